@@ -66,6 +66,8 @@ func main() {
 				sessionID = logging.NewSessionID()
 			}
 
+			logFormat, _ := getFlagValue(os.Args, app.FlagLogFormat)
+
 			closer, err := logging.Init(
 				logsPath,
 				sessionID,
@@ -73,6 +75,7 @@ func main() {
 				logging.DefaultMaxLogFileSize,
 				logging.DefaultPruningSize,
 				"",
+				logging.Format(logFormat),
 			)
 			if err != nil {
 				return err
@@ -35,6 +35,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
 	"github.com/ProtonMail/proton-bridge/v3/internal/useragent"
 	"github.com/ProtonMail/proton-bridge/v3/internal/versioner"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain/passphrasefd"
 	"github.com/bradenaw/juniper/xslices"
 	"github.com/elastic/go-sysinfo"
 	"github.com/elastic/go-sysinfo/types"
@@ -60,6 +61,13 @@ const (
 	HyphenatedFlagLauncher  = "--" + FlagLauncher
 	HyphenatedFlagWait      = "--" + FlagWait
 	HyphenatedFlagSessionID = "--" + FlagSessionID
+
+	CommandConfig   = "config"
+	CommandAccounts = "accounts"
+	CommandUpdate   = "update"
+	CommandVault    = "vault"
+	CommandImport   = "import"
+	CommandExport   = "export"
 )
 
 func main() { //nolint:funlen
@@ -94,6 +102,7 @@ func main() { //nolint:funlen
 		logging.DefaultMaxLogFileSize,
 		logging.NoPruning,
 		os.Getenv("VERBOSITY"),
+		logging.TextFormat,
 	); err != nil {
 		l.WithError(err).Fatal("Failed to setup logging")
 	}
@@ -131,7 +140,7 @@ func main() { //nolint:funlen
 	exe, err := getPathToUpdatedExecutable(filepath.Base(launcher), versioner, kr)
 	if err != nil {
 		exeToLaunch := guiName
-		if inCLIMode(args) {
+		if inCLIMode(args) || isScriptingCommand(args) {
 			exeToLaunch = exeName
 		}
 
@@ -155,13 +164,25 @@ func main() { //nolint:funlen
 		}
 	}
 
-	cmd := execabs.Command(exe, appendLauncherPath(launcher, appendOrModifySessionID(args, string(sessionID)))...) //nolint:gosec
+	// One-shot commands only talk to the running instance, they are forwarded as is.
+	scripting := isScriptingCommand(args)
+
+	exeArgs := args
+	if !scripting {
+		exeArgs = appendLauncherPath(launcher, appendOrModifySessionID(args, string(sessionID)))
+	}
+
+	cmd := execabs.Command(exe, exeArgs...) //nolint:gosec
 
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	cmd.Env = os.Environ()
 
+	if err := passphrasefd.Forward(cmd); err != nil {
+		l.WithError(err).Error("Failed to pass on the keychain passphrase")
+	}
+
 	// On windows, if you use Run(), a terminal stays open; we don't want that.
 	if //goland:noinspection GoBoolExpressions
 	runtime.GOOS == "windows" {
@@ -170,11 +191,21 @@ func main() { //nolint:funlen
 		err = cmd.Run()
 	}
 
+	// Scripts rely on the exit code of one-shot commands.
+	if exitErr := new(execabs.ExitError); scripting && errors.As(err, &exitErr) {
+		os.Exit(exitErr.ExitCode())
+	}
+
 	if err != nil {
 		l.WithError(err).Fatal("Failed to launch")
 	}
 }
 
+// isScriptingCommand detects if a one-shot command is asked. The command must be the first argument.
+func isScriptingCommand(args []string) bool {
+	return len(args) > 0 && slices.Contains([]string{CommandConfig, CommandAccounts, CommandUpdate, CommandVault, CommandImport, CommandExport}, args[0])
+}
+
 // appendLauncherPath add launcher path if missing.
 func appendLauncherPath(path string, args []string) []string {
 	if !slices.Contains(args, HyphenatedFlagLauncher) {
@@ -266,6 +297,16 @@ func getPathToUpdatedExecutable(
 		logrus.WithField("version", constants.Version).WithError(err).Error("Failed to parse current version")
 	}
 
+	// Older updates are only started if the launcher version was rolled back or is newer than the pinned one.
+	// No update is started if the versions which are allowed are unknown.
+	var currentAllowed bool
+
+	if currentVersion != nil {
+		if currentAllowed, err = ver.IsAllowed(currentVersion); err != nil {
+			return "", errors.Wrap(err, "failed to check the allowed versions")
+		}
+	}
+
 	for _, version := range versions {
 		vlog := logrus.WithFields(logrus.Fields{
 			"version":       constants.Version,
@@ -283,8 +324,19 @@ func getPathToUpdatedExecutable(
 			continue
 		}
 
+		// Skip versions that were rolled back or are newer than the pinned one.
+		allowed, err := ver.IsAllowed(version.SemVer())
+		if err != nil {
+			return "", errors.Wrap(err, "failed to check the allowed versions")
+		}
+
+		if !allowed {
+			vlog.Info("Version is not allowed and is skipped")
+			continue
+		}
+
 		// Skip versions that are less or equal to launcher version.
-		if currentVersion != nil && !version.SemVer().GreaterThan(currentVersion) {
+		if currentAllowed && !version.SemVer().GreaterThan(currentVersion) {
 			continue
 		}
 
@@ -70,6 +70,15 @@ func TestFindAndStripWait(t *testing.T) {
 	assert.Equal(t, values, []string{"b", "c", "d"})
 }
 
+func TestIsScriptingCommand(t *testing.T) {
+	assert.True(t, isScriptingCommand([]string{"config", "get", "imap-port"}))
+	assert.True(t, isScriptingCommand([]string{"accounts", "list", "--json"}))
+	assert.True(t, isScriptingCommand([]string{"import", "user", "mail.mbox"}))
+	assert.True(t, isScriptingCommand([]string{"export", "user", "--dest", "backup"}))
+	assert.False(t, isScriptingCommand([]string{"--cli", "config"}))
+	assert.False(t, isScriptingCommand([]string{}))
+}
+
 func TestAppendOrModifySessionID(t *testing.T) {
 	sessionID := string(logging.NewSessionID())
 	assert.Equal(t, appendOrModifySessionID(nil, sessionID), []string{"--session-id", sessionID})
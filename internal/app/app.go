@@ -63,6 +63,7 @@ const (
 
 	flagLogLevel      = "log-level"
 	flagLogLevelShort = "l"
+	FlagLogFormat     = "log-format"
 
 	flagGRPC      = "grpc"
 	flagGRPCShort = "g"
@@ -82,6 +83,9 @@ const (
 	flagSoftwareRenderer    = "software-renderer"
 	flagSetSoftwareRenderer = "set-software-renderer"
 	flagSetHardwareRenderer = "set-hardware-renderer"
+
+	flagDemo        = "demo"
+	flagDemoFixture = "demo-fixture"
 )
 
 // Hidden flags.
@@ -138,7 +142,12 @@ func New() *cli.App {
 		&cli.StringFlag{
 			Name:    flagLogLevel,
 			Aliases: []string{flagLogLevelShort},
-			Usage:   "Set the log level (one of panic, fatal, error, warn, info, debug)",
+			Usage:   "Set the log level (one of panic, fatal, error, warn, info, debug, trace), optionally followed by per-subsystem levels (e.g. info,sync=debug,smtp=trace)",
+		},
+		&cli.StringFlag{
+			Name:  FlagLogFormat,
+			Usage: "Set the format of the log files (text|json)",
+			Value: string(logging.TextFormat),
 		},
 		&cli.BoolFlag{
 			Name:    flagGRPC,
@@ -163,6 +172,14 @@ func New() *cli.App {
 			Name:  flagLogSMTP,
 			Usage: "Enable logging of SMTP communications (may contain decrypted data!)",
 		},
+		&cli.BoolFlag{
+			Name:  flagDemo,
+			Usage: "Run against a built-in fake Proton server instead of the real one, for mail client development",
+		},
+		&cli.PathFlag{
+			Name:  flagDemoFixture,
+			Usage: "The fixture file describing the users, addresses, labels and messages of the fake server in demo mode",
+		},
 		&cli.BoolFlag{
 			Name:               flagSoftwareRenderer, // This flag is ignored by bridge, but should be passed to launcher in case of restart, so it need to be accepted by the CLI parser.
 			Usage:              "Use software rendering of the GUI for the current execution of the application",
@@ -219,6 +236,7 @@ func New() *cli.App {
 		app.Flags = append(app.Flags, cliFlagEnableKeychainTest, cliFlagDisableKeychainTest)
 	}
 
+	app.Commands = newCommands()
 	app.Action = run
 
 	return app
@@ -234,6 +252,11 @@ func run(c *cli.Context) error {
 	// Create a user agent that will be used for all requests.
 	identifier := useragent.New()
 
+	// Run against a fake server if requested; nothing is reported nor kept in this mode.
+	if c.Bool(flagDemo) {
+		return runDemo(c, version, identifier)
+	}
+
 	// Create a new Sentry client that will be used to report crashes etc.
 	reporter := sentry.NewReporter(constants.FullAppName, identifier)
 
@@ -270,6 +293,9 @@ func run(c *cli.Context) error {
 						logrus.WithError(err).Error("Failed to migrate keychain helper")
 					}
 
+					// Roll back to the previous version if this one keeps crashing on startup.
+					defer watchStartupCrashes(crashHandler, locations, version)()
+
 					// Initialize logging.
 					return withLogging(c, crashHandler, locations, func(closer io.Closer) error {
 						logCloser = closer
@@ -404,6 +430,7 @@ func withLogging(c *cli.Context, crashHandler *crash.Handler, locations *locatio
 		logging.DefaultMaxLogFileSize,
 		logging.DefaultPruningSize,
 		c.String(flagLogLevel),
+		logging.Format(c.String(FlagLogFormat)),
 	); err != nil {
 		return fmt.Errorf("could not initialize logging: %w", err)
 	}
@@ -32,6 +32,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/dialer"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/locations"
+	"github.com/ProtonMail/proton-bridge/v3/internal/policy"
 	"github.com/ProtonMail/proton-bridge/v3/internal/sentry"
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
 	"github.com/ProtonMail/proton-bridge/v3/internal/useragent"
@@ -91,6 +92,12 @@ func withBridge(
 		return fmt.Errorf("could not create updater: %w", err)
 	}
 
+	// Load the settings enforced by the IT department, if any.
+	policy, err := policy.Load(policy.GetPath())
+	if err != nil {
+		return fmt.Errorf("could not load policy: %w", err)
+	}
+
 	// Create a new bridge.
 	bridge, eventCh, err := bridge.New(
 		// The app stuff.
@@ -100,6 +107,7 @@ func withBridge(
 		updater,
 		version,
 		keychains,
+		policy,
 
 		// The API stuff.
 		constants.APIHost,
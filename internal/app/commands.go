@@ -0,0 +1,150 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/grpc"
+	"github.com/ProtonMail/proton-bridge/v3/internal/locations"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Exit codes of the one-shot commands.
+const (
+	exitCodeError      = 1
+	exitCodeUsage      = 2
+	exitCodeNotRunning = 3
+	exitCodeRunning    = 4
+)
+
+const flagJSON = "json"
+
+// newCommands returns the one-shot commands. They do not start bridge but talk to the running instance through its
+// gRPC service, which must have been started with --grpc, except for the vault commands, which require bridge not to
+// be running. They exit with exitCodeError on failure, exitCodeUsage on invalid arguments and exitCodeNotRunning if no
+// instance is running, or exitCodeRunning for the vault commands if one is.
+func newCommands() []*cli.Command {
+	jsonFlag := &cli.BoolFlag{
+		Name:  flagJSON,
+		Usage: "Print the result as JSON",
+	}
+
+	return []*cli.Command{
+		newConfigCommand(jsonFlag),
+		newAccountsCommand(jsonFlag),
+		newImportCommand(jsonFlag),
+		newExportCommand(jsonFlag),
+		newUpdateCommand(jsonFlag),
+		newVaultCommand(jsonFlag),
+	}
+}
+
+func withClient(fn func(*cli.Context, grpc.BridgeClient) error) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		return WithLocations(func(locations *locations.Locations) error {
+			client, conn, err := grpc.NewClient(c.Context, locations)
+			if err != nil {
+				if errors.Is(err, grpc.ErrServiceNotRunning) {
+					return cli.Exit(err, exitCodeNotRunning)
+				}
+
+				return cli.Exit(err, exitCodeError)
+			}
+
+			defer func() { _ = conn.Close() }()
+
+			if err := fn(c, client); err != nil {
+				return toExitError(err)
+			}
+
+			return nil
+		})
+	}
+}
+
+// toExitError maps the errors to the exit codes of the one-shot commands.
+func toExitError(err error) error {
+	if exitErr := cli.ExitCoder(nil); errors.As(err, &exitErr) {
+		return err
+	}
+
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() { //nolint:exhaustive
+		case codes.NotFound, codes.InvalidArgument:
+			return cli.Exit(st.Message(), exitCodeUsage)
+
+		default:
+			return cli.Exit(st.Message(), exitCodeError)
+		}
+	}
+
+	return cli.Exit(err, exitCodeError)
+}
+
+func usageError(c *cli.Context) error {
+	return cli.Exit(fmt.Sprintf("usage: %v %v", c.Command.HelpName, c.Command.ArgsUsage), exitCodeUsage)
+}
+
+// findAccount looks up an account of the running instance by ID, username or address.
+func findAccount(c *cli.Context, client grpc.BridgeClient, query string) (*grpc.User, error) {
+	res, err := client.GetUserList(c.Context, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return findUser(res.Users, query)
+}
+
+// findUser looks up a user by ID, username or address.
+func findUser(users []*grpc.User, query string) (*grpc.User, error) {
+	for _, user := range users {
+		if user.Id == query || strings.EqualFold(user.Username, query) {
+			return user, nil
+		}
+
+		for _, address := range user.Addresses {
+			if strings.EqualFold(address, query) {
+				return user, nil
+			}
+		}
+	}
+
+	return nil, cli.Exit(fmt.Sprintf("no such account: %v", query), exitCodeUsage)
+}
+
+func printJSON(c *cli.Context, v any) error {
+	enc := json.NewEncoder(c.App.Writer)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+func changedText(changed bool) string {
+	if changed {
+		return "changed"
+	}
+
+	return "unchanged"
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/grpc"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	addressModeSplit    = "split"
+	addressModeCombined = "combined"
+)
+
+func newAccountsCommand(jsonFlag cli.Flag) *cli.Command {
+	return &cli.Command{
+		Name:  "accounts",
+		Usage: "Manage the accounts of the running instance",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "list",
+				Usage:  "Print the accounts",
+				Flags:  []cli.Flag{jsonFlag},
+				Action: withClient(accountsList),
+			},
+			{
+				Name:      "mode",
+				Usage:     "Set the address mode of an account, nothing is done if it is already in this mode",
+				ArgsUsage: "<id|username|address> <split|combined>",
+				Flags:     []cli.Flag{jsonFlag},
+				Action:    withClient(accountsMode),
+			},
+			{
+				Name:      "read-only",
+				Usage:     "Set whether the mail clients can only read the mailbox of an account, whatever their password",
+				ArgsUsage: "<id|username|address> <true|false>",
+				Description: "The mail clients of a read-only account get the mailboxes opened read-only, their changes " +
+					"are refused with NO [NOPERM] and they cannot authenticate over SMTP.",
+				Flags:  []cli.Flag{jsonFlag},
+				Action: withClient(accountsReadOnly),
+			},
+			{
+				Name:      "strict-split",
+				Usage:     "Set whether, in split mode, each address of an account can only send as itself over SMTP",
+				ArgsUsage: "<id|username|address> <true|false>",
+				Description: "An SMTP session authenticated with an address can then only send as this address, its + " +
+					"aliases or the addresses of its group, so that the Sent copy always lands in the mailbox of the " +
+					"authenticated address.",
+				Flags:  []cli.Flag{jsonFlag},
+				Action: withClient(accountsStrictSplit),
+			},
+			newAppPasswordsCommand(jsonFlag),
+			newAddressGroupsCommand(jsonFlag),
+		},
+	}
+}
+
+type account struct {
+	ID          string   `json:"id"`
+	Username    string   `json:"username"`
+	State       string   `json:"state"`
+	Mode        string   `json:"mode"`
+	ReadOnly    bool     `json:"readOnly"`
+	StrictSplit bool     `json:"strictSplitMode"`
+	Addresses   []string `json:"addresses"`
+	UsedBytes   int64    `json:"usedBytes"`
+	TotalBytes  int64    `json:"totalBytes"`
+	Changed     *bool    `json:"changed,omitempty"`
+}
+
+// newAccount converts a gRPC user. The bridge password it contains is deliberately left out.
+func newAccount(user *grpc.User) account {
+	mode := addressModeCombined
+	if user.SplitMode {
+		mode = addressModeSplit
+	}
+
+	return account{
+		ID:          user.Id,
+		Username:    user.Username,
+		State:       strings.ToLower(user.State.String()),
+		Mode:        mode,
+		ReadOnly:    user.ReadOnly,
+		StrictSplit: user.StrictSplitMode,
+		Addresses:   user.Addresses,
+		UsedBytes:   user.UsedBytes,
+		TotalBytes:  user.TotalBytes,
+	}
+}
+
+func accountsList(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 0 {
+		return usageError(c)
+	}
+
+	res, err := client.GetUserList(c.Context, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+
+	accounts := make([]account, 0, len(res.Users))
+	for _, user := range res.Users {
+		accounts = append(accounts, newAccount(user))
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, accounts)
+	}
+
+	for _, account := range accounts {
+		if _, err := fmt.Fprintf(
+			c.App.Writer,
+			"%v\t%v\t%v\t%v\t%v\t%v\n",
+			account.ID,
+			account.Username,
+			account.State,
+			account.Mode,
+			strings.Join(account.Addresses, ","),
+			readOnlyText(account.ReadOnly),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func accountsMode(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 2 {
+		return usageError(c)
+	}
+
+	mode := c.Args().Get(1)
+	if mode != addressModeSplit && mode != addressModeCombined {
+		return usageError(c)
+	}
+
+	user, err := findAccount(c, client, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	changed, err := client.SetUserAddressMode(c.Context, &grpc.UserSplitModeRequest{UserID: user.Id, Active: mode == addressModeSplit})
+	if err != nil {
+		return err
+	}
+
+	account := newAccount(user)
+	account.Mode = mode
+	account.Changed = &changed.Value
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, account)
+	}
+
+	_, err = fmt.Fprintf(c.App.Writer, "%v: %v (%v)\n", account.Username, mode, changedText(changed.Value))
+
+	return err
+}
+
+func accountsReadOnly(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 2 {
+		return usageError(c)
+	}
+
+	readOnly, err := strconv.ParseBool(c.Args().Get(1))
+	if err != nil {
+		return usageError(c)
+	}
+
+	user, err := findAccount(c, client, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	changed, err := client.SetUserReadOnly(c.Context, &grpc.UserReadOnlyRequest{UserID: user.Id, ReadOnly: readOnly})
+	if err != nil {
+		return err
+	}
+
+	account := newAccount(user)
+	account.ReadOnly = readOnly
+	account.Changed = &changed.Value
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, account)
+	}
+
+	_, err = fmt.Fprintf(c.App.Writer, "%v: %v (%v)\n", account.Username, readOnlyText(readOnly), changedText(changed.Value))
+
+	return err
+}
+
+func accountsStrictSplit(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 2 {
+		return usageError(c)
+	}
+
+	strict, err := strconv.ParseBool(c.Args().Get(1))
+	if err != nil {
+		return usageError(c)
+	}
+
+	user, err := findAccount(c, client, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	changed, err := client.SetUserStrictSplitMode(c.Context, &grpc.UserStrictSplitModeRequest{UserID: user.Id, StrictSplitMode: strict})
+	if err != nil {
+		return err
+	}
+
+	account := newAccount(user)
+	account.StrictSplit = strict
+	account.Changed = &changed.Value
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, account)
+	}
+
+	_, err = fmt.Fprintf(c.App.Writer, "%v: %v (%v)\n", account.Username, strictSplitText(strict), changedText(changed.Value))
+
+	return err
+}
+
+func strictSplitText(strict bool) string {
+	if strict {
+		return "strict split mode enabled"
+	}
+
+	return "strict split mode disabled"
+}
+
+func readOnlyText(readOnly bool) string {
+	if readOnly {
+		return "read-only"
+	}
+
+	return "read-write"
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/grpc"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func newAddressGroupsCommand(jsonFlag cli.Flag) *cli.Command {
+	return &cli.Command{
+		Name:  "address-groups",
+		Usage: "Manage the groups of addresses sharing a mailbox in split mode",
+		Description: "The addresses of a group share a mailbox, like in combined mode, while the other addresses of " +
+			"the account keep their own.",
+		Subcommands: []*cli.Command{
+			{
+				Name:      "list",
+				Usage:     "Print the address groups of an account",
+				ArgsUsage: "<id|username|address>",
+				Flags:     []cli.Flag{jsonFlag},
+				Action:    withClient(addressGroupsList),
+			},
+			{
+				Name:      "set",
+				Usage:     "Create or replace an address group; the mailboxes of the addresses joining or leaving it are resynced",
+				ArgsUsage: "<id|username|address> <name> <address>...",
+				Action:    withClient(addressGroupsSet),
+			},
+			{
+				Name:      "remove",
+				Usage:     "Remove an address group, its addresses getting their own mailbox again",
+				ArgsUsage: "<id|username|address> <name>",
+				Action:    withClient(addressGroupsRemove),
+			},
+		},
+	}
+}
+
+type addressGroup struct {
+	Name      string   `json:"name"`
+	Addresses []string `json:"addresses"`
+}
+
+func addressGroupsList(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 1 {
+		return usageError(c)
+	}
+
+	user, err := findAccount(c, client, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	res, err := client.ListAddressGroups(c.Context, wrapperspb.String(user.Id))
+	if err != nil {
+		return err
+	}
+
+	groups := make([]addressGroup, 0, len(res.AddressGroups))
+	for _, group := range res.AddressGroups {
+		groups = append(groups, addressGroup{Name: group.Name, Addresses: group.Addresses})
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, groups)
+	}
+
+	for _, group := range groups {
+		if _, err := fmt.Fprintf(c.App.Writer, "%v\t%v\n", group.Name, strings.Join(group.Addresses, ",")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func addressGroupsSet(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() < 3 {
+		return usageError(c)
+	}
+
+	user, err := findAccount(c, client, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.SetAddressGroup(c.Context, &grpc.AddressGroupRequest{
+		UserID:    user.Id,
+		Name:      c.Args().Get(1),
+		Addresses: c.Args().Slice()[2:],
+	}); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(c.App.Writer, "%v: set\n", c.Args().Get(1))
+
+	return err
+}
+
+func addressGroupsRemove(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 2 {
+		return usageError(c)
+	}
+
+	user, err := findAccount(c, client, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.SetAddressGroup(c.Context, &grpc.AddressGroupRequest{
+		UserID: user.Id,
+		Name:   c.Args().Get(1),
+	}); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(c.App.Writer, "%v: removed\n", c.Args().Get(1))
+
+	return err
+}
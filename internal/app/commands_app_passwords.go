@@ -0,0 +1,197 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/grpc"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func newAppPasswordsCommand(jsonFlag cli.Flag) *cli.Command {
+	return &cli.Command{
+		Name:  "app-passwords",
+		Usage: "Manage the per-client passwords of an account, used in place of the bridge password",
+		Subcommands: []*cli.Command{
+			{
+				Name:      "list",
+				Usage:     "Print the app passwords of an account",
+				ArgsUsage: "<id|username|address>",
+				Flags:     []cli.Flag{jsonFlag},
+				Action:    withClient(appPasswordsList),
+			},
+			{
+				Name:      "create",
+				Usage:     "Create an app password and print it; it cannot be retrieved later",
+				ArgsUsage: "<id|username|address> <name> <all|imap|smtp|imap-readonly>",
+				Description: "The scope restricts the app password to IMAP or SMTP, imap-readonly opening the " +
+					"mailboxes read-only.",
+				Flags:  []cli.Flag{jsonFlag},
+				Action: withClient(appPasswordsCreate),
+			},
+			{
+				Name:      "revoke",
+				Usage:     "Revoke an app password and disconnect the clients using it",
+				ArgsUsage: "<id|username|address> <app password id|name>",
+				Action:    withClient(appPasswordsRevoke),
+			},
+		},
+	}
+}
+
+type appPassword struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Scope      string     `json:"scope"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	Password   string     `json:"password,omitempty"`
+}
+
+func newAppPassword(res *grpc.AppPassword) appPassword {
+	appPassword := appPassword{
+		ID:        res.Id,
+		Name:      res.Name,
+		Scope:     res.Scope,
+		CreatedAt: time.Unix(res.CreatedAt, 0),
+	}
+
+	if res.LastUsedAt != 0 {
+		lastUsedAt := time.Unix(res.LastUsedAt, 0)
+		appPassword.LastUsedAt = &lastUsedAt
+	}
+
+	return appPassword
+}
+
+func appPasswordsList(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 1 {
+		return usageError(c)
+	}
+
+	user, err := findAccount(c, client, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	res, err := client.ListAppPasswords(c.Context, wrapperspb.String(user.Id))
+	if err != nil {
+		return err
+	}
+
+	appPasswords := make([]appPassword, 0, len(res.AppPasswords))
+	for _, appPassword := range res.AppPasswords {
+		appPasswords = append(appPasswords, newAppPassword(appPassword))
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, appPasswords)
+	}
+
+	for _, appPassword := range appPasswords {
+		lastUsed := "never"
+		if appPassword.LastUsedAt != nil {
+			lastUsed = appPassword.LastUsedAt.Format(time.RFC3339)
+		}
+
+		if _, err := fmt.Fprintf(
+			c.App.Writer,
+			"%v\t%v\t%v\t%v\t%v\n",
+			appPassword.ID,
+			appPassword.Name,
+			appPassword.Scope,
+			appPassword.CreatedAt.Format(time.RFC3339),
+			lastUsed,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func appPasswordsCreate(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 3 {
+		return usageError(c)
+	}
+
+	user, err := findAccount(c, client, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	res, err := client.CreateAppPassword(c.Context, &grpc.CreateAppPasswordRequest{
+		UserID: user.Id,
+		Name:   c.Args().Get(1),
+		Scope:  c.Args().Get(2),
+	})
+	if err != nil {
+		return err
+	}
+
+	appPassword := newAppPassword(res.AppPassword)
+	appPassword.Password = string(res.Password)
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, appPassword)
+	}
+
+	_, err = fmt.Fprintln(c.App.Writer, appPassword.Password)
+
+	return err
+}
+
+func appPasswordsRevoke(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 2 {
+		return usageError(c)
+	}
+
+	user, err := findAccount(c, client, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	res, err := client.ListAppPasswords(c.Context, wrapperspb.String(user.Id))
+	if err != nil {
+		return err
+	}
+
+	query := c.Args().Get(1)
+
+	for _, appPassword := range res.AppPasswords {
+		if appPassword.Id != query && appPassword.Name != query {
+			continue
+		}
+
+		if _, err := client.RevokeAppPassword(c.Context, &grpc.RevokeAppPasswordRequest{
+			UserID:        user.Id,
+			AppPasswordID: appPassword.Id,
+		}); err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(c.App.Writer, "%v: revoked\n", appPassword.Name)
+
+		return err
+	}
+
+	return cli.Exit(fmt.Sprintf("no such app password: %v", query), exitCodeUsage)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/grpc"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func newConfigCommand(jsonFlag cli.Flag) *cli.Command {
+	return &cli.Command{
+		Name:  "config",
+		Usage: "Read or change the settings of the running instance",
+		Description: "The settings locked by the policy file are listed as managed by policy and cannot be changed.\n\n" +
+			"system-views lists the system views shown to the mail clients besides All Mail, among starred, snoozed, " +
+			"scheduled (shown once not empty) and almost-all-mail. Messages cannot be moved into Snoozed, as bridge " +
+			"cannot snooze them; moving them out of it files them in the destination, and they leave Snoozed " +
+			"once the server unsnoozes them.\n\n" +
+			"update-mirror-url makes bridge download the version file and the update packages from a mirror with the " +
+			"same layout as https://proton.me/download.\n\n" +
+			"update-pin pins bridge to a version: newer versions are neither installed nor started by the launcher. " +
+			"An empty value removes the pin.\n\n" +
+			"update-rollback-crashes is the number of consecutive crashes within 2 minutes of starting after which " +
+			"bridge rolls back to the previous version, 0 never rolling back.",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "list",
+				Usage:  "Print all the settings",
+				Flags:  []cli.Flag{jsonFlag},
+				Action: withClient(configList),
+			},
+			{
+				Name:      "get",
+				Usage:     "Print the value of a setting",
+				ArgsUsage: "<name>",
+				Flags:     []cli.Flag{jsonFlag},
+				Action:    withClient(configGet),
+			},
+			{
+				Name:      "set",
+				Usage:     "Change the value of a setting, nothing is done if it already has this value",
+				ArgsUsage: "<name> <value>",
+				Flags:     []cli.Flag{jsonFlag},
+				Action:    withClient(configSet),
+			},
+		},
+	}
+}
+
+func configList(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 0 {
+		return usageError(c)
+	}
+
+	res, err := client.Settings(c.Context, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, res.Settings)
+	}
+
+	names := make([]string, 0, len(res.Settings))
+	for name := range res.Settings {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		var locked string
+		if slices.Contains(res.LockedSettings, name) {
+			locked = " (managed by policy)"
+		}
+
+		if _, err := fmt.Fprintf(c.App.Writer, "%v=%v%v\n", name, res.Settings[name], locked); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type configValue struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Changed *bool  `json:"changed,omitempty"`
+	Locked  bool   `json:"locked,omitempty"`
+}
+
+func configGet(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 1 {
+		return usageError(c)
+	}
+
+	res, err := client.GetSetting(c.Context, wrapperspb.String(c.Args().Get(0)))
+	if err != nil {
+		return err
+	}
+
+	if c.Bool(flagJSON) {
+		settings, err := client.Settings(c.Context, &emptypb.Empty{})
+		if err != nil {
+			return err
+		}
+
+		return printJSON(c, configValue{
+			Name:   c.Args().Get(0),
+			Value:  res.Value,
+			Locked: slices.Contains(settings.LockedSettings, c.Args().Get(0)),
+		})
+	}
+
+	_, err = fmt.Fprintln(c.App.Writer, res.Value)
+
+	return err
+}
+
+func configSet(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 2 {
+		return usageError(c)
+	}
+
+	name := c.Args().Get(0)
+
+	res, err := client.SetSetting(c.Context, &grpc.SetSettingRequest{Name: name, Value: c.Args().Get(1)})
+	if err != nil {
+		return err
+	}
+
+	// Print the value as normalized by bridge.
+	value, err := client.GetSetting(c.Context, wrapperspb.String(name))
+	if err != nil {
+		return err
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, configValue{Name: name, Value: value.Value, Changed: &res.Value})
+	}
+
+	_, err = fmt.Fprintf(c.App.Writer, "%v=%v (%v)\n", name, value.Value, changedText(res.Value))
+
+	return err
+}
@@ -0,0 +1,264 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/grpc"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	flagFormat     = "format"
+	flagDest       = "dest"
+	flagEvery      = "every"
+	flagSchedule   = "schedule"
+	flagUnschedule = "unschedule"
+)
+
+func newExportCommand(jsonFlag cli.Flag) *cli.Command {
+	return &cli.Command{
+		Name:      "export",
+		Usage:     "Export the decrypted messages of an account into Maildir directories or mbox files",
+		ArgsUsage: "<id|username|address>",
+		Description: "Each mailbox, except All Mail, is exported into a Maildir directory or an mbox file named as in " +
+			"the mail clients. Only the messages which were not exported into the destination before are exported, " +
+			"so running the export again adds the new messages. With --every, the export then runs periodically in " +
+			"the background.",
+		Flags: []cli.Flag{
+			jsonFlag,
+			&cli.StringFlag{
+				Name:  flagFormat,
+				Usage: "The format of the export, `maildir` or `mbox`",
+				Value: "maildir",
+			},
+			&cli.PathFlag{
+				Name:  flagDest,
+				Usage: "The folder to export the mailboxes into",
+			},
+			&cli.DurationFlag{
+				Name:  flagEvery,
+				Usage: "Then export the messages into the same folder every `interval`, e.g. 24h, at least one hour",
+			},
+			&cli.BoolFlag{
+				Name:  flagSchedule,
+				Usage: "Print the periodic export of the account rather than exporting",
+			},
+			&cli.BoolFlag{
+				Name:  flagUnschedule,
+				Usage: "Stop exporting the messages of the account periodically rather than exporting",
+			},
+		},
+		Action: withClient(exportMessages),
+	}
+}
+
+type exportReport struct {
+	Exported int64           `json:"exported"`
+	Skipped  int64           `json:"skipped"`
+	Failures []exportFailure `json:"failures"`
+}
+
+type exportFailure struct {
+	MessageID string `json:"messageID"`
+	Subject   string `json:"subject"`
+	Error     string `json:"error"`
+}
+
+type exportSchedule struct {
+	Format    string `json:"format,omitempty"`
+	Dest      string `json:"dest,omitempty"`
+	Interval  string `json:"interval,omitempty"`
+	LastRun   string `json:"lastRun,omitempty"`
+	LastError string `json:"lastError,omitempty"`
+}
+
+func exportMessages(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 1 {
+		return usageError(c)
+	}
+
+	if c.Bool(flagSchedule) && c.Bool(flagUnschedule) {
+		return usageError(c)
+	}
+
+	if (c.Bool(flagSchedule) || c.Bool(flagUnschedule)) && (c.IsSet(flagDest) || c.IsSet(flagEvery)) {
+		return usageError(c)
+	}
+
+	user, err := findAccount(c, client, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case c.Bool(flagSchedule):
+		return printExportSchedule(c, client, user.Id)
+
+	case c.Bool(flagUnschedule):
+		if _, err := client.SetExportSchedule(c.Context, &grpc.ExportScheduleRequest{UserID: user.Id}); err != nil {
+			return err
+		}
+
+		return printExportSchedule(c, client, user.Id)
+	}
+
+	if !c.IsSet(flagDest) {
+		return usageError(c)
+	}
+
+	// The running instance might not share the working directory of this command.
+	dest, err := filepath.Abs(c.Path(flagDest))
+	if err != nil {
+		return err
+	}
+
+	stream, err := client.ExportMessages(c.Context, &grpc.ExportMessagesRequest{
+		UserID: user.Id,
+		Format: c.String(flagFormat),
+		Dest:   dest,
+	})
+	if err != nil {
+		return err
+	}
+
+	var res *grpc.ExportMessagesProgress
+
+	for {
+		progress, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if progress.Done {
+			res = progress
+			break
+		}
+
+		if _, err := fmt.Fprintf(c.App.ErrWriter, "%v/%v: exported %v, skipped %v, failed %v\n",
+			progress.Exported+progress.Skipped+progress.Failed, progress.Total, progress.Exported, progress.Skipped, progress.Failed); err != nil {
+			return err
+		}
+	}
+
+	if res == nil {
+		return cli.Exit("the export was interrupted, run it again to resume it", exitCodeError)
+	}
+
+	if c.IsSet(flagEvery) {
+		if _, err := client.SetExportSchedule(c.Context, &grpc.ExportScheduleRequest{
+			UserID:          user.Id,
+			Format:          c.String(flagFormat),
+			Dest:            dest,
+			IntervalSeconds: int64(c.Duration(flagEvery) / time.Second),
+		}); err != nil {
+			return err
+		}
+	}
+
+	report := exportReport{
+		Exported: res.Exported,
+		Skipped:  res.Skipped,
+		Failures: xslices.Map(res.Failures, func(failure *grpc.ExportFailure) exportFailure {
+			return exportFailure{MessageID: failure.MessageID, Subject: failure.Subject, Error: failure.Error}
+		}),
+	}
+
+	if c.Bool(flagJSON) {
+		if report.Failures == nil {
+			report.Failures = []exportFailure{}
+		}
+
+		if err := printJSON(c, report); err != nil {
+			return err
+		}
+	} else {
+		for _, failure := range report.Failures {
+			if _, err := fmt.Fprintf(c.App.Writer, "%v (%q): %v\n", failure.MessageID, failure.Subject, failure.Error); err != nil {
+				return err
+			}
+		}
+
+		if _, err := fmt.Fprintf(c.App.Writer, "exported %v, skipped %v as already exported, failed %v\n",
+			report.Exported, report.Skipped, len(report.Failures)); err != nil {
+			return err
+		}
+
+		if c.IsSet(flagEvery) {
+			if _, err := fmt.Fprintf(c.App.Writer, "the messages are now exported every %v\n", c.Duration(flagEvery)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(report.Failures) > 0 {
+		return cli.Exit(fmt.Sprintf("%v message(s) could not be exported, run the export again to retry them", len(report.Failures)), exitCodeError)
+	}
+
+	return nil
+}
+
+func printExportSchedule(c *cli.Context, client grpc.BridgeClient, userID string) error {
+	res, err := client.GetExportSchedule(c.Context, wrapperspb.String(userID))
+	if err != nil {
+		return err
+	}
+
+	var schedule exportSchedule
+
+	if res.IntervalSeconds > 0 {
+		schedule = exportSchedule{
+			Format:    res.Format,
+			Dest:      res.Dest,
+			Interval:  (time.Duration(res.IntervalSeconds) * time.Second).String(),
+			LastRun:   time.Unix(res.LastRun, 0).Format(time.RFC3339),
+			LastError: res.LastError,
+		}
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, schedule)
+	}
+
+	if schedule.Interval == "" {
+		_, err := fmt.Fprintln(c.App.Writer, "the messages are not exported periodically")
+		return err
+	}
+
+	if _, err := fmt.Fprintf(c.App.Writer, "exported every %v as %v into %v, last run at %v\n",
+		schedule.Interval, schedule.Format, schedule.Dest, schedule.LastRun); err != nil {
+		return err
+	}
+
+	if schedule.LastError != "" {
+		if _, err := fmt.Fprintf(c.App.Writer, "the last run failed: %v\n", schedule.LastError); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
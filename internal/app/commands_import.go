@@ -0,0 +1,201 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/grpc"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagMap     = "map"
+	flagLabels  = "labels"
+	flagAddress = "address"
+	flagRestart = "restart"
+	flagReport  = "report"
+)
+
+func newImportCommand(jsonFlag cli.Flag) *cli.Command {
+	return &cli.Command{
+		Name:      "import",
+		Usage:     "Import the messages of an mbox file, a Maildir directory or a directory of .eml files into an account",
+		ArgsUsage: "<id|username|address> <source>",
+		Description: "The folders of the source are imported into the mailboxes of the same name, Inbox, Sent, Drafts, " +
+			"Archive, Spam and Trash being recognized, the other folders being created. The messages already imported " +
+			"from the same source are skipped, so an interrupted import is resumed by running it again.",
+		Flags: []cli.Flag{
+			jsonFlag,
+			&cli.StringSliceFlag{
+				Name:  flagMap,
+				Usage: "Import a folder into a mailbox, as `folder=mailbox` where the mailbox is named as in the mail clients, e.g. Work/Old=Labels/Old",
+			},
+			&cli.BoolFlag{
+				Name:  flagLabels,
+				Usage: "Import the folders which are not mapped as labels rather than folders",
+			},
+			&cli.StringFlag{
+				Name:  flagAddress,
+				Usage: "Import the messages into this address rather than the address they were sent to or from",
+			},
+			&cli.BoolFlag{
+				Name:  flagRestart,
+				Usage: "Import all the messages again rather than resuming the previous import of the source",
+			},
+			&cli.PathFlag{
+				Name:  flagReport,
+				Usage: "Write the report, with the messages which could not be imported, as JSON to this file",
+			},
+		},
+		Action: withClient(importMessages),
+	}
+}
+
+type importReport struct {
+	Imported int64           `json:"imported"`
+	Skipped  int64           `json:"skipped"`
+	Failures []importFailure `json:"failures"`
+}
+
+type importFailure struct {
+	ID     string `json:"id"`
+	Folder string `json:"folder"`
+	Error  string `json:"error"`
+}
+
+func newImportReport(res *grpc.ImportMessagesProgress) importReport {
+	return importReport{
+		Imported: res.Imported,
+		Skipped:  res.Skipped,
+		Failures: xslices.Map(res.Failures, func(failure *grpc.ImportFailure) importFailure {
+			return importFailure{ID: failure.Id, Folder: failure.Folder, Error: failure.Error}
+		}),
+	}
+}
+
+func importMessages(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 2 {
+		return usageError(c)
+	}
+
+	mapping := make(map[string]string)
+
+	for _, value := range c.StringSlice(flagMap) {
+		folder, mailbox, ok := strings.Cut(value, "=")
+		if !ok || folder == "" || mailbox == "" {
+			return cli.Exit(fmt.Sprintf("invalid mapping %q, must be folder=mailbox", value), exitCodeUsage)
+		}
+
+		mapping[folder] = mailbox
+	}
+
+	user, err := findAccount(c, client, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	// The running instance might not share the working directory of this command.
+	source, err := filepath.Abs(c.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	stream, err := client.ImportMessages(c.Context, &grpc.ImportMessagesRequest{
+		UserID:   user.Id,
+		Source:   source,
+		Mapping:  mapping,
+		AsLabels: c.Bool(flagLabels),
+		Address:  c.String(flagAddress),
+		Restart:  c.Bool(flagRestart),
+	})
+	if err != nil {
+		return err
+	}
+
+	var res *grpc.ImportMessagesProgress
+
+	for {
+		progress, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if progress.Done {
+			res = progress
+			break
+		}
+
+		if _, err := fmt.Fprintf(c.App.ErrWriter, "imported %v, skipped %v, failed %v\n", progress.Imported, progress.Skipped, progress.Failed); err != nil {
+			return err
+		}
+	}
+
+	if res == nil {
+		return cli.Exit("the import was interrupted, run it again to resume it", exitCodeError)
+	}
+
+	report := newImportReport(res)
+
+	if c.IsSet(flagReport) {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(c.Path(flagReport), data, 0o600); err != nil {
+			return err
+		}
+	}
+
+	if c.Bool(flagJSON) {
+		if report.Failures == nil {
+			report.Failures = []importFailure{}
+		}
+
+		if err := printJSON(c, report); err != nil {
+			return err
+		}
+	} else {
+		for _, failure := range report.Failures {
+			if _, err := fmt.Fprintf(c.App.Writer, "%v (%v): %v\n", failure.ID, failure.Folder, failure.Error); err != nil {
+				return err
+			}
+		}
+
+		if _, err := fmt.Fprintf(c.App.Writer, "imported %v, skipped %v as already imported, failed %v\n",
+			report.Imported, report.Skipped, len(report.Failures)); err != nil {
+			return err
+		}
+	}
+
+	if len(report.Failures) > 0 {
+		return cli.Exit(fmt.Sprintf("%v message(s) could not be imported, run the import again to retry them", len(report.Failures)), exitCodeError)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/grpc"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCommands_FindUser(t *testing.T) {
+	users := []*grpc.User{
+		{Id: "id-1", Username: "alice", Addresses: []string{"alice@proton.me", "alias@proton.me"}},
+		{Id: "id-2", Username: "bob", Addresses: []string{"bob@proton.me"}},
+	}
+
+	for _, query := range []string{"id-1", "Alice", "ALIAS@proton.me"} {
+		user, err := findUser(users, query)
+		require.NoError(t, err)
+		require.Equal(t, "id-1", user.Id)
+	}
+
+	_, err := findUser(users, "carol")
+	requireExitCode(t, exitCodeUsage, err)
+}
+
+func TestCommands_FindVaultUser(t *testing.T) {
+	v, _, err := vault.New(t.TempDir(), t.TempDir(), []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+
+	user, err := v.AddUser("id-1", "alice", "alice@proton.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+	require.NoError(t, user.Close())
+
+	for _, query := range []string{"id-1", "Alice", "ALICE@proton.me"} {
+		userID, err := findVaultUser(v, query)
+		require.NoError(t, err)
+		require.Equal(t, "id-1", userID)
+	}
+
+	_, err = findVaultUser(v, "bob")
+	requireExitCode(t, exitCodeUsage, err)
+
+	require.Equal(t, "bridge vault fix clear-sync id-1", issueFix(vault.Issue{UserID: "id-1", Fix: vault.FixClearSync}))
+	require.Equal(t, "bridge vault fix reset-ports", issueFix(vault.Issue{Fix: vault.FixResetPorts}))
+
+	idx := 1
+	require.Equal(t, "bridge vault fix remove-user --index 1", issueFix(vault.Issue{UserIndex: &idx, Fix: vault.FixRemoveUser}))
+}
+
+func TestCommands_ExitCodes(t *testing.T) {
+	requireExitCode(t, exitCodeUsage, toExitError(status.Error(codes.NotFound, "unknown setting")))
+	requireExitCode(t, exitCodeUsage, toExitError(status.Error(codes.InvalidArgument, "invalid value")))
+	requireExitCode(t, exitCodeError, toExitError(status.Error(codes.Internal, "failure")))
+	requireExitCode(t, exitCodeError, toExitError(errors.New("failure")))
+	requireExitCode(t, exitCodeNotRunning, toExitError(cli.Exit("not running", exitCodeNotRunning)))
+}
+
+func requireExitCode(t *testing.T, code int, err error) {
+	var exitErr cli.ExitCoder
+
+	require.ErrorAs(t, err, &exitErr)
+	require.Equal(t, code, exitErr.ExitCode())
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/grpc"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	flagFrom      = "from"
+	flagSignature = "signature"
+	flagVersion   = "version"
+	flagNoRestart = "no-restart"
+)
+
+func newUpdateCommand(jsonFlag cli.Flag) *cli.Command {
+	return &cli.Command{
+		Name:  "update",
+		Usage: "Update the running instance",
+		Description: "A bad version is neither installed again nor started by the launcher, and bridge also rolls " +
+			"back by itself when it crashes within 2 minutes of starting as many times in a row as set with the " +
+			"update-rollback-crashes setting, 3 by default, 0 never rolling back. The pinned and bad versions and this " +
+			"setting are kept in versions.json in the updates folder.",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "install",
+				Usage: "Install an update package supplied locally, signed like the downloaded ones, then restart",
+				Description: "The package is verified like the downloaded packages, for machines which cannot reach " +
+					"the update host. The restart starts the new version when bridge was launched by the launcher.",
+				Flags: []cli.Flag{
+					&cli.PathFlag{
+						Name:     flagFrom,
+						Usage:    "The update package to install",
+						Required: true,
+					},
+					&cli.PathFlag{
+						Name:  flagSignature,
+						Usage: "The detached signature of the package, by default the package path followed by .sig",
+					},
+					&cli.StringFlag{
+						Name:  flagVersion,
+						Usage: "The version the package must have, which is read from the package",
+					},
+					&cli.BoolFlag{
+						Name:  flagNoRestart,
+						Usage: "Do not restart once the update is installed; it is used from the next start",
+					},
+				},
+				Action: withClient(updateInstall),
+			},
+			{
+				Name:        "rollback",
+				Usage:       "Mark the running version as bad and restart with the previous one",
+				Description: "The previous verified version is the bundled one when running an update.",
+				Action:      withClient(updateRollback),
+			},
+		},
+	}
+}
+
+func updateInstall(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 0 {
+		return usageError(c)
+	}
+
+	// The running instance might not share the working directory of this command.
+	pkgPath, err := filepath.Abs(c.Path(flagFrom))
+	if err != nil {
+		return err
+	}
+
+	var sigPath string
+
+	if c.IsSet(flagSignature) {
+		if sigPath, err = filepath.Abs(c.Path(flagSignature)); err != nil {
+			return err
+		}
+	}
+
+	if _, err := client.InstallUpdateFromFile(c.Context, &grpc.InstallUpdateFromFileRequest{
+		PackagePath:   pkgPath,
+		SignaturePath: sigPath,
+		Version:       c.String(flagVersion),
+		Restart:       !c.Bool(flagNoRestart),
+	}); err != nil {
+		return err
+	}
+
+	if c.Bool(flagNoRestart) {
+		_, err = fmt.Fprintln(c.App.Writer, "update installed, it is used from the next start")
+	} else {
+		_, err = fmt.Fprintln(c.App.Writer, "update installed, restarting")
+	}
+
+	return err
+}
+
+func updateRollback(c *cli.Context, client grpc.BridgeClient) error {
+	if c.NArg() != 0 {
+		return usageError(c)
+	}
+
+	if _, err := client.Rollback(c.Context, &emptypb.Empty{}); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintln(c.App.Writer, "rolled back, restarting")
+
+	return err
+}
@@ -0,0 +1,407 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/locations"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapsmtpserver"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	"github.com/allan-simon/go-singleinstance"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagGluonKeys = "gluon-keys"
+	flagIndex     = "index"
+)
+
+func newVaultCommand(jsonFlag cli.Flag) *cli.Command {
+	return &cli.Command{
+		Name:  "vault",
+		Usage: "Maintain the vault while bridge is not running",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "rekey",
+				Usage: "Encrypt the vault with a new key and replace the key in the keychain",
+				Description: "An interrupted rotation of the keys of the local message stores is completed on the " +
+					"next start.",
+				Flags: []cli.Flag{
+					jsonFlag,
+					&cli.BoolFlag{
+						Name:  flagGluonKeys,
+						Usage: "Also rotate the keys of the accounts' local message stores and re-encrypt them",
+					},
+				},
+				Action: withOfflineVault(vaultRekey),
+			},
+			{
+				Name:   "dump",
+				Usage:  "Print the content of the vault as JSON, without the secrets",
+				Action: withOfflineVault(vaultDump),
+			},
+			{
+				Name:  "check",
+				Usage: "Print the inconsistencies found in the vault and the fix of each",
+				Description: "The command exits with 1 if there are any inconsistencies, each being repaired by the " +
+					"vault fix command printed along with it.",
+				Flags:  []cli.Flag{jsonFlag},
+				Action: withOfflineVault(vaultCheck),
+			},
+			{
+				Name:  "fix",
+				Usage: "Repair the vault",
+				Subcommands: []*cli.Command{
+					{
+						Name:      vault.FixClearSync,
+						Usage:     "Clear the sync status of an account, which is fully synced again on the next start",
+						ArgsUsage: "<id|username|address>",
+						Flags:     []cli.Flag{jsonFlag},
+						Action:    withOfflineVault(vaultFixUser(vault.FixClearSync, (*vault.Vault).ClearUserSyncStatus)),
+					},
+					{
+						Name:      vault.FixClearGluonIDs,
+						Usage:     "Forget the local mailboxes of an account, which are created and fully synced again on the next start",
+						ArgsUsage: "<id|username|address>",
+						Flags:     []cli.Flag{jsonFlag},
+						Action:    withOfflineVault(vaultFixUser(vault.FixClearGluonIDs, (*vault.Vault).ClearUserGluonIDs)),
+					},
+					{
+						Name:      vault.FixRemoveUser,
+						Usage:     "Remove an account from the vault, it must be added again",
+						ArgsUsage: "<id|username|address>",
+						Flags: []cli.Flag{
+							jsonFlag,
+							&cli.IntFlag{
+								Name:  flagIndex,
+								Usage: "Remove the account at this position in the vault instead, for the accounts without ID",
+							},
+						},
+						Action: withOfflineVault(vaultFixRemoveUser),
+					},
+					{
+						Name:   vault.FixResetPorts,
+						Usage:  "Set the IMAP and SMTP ports back to their defaults, or the first free ports after them",
+						Flags:  []cli.Flag{jsonFlag},
+						Action: withOfflineVault(vaultFixResetPorts),
+					},
+				},
+			},
+		},
+	}
+}
+
+// offlineVault is the vault of a bridge instance that is not running, opened by the vault commands.
+type offlineVault struct {
+	vault    *vault.Vault
+	keychain *keychain.Keychain
+}
+
+// withOfflineVault opens the vault for the commands working on the bridge files directly, which requires bridge
+// not to be running. Unlike at startup, a vault that can't be decrypted is left alone rather than reset.
+func withOfflineVault(fn func(*cli.Context, *offlineVault) error) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		// The keychain helpers log their availability, which is of no interest here.
+		logrus.SetLevel(logrus.ErrorLevel)
+
+		return WithLocations(func(locations *locations.Locations) error {
+			vaultDir, err := locations.ProvideSettingsPath()
+			if err != nil {
+				return cli.Exit(err, exitCodeError)
+			}
+
+			lock, err := singleinstance.CreateLockFile(locations.GetLockFile())
+			if err != nil {
+				return cli.Exit("bridge is running, it must be quit first", exitCodeRunning)
+			}
+			defer func() { _ = lock.Close() }()
+
+			kc, key, err := loadOfflineVaultKey(vaultDir)
+			if err != nil {
+				return cli.Exit(err, exitCodeError)
+			}
+
+			if err := vault.Check(vaultDir, key); err != nil {
+				return cli.Exit(fmt.Sprintf("could not open vault: %v", err), exitCodeError)
+			}
+
+			gluonCacheDir, err := locations.ProvideGluonCachePath()
+			if err != nil {
+				return cli.Exit(err, exitCodeError)
+			}
+
+			v, _, err := vault.New(vaultDir, gluonCacheDir, key, async.NoopPanicHandler{})
+			if err != nil {
+				return cli.Exit(fmt.Sprintf("could not open vault: %v", err), exitCodeError)
+			}
+			defer func() { _ = v.Close() }()
+
+			if err := fn(c, &offlineVault{vault: v, keychain: kc}); err != nil {
+				return toExitError(err)
+			}
+
+			return nil
+		})
+	}
+}
+
+// loadOfflineVaultKey gets the vault key from the keychain bridge last used, without creating one if there is none.
+func loadOfflineVaultKey(vaultDir string) (*keychain.Keychain, []byte, error) {
+	helper, err := vault.GetHelper(vaultDir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get keychain helper: %w", err)
+	}
+
+	keychains := keychain.NewList()
+
+	kc, _, err := keychain.NewKeychain(helper, constants.KeyChainName, keychains.GetHelpers(), keychains.GetDefaultHelper())
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create keychain: %w", err)
+	}
+
+	if err := vault.RecoverRekey(vaultDir, kc); err != nil {
+		return nil, nil, fmt.Errorf("could not recover vault key rotation: %w", err)
+	}
+
+	key, err := vault.GetVaultKey(kc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get vault key: %w", err)
+	}
+
+	return kc, key, nil
+}
+
+type vaultRekeyResult struct {
+	GluonKeysRotated []string `json:"gluonKeysRotated"`
+}
+
+func vaultRekey(c *cli.Context, v *offlineVault) error {
+	if c.NArg() != 0 {
+		return usageError(c)
+	}
+
+	if err := vault.Rekey(v.vault, v.keychain); err != nil {
+		return fmt.Errorf("could not rotate vault key: %w", err)
+	}
+
+	result := vaultRekeyResult{GluonKeysRotated: []string{}}
+
+	if c.Bool(flagGluonKeys) {
+		storeDir := imapsmtpserver.ApplyGluonCachePathSuffix(v.vault.GetGluonCacheDir())
+
+		if err := v.vault.ForUser(1, func(user *vault.User) error {
+			if err := user.RotateGluonKey(storeDir); err != nil {
+				return fmt.Errorf("could not rotate gluon key of %v: %w", user.Username(), err)
+			}
+
+			result.GluonKeysRotated = append(result.GluonKeysRotated, user.Username())
+
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	if c.Bool(flagJSON) {
+		return printJSON(c, result)
+	}
+
+	if _, err := fmt.Fprintln(c.App.Writer, "vault key: rotated"); err != nil {
+		return err
+	}
+
+	for _, username := range result.GluonKeysRotated {
+		if _, err := fmt.Fprintf(c.App.Writer, "%v: gluon key rotated\n", username); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func vaultDump(c *cli.Context, v *offlineVault) error {
+	if c.NArg() != 0 {
+		return usageError(c)
+	}
+
+	dump, err := v.vault.DumpRedacted()
+	if err != nil {
+		return fmt.Errorf("could not dump vault: %w", err)
+	}
+
+	_, err = c.App.Writer.Write(dump)
+
+	return err
+}
+
+func vaultCheck(c *cli.Context, v *offlineVault) error {
+	if c.NArg() != 0 {
+		return usageError(c)
+	}
+
+	issues := v.vault.Validate()
+
+	if c.Bool(flagJSON) {
+		if issues == nil {
+			issues = []vault.Issue{}
+		}
+
+		if err := printJSON(c, issues); err != nil {
+			return err
+		}
+	} else {
+		for _, issue := range issues {
+			if _, err := fmt.Fprintf(c.App.Writer, "%v: %v (fix: %v)\n", issueTarget(issue), issue.Problem, issueFix(issue)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(issues) > 0 {
+		return cli.Exit(fmt.Sprintf("%v issue(s) found", len(issues)), exitCodeError)
+	}
+
+	if !c.Bool(flagJSON) {
+		if _, err := fmt.Fprintln(c.App.Writer, "no issue found"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func issueTarget(issue vault.Issue) string {
+	if issue.UserIndex != nil {
+		return fmt.Sprintf("user at index %v", *issue.UserIndex)
+	}
+
+	if issue.UserID == "" {
+		return "settings"
+	}
+
+	return issue.UserID
+}
+
+// issueFix returns the command applying the fix of the issue.
+func issueFix(issue vault.Issue) string {
+	if issue.UserIndex != nil {
+		return fmt.Sprintf("bridge vault fix %v --%v %v", issue.Fix, flagIndex, *issue.UserIndex)
+	}
+
+	if issue.UserID == "" {
+		return "bridge vault fix " + issue.Fix
+	}
+
+	return fmt.Sprintf("bridge vault fix %v %v", issue.Fix, issue.UserID)
+}
+
+type vaultFixResult struct {
+	Fix       string `json:"fix"`
+	UserID    string `json:"userID,omitempty"`
+	UserIndex *int   `json:"userIndex,omitempty"`
+}
+
+// vaultFixUser returns the action applying a fix to the user given as argument.
+func vaultFixUser(fix string, apply func(*vault.Vault, string) error) func(*cli.Context, *offlineVault) error {
+	return func(c *cli.Context, v *offlineVault) error {
+		if c.NArg() != 1 {
+			return usageError(c)
+		}
+
+		userID, err := findVaultUser(v.vault, c.Args().Get(0))
+		if err != nil {
+			return err
+		}
+
+		if err := apply(v.vault, userID); err != nil {
+			return fmt.Errorf("could not apply %v: %w", fix, err)
+		}
+
+		return printFixResult(c, vaultFixResult{Fix: fix, UserID: userID})
+	}
+}
+
+// vaultFixRemoveUser removes the user given as argument, or at the position given by the index flag.
+func vaultFixRemoveUser(c *cli.Context, v *offlineVault) error {
+	if !c.IsSet(flagIndex) {
+		return vaultFixUser(vault.FixRemoveUser, (*vault.Vault).DeleteUser)(c, v)
+	}
+
+	if c.NArg() != 0 {
+		return usageError(c)
+	}
+
+	idx := c.Int(flagIndex)
+
+	if err := v.vault.DeleteUserAt(idx); err != nil {
+		return fmt.Errorf("could not apply %v: %w", vault.FixRemoveUser, err)
+	}
+
+	return printFixResult(c, vaultFixResult{Fix: vault.FixRemoveUser, UserIndex: &idx})
+}
+
+func vaultFixResetPorts(c *cli.Context, v *offlineVault) error {
+	if c.NArg() != 0 {
+		return usageError(c)
+	}
+
+	if err := v.vault.ResetPorts(); err != nil {
+		return fmt.Errorf("could not reset ports: %w", err)
+	}
+
+	return printFixResult(c, vaultFixResult{Fix: vault.FixResetPorts})
+}
+
+func printFixResult(c *cli.Context, result vaultFixResult) error {
+	if c.Bool(flagJSON) {
+		return printJSON(c, result)
+	}
+
+	_, err := fmt.Fprintf(c.App.Writer, "%v: %v applied\n", issueTarget(vault.Issue{UserID: result.UserID, UserIndex: result.UserIndex}), result.Fix)
+
+	return err
+}
+
+// findVaultUser returns the ID of the vault user with the given ID, username or primary address.
+func findVaultUser(v *vault.Vault, query string) (string, error) {
+	for _, userID := range v.GetUserIDs() {
+		if userID == query {
+			return userID, nil
+		}
+
+		var found bool
+
+		if err := v.GetUser(userID, func(user *vault.User) {
+			found = strings.EqualFold(user.Username(), query) || strings.EqualFold(user.PrimaryEmail(), query)
+		}); err != nil {
+			return "", err
+		}
+
+		if found {
+			return userID, nil
+		}
+	}
+
+	return "", cli.Exit(fmt.Sprintf("no such account: %v", query), exitCodeUsage)
+}
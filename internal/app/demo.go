@@ -0,0 +1,197 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/gluon/imap"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/crash"
+	"github.com/ProtonMail/proton-bridge/v3/internal/locations"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+	"github.com/ProtonMail/proton-bridge/v3/internal/policy"
+	"github.com/ProtonMail/proton-bridge/v3/internal/sentry"
+	"github.com/ProtonMail/proton-bridge/v3/internal/useragent"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/restarter"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// demoFS holds the fixture used in demo mode when none is given.
+//
+//go:embed demo
+var demoFS embed.FS
+
+// runDemo runs bridge against a fake API server seeded from a fixture, for mail client development.
+// The fixture users are logged in and their bridge passwords printed. All the data is kept in a temporary directory
+// removed when bridge stops, so that each run starts from the fixture.
+func runDemo(c *cli.Context, version *semver.Version, identifier *useragent.UserAgent) error {
+	fixture, fsys, err := loadDemoFixture(c.Path(flagDemoFixture))
+	if err != nil {
+		return fmt.Errorf("could not load demo fixture: %w", err)
+	}
+
+	dir, err := os.MkdirTemp("", "bridge-demo-")
+	if err != nil {
+		return fmt.Errorf("could not create demo directory: %w", err)
+	}
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	api := server.New(server.WithTLS(false), server.WithDomain(fixture.Domain))
+	defer api.Close()
+
+	if err := fixture.seed(c.Context, api, fsys); err != nil {
+		return fmt.Errorf("could not seed demo server: %w", err)
+	}
+
+	// Without a frontend, bridge runs until interrupted.
+	if !c.Bool(flagCLI) && !c.Bool(flagGRPC) {
+		if err := c.Set(flagNonInteractive, "true"); err != nil {
+			return err
+		}
+	}
+
+	// The app is never restarted in demo mode.
+	restarter := restarter.New("")
+
+	quitCh := make(chan struct{})
+	quit := sync.OnceFunc(func() { close(quitCh) })
+
+	crashHandler := crash.NewHandler(func(any) error { quit(); return nil })
+	defer async.HandlePanic(crashHandler)
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+
+	go func() {
+		if _, ok := <-sigCh; ok {
+			quit()
+		}
+	}()
+
+	locations := locations.New(bridge.NewTestLocationsProvider(dir), constants.ConfigName)
+
+	var logCloser io.Closer
+	defer func() {
+		_ = logging.Close(logCloser)
+	}()
+
+	return withLogging(c, crashHandler, locations, func(closer io.Closer) error {
+		logCloser = closer
+
+		logrus.WithField("url", api.GetHostURL()).Warn("Running in demo mode against a fake server")
+
+		// The vault key is kept in memory rather than in the keychain.
+		keychains := keychain.NewTestKeychainsList()
+
+		return WithVault(nil, locations, keychains, crashHandler, func(v *vault.Vault, _, _ bool) error {
+			return withCookieJar(v, func(cookieJar http.CookieJar) error {
+				b, eventCh, err := bridge.New(
+					locations,
+					v,
+					demoAutostarter{},
+					bridge.NewTestUpdater(version, version),
+					version,
+					keychains,
+					&policy.Policy{},
+
+					api.GetHostURL(),
+					cookieJar,
+					identifier,
+					demoNetwork{},
+					http.DefaultTransport,
+					demoNetwork{},
+
+					crashHandler,
+					sentry.NullSentryReporter{},
+					imap.DefaultEpochUIDValidityGenerator(),
+					nil,
+
+					c.String(flagLogIMAP) == "client" || c.String(flagLogIMAP) == "all",
+					c.String(flagLogIMAP) == "server" || c.String(flagLogIMAP) == "all",
+					c.Bool(flagLogSMTP),
+				)
+				if err != nil {
+					return fmt.Errorf("could not create bridge: %w", err)
+				}
+				defer b.Close(c.Context)
+
+				if err := loginDemoUsers(c, b, fixture); err != nil {
+					return err
+				}
+
+				return runFrontend(c, crashHandler, restarter, locations, b, eventCh, quitCh, c.Int(flagParentPID))
+			})
+		})
+	})
+}
+
+// loginDemoUsers logs the fixture users in and prints how the mail clients connect to each of them.
+func loginDemoUsers(c *cli.Context, b *bridge.Bridge, fixture *demoFixture) error {
+	if _, err := fmt.Fprintf(c.App.Writer, "Demo mode: IMAP on 127.0.0.1:%v (SSL %v), SMTP on 127.0.0.1:%v (SSL %v)\n",
+		b.GetIMAPPort(), b.GetIMAPSSL(), b.GetSMTPPort(), b.GetSMTPSSL()); err != nil {
+		return err
+	}
+
+	for _, user := range fixture.Users {
+		userID, err := b.LoginFull(c.Context, user.Username, []byte(user.Password), nil, nil)
+		if err != nil {
+			return fmt.Errorf("could not log in demo user %v: %w", user.Username, err)
+		}
+
+		info, err := b.GetUserInfo(userID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(c.App.Writer, "  %v: password %s, addresses %v\n", user.Username, info.BridgePass, info.Addresses); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// demoAutostarter never starts the app with the system, as the demo data does not outlive it.
+type demoAutostarter struct{}
+
+func (demoAutostarter) Enable() error   { return nil }
+func (demoAutostarter) Disable() error  { return nil }
+func (demoAutostarter) IsEnabled() bool { return false }
+
+// demoNetwork is the proxy controller and TLS reporter of the demo mode, where the fake server is reached directly.
+type demoNetwork struct{}
+
+func (demoNetwork) AllowProxy()                    {}
+func (demoNetwork) DisallowProxy()                 {}
+func (demoNetwork) GetTLSIssueCh() <-chan struct{} { return nil }
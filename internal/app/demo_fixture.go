@@ -0,0 +1,313 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/mail"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/localmail"
+	"github.com/bradenaw/juniper/stream"
+)
+
+const demoFixtureName = "fixture.json"
+
+// demoFixture describes the content of the fake server of the demo mode.
+type demoFixture struct {
+	// Domain is the domain of the primary addresses, `<username>@<domain>`.
+	Domain string `json:"domain"`
+
+	Users []demoUser `json:"users"`
+}
+
+type demoUser struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+
+	// Addresses are the addresses of the user besides the primary one.
+	Addresses []string `json:"addresses"`
+
+	Labels   []demoLabel    `json:"labels"`
+	Messages []demoMessages `json:"messages"`
+}
+
+type demoLabel struct {
+	Name string `json:"name"`
+
+	// Type is either `folder` or `label`.
+	Type string `json:"type"`
+}
+
+// demoMessages are the messages of an mbox file imported into a mailbox.
+type demoMessages struct {
+	// Mbox is the path of the mbox file, relative to the fixture.
+	Mbox string `json:"mbox"`
+
+	// Mailbox is a system mailbox (Inbox, Sent, Archive, Trash or Spam) or one of the user's labels.
+	// The messages are put in the inbox by default, and when they are given a label which is not a folder.
+	Mailbox string `json:"mailbox"`
+
+	Unread bool `json:"unread"`
+}
+
+// loadDemoFixture loads the fixture at the given path, or the built-in one if empty. The returned file system holds
+// the mbox files it refers to.
+func loadDemoFixture(fixturePath string) (*demoFixture, fs.FS, error) {
+	var (
+		fsys fs.FS
+		name = demoFixtureName
+		err  error
+	)
+
+	if fixturePath == "" {
+		if fsys, err = fs.Sub(demoFS, "demo"); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		fsys, name = os.DirFS(filepath.Dir(fixturePath)), filepath.Base(fixturePath)
+	}
+
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fixture demoFixture
+
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, nil, err
+	}
+
+	if fixture.Domain == "" {
+		fixture.Domain = "proton.local"
+	}
+
+	for _, user := range fixture.Users {
+		if user.Username == "" || user.Password == "" {
+			return nil, nil, errors.New("the users must have a username and a password")
+		}
+	}
+
+	return &fixture, fsys, nil
+}
+
+// seed creates the users of the fixture on the fake server, with their addresses, labels and messages.
+func (fixture *demoFixture) seed(ctx context.Context, api *server.Server, fsys fs.FS) error {
+	m := proton.New(proton.WithHostURL(api.GetHostURL()), proton.WithTransport(http.DefaultTransport))
+	defer m.Close()
+
+	for _, user := range fixture.Users {
+		userID, _, err := api.CreateUser(user.Username, []byte(user.Password))
+		if err != nil {
+			return fmt.Errorf("could not create user %v: %w", user.Username, err)
+		}
+
+		for _, email := range user.Addresses {
+			if _, err := api.CreateAddress(userID, email, []byte(user.Password), true); err != nil {
+				return fmt.Errorf("could not create address %v: %w", email, err)
+			}
+		}
+
+		labelIDs := make(map[string]string)
+		folders := make(map[string]bool)
+
+		for _, label := range user.Labels {
+			labelType := proton.LabelTypeLabel
+			if label.Type == "folder" {
+				labelType = proton.LabelTypeFolder
+			}
+
+			labelID, err := api.CreateLabel(userID, label.Name, "", labelType)
+			if err != nil {
+				return fmt.Errorf("could not create label %v: %w", label.Name, err)
+			}
+
+			labelIDs[strings.ToLower(label.Name)] = labelID
+			folders[labelID] = labelType == proton.LabelTypeFolder
+		}
+
+		if len(user.Messages) == 0 {
+			continue
+		}
+
+		if err := importDemoMessages(ctx, m, user, fsys, labelIDs, folders); err != nil {
+			return fmt.Errorf("could not import messages of %v: %w", user.Username, err)
+		}
+	}
+
+	return nil
+}
+
+// importDemoMessages imports the mbox files of the user, each message into the address it was sent to
+// (or sent from, for the Sent mailbox), the primary address otherwise.
+func importDemoMessages(
+	ctx context.Context,
+	m *proton.Manager,
+	user demoUser,
+	fsys fs.FS,
+	labelIDs map[string]string,
+	folders map[string]bool,
+) error {
+	c, _, err := m.NewClientWithLogin(ctx, user.Username, []byte(user.Password))
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	apiUser, err := c.GetUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	addresses, err := c.GetAddresses(ctx)
+	if err != nil {
+		return err
+	}
+
+	salts, err := c.GetSalts(ctx)
+	if err != nil {
+		return err
+	}
+
+	keyPass, err := salts.SaltForKey([]byte(user.Password), apiUser.Keys.Primary().ID)
+	if err != nil {
+		return err
+	}
+
+	_, addrKRs, err := proton.Unlock(apiUser, addresses, keyPass, async.NoopPanicHandler{})
+	if err != nil {
+		return err
+	}
+
+	reqs := make(map[string][]proton.ImportReq)
+
+	for _, messages := range user.Messages {
+		data, err := fs.ReadFile(fsys, path.Clean(filepath.ToSlash(messages.Mbox)))
+		if err != nil {
+			return err
+		}
+
+		mailboxIDs, flags, err := getDemoMailbox(messages.Mailbox, labelIDs, folders)
+		if err != nil {
+			return err
+		}
+
+		if err := localmail.ReadMbox(bytes.NewReader(data), func(msg localmail.Message) error {
+			addrID := getDemoAddressID(msg.Literal, addresses, flags == proton.MessageFlagSent)
+
+			reqs[addrID] = append(reqs[addrID], proton.ImportReq{
+				Metadata: proton.ImportMetadata{
+					AddressID: addrID,
+					LabelIDs:  mailboxIDs,
+					Unread:    proton.Bool(messages.Unread),
+					Flags:     flags,
+				},
+				Message: msg.Literal,
+			})
+
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	for addrID, addrReqs := range reqs {
+		res, err := c.ImportMessages(ctx, addrKRs[addrID], 1, 1, addrReqs...)
+		if err != nil {
+			return err
+		}
+
+		if _, err := stream.Collect(ctx, res); err != nil {
+			return err
+		}
+	}
+
+	return c.AuthDelete(ctx)
+}
+
+// getDemoMailbox returns the label IDs and the flags of the messages imported into the given mailbox.
+func getDemoMailbox(mailbox string, labelIDs map[string]string, folders map[string]bool) ([]string, proton.MessageFlag, error) {
+	switch strings.ToLower(mailbox) {
+	case "", "inbox":
+		return []string{proton.InboxLabel}, proton.MessageFlagReceived, nil
+
+	case "sent":
+		return []string{proton.SentLabel}, proton.MessageFlagSent, nil
+
+	case "archive":
+		return []string{proton.ArchiveLabel}, proton.MessageFlagReceived, nil
+
+	case "trash":
+		return []string{proton.TrashLabel}, proton.MessageFlagReceived, nil
+
+	case "spam":
+		return []string{proton.SpamLabel}, proton.MessageFlagReceived, nil
+	}
+
+	labelID, ok := labelIDs[strings.ToLower(mailbox)]
+	if !ok {
+		return nil, 0, fmt.Errorf("unknown mailbox %q", mailbox)
+	}
+
+	// The messages must be in a folder.
+	if !folders[labelID] {
+		return []string{proton.InboxLabel, labelID}, proton.MessageFlagReceived, nil
+	}
+
+	return []string{labelID}, proton.MessageFlagReceived, nil
+}
+
+// getDemoAddressID returns the ID of the address the message was sent to, or sent from, the primary one otherwise.
+func getDemoAddressID(literal []byte, addresses []proton.Address, sent bool) string {
+	headers := []string{"To", "Cc", "Delivered-To"}
+	if sent {
+		headers = []string{"From"}
+	}
+
+	if msg, err := mail.ReadMessage(bytes.NewReader(literal)); err == nil {
+		for _, header := range headers {
+			list, err := msg.Header.AddressList(header)
+			if err != nil {
+				continue
+			}
+
+			for _, recipient := range list {
+				for _, address := range addresses {
+					if strings.EqualFold(address.Email, recipient.Address) {
+						return address.ID
+					}
+				}
+			}
+		}
+	}
+
+	return addresses[0].ID
+}
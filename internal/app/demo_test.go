@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDemo_SeedFixture(t *testing.T) {
+	fixture, fsys, err := loadDemoFixture("")
+	require.NoError(t, err)
+	require.Equal(t, "proton.local", fixture.Domain)
+
+	s := server.New(server.WithTLS(false), server.WithDomain(fixture.Domain))
+	defer s.Close()
+
+	require.NoError(t, fixture.seed(context.Background(), s, fsys))
+
+	m := proton.New(proton.WithHostURL(s.GetHostURL()))
+	defer m.Close()
+
+	c, _, err := m.NewClientWithLogin(context.Background(), "alice", []byte("password"))
+	require.NoError(t, err)
+	defer c.Close()
+
+	addresses, err := c.GetAddresses(context.Background())
+	require.NoError(t, err)
+	require.Len(t, addresses, 2)
+
+	labels, err := c.GetLabels(context.Background(), proton.LabelTypeFolder, proton.LabelTypeLabel)
+	require.NoError(t, err)
+	require.Len(t, labels, 2)
+
+	metadata, err := c.GetMessageMetadata(context.Background(), proton.MessageFilter{})
+	require.NoError(t, err)
+	require.Len(t, metadata, 4)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	bridgeCLI "github.com/ProtonMail/proton-bridge/v3/internal/frontend/cli"
 	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/grpc"
+	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/notifier"
 	"github.com/ProtonMail/proton-bridge/v3/internal/locations"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/restarter"
 	"github.com/sirupsen/logrus"
@@ -46,9 +47,13 @@ func runFrontend(
 
 	switch {
 	case c.Bool(flagCLI):
+		defer runNotifier(crashHandler, bridge)()
+
 		return bridgeCLI.New(bridge, restarter, eventCh, crashHandler, quitCh).Loop()
 
 	case c.Bool(flagNonInteractive):
+		defer runNotifier(crashHandler, bridge)()
+
 		<-quitCh
 		return nil
 
@@ -68,3 +73,13 @@ func runFrontend(
 		return fmt.Errorf("no frontend specified, use --cli, --grpc or --noninteractive")
 	}
 }
+
+// runNotifier raises desktop notifications for the events requiring attention, as there is no GUI to display them.
+// The returned function stops it.
+func runNotifier(crashHandler *crash.Handler, bridge *bridge.Bridge) func() {
+	eventCh, done := bridge.GetEvents(notifier.Events()...)
+
+	go notifier.New(bridge, crashHandler).Run(eventCh)
+
+	return done
+}
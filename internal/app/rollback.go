@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/ProtonMail/proton-bridge/v3/internal/crash"
+	"github.com/ProtonMail/proton-bridge/v3/internal/locations"
+	"github.com/ProtonMail/proton-bridge/v3/internal/versioner"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/restarter"
+	"github.com/sirupsen/logrus"
+)
+
+// startupDuration is how long after start a crash still counts as a startup crash.
+const startupDuration = 2 * time.Minute
+
+// watchStartupCrashes rolls back to the previous version if the given version keeps crashing on startup, as many times
+// as set with the update-rollback-crashes setting. The crash count is reset once the app has been running for long
+// enough, so only startup crashes are counted. The returned function stops watching.
+func watchStartupCrashes(crashHandler *crash.Handler, locations *locations.Locations, version *semver.Version) func() {
+	start := time.Now()
+
+	crashHandler.AddRecoveryAction(func(any) error {
+		if time.Since(start) > startupDuration {
+			return nil
+		}
+
+		updater, err := newUpdater(locations)
+		if err != nil {
+			return err
+		}
+
+		maxCrashes, err := updater.GetRollbackCrashes()
+		if err != nil {
+			logrus.WithError(err).Error("Failed to get the number of startup crashes to roll back after, using the default")
+			maxCrashes = versioner.DefaultRollbackCrashes
+		}
+
+		if maxCrashes == 0 || getCrashCount()+1 < maxCrashes {
+			return nil
+		}
+
+		logrus.WithField("version", version).Warn("Bridge keeps crashing on startup, rolling back to the previous version")
+
+		if err := updater.Rollback(version); err != nil {
+			return err
+		}
+
+		// The previous version gets its own startup crashes.
+		return os.Setenv(restarter.BridgeCrashCount, "0")
+	})
+
+	timer := time.AfterFunc(startupDuration, func() {
+		if err := os.Setenv(restarter.BridgeCrashCount, "0"); err != nil {
+			logrus.WithError(err).Error("Failed to reset crash count")
+		}
+	})
+
+	return func() { timer.Stop() }
+}
+
+// getCrashCount returns the number of consecutive crash restarts before this start.
+func getCrashCount() int {
+	count, err := strconv.Atoi(os.Getenv(restarter.BridgeCrashCount))
+	if err != nil {
+		return 0
+	}
+
+	return count
+}
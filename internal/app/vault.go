@@ -28,6 +28,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 	"github.com/ProtonMail/proton-bridge/v3/internal/locations"
 	"github.com/ProtonMail/proton-bridge/v3/internal/sentry"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapsmtpserver"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
 	"github.com/sirupsen/logrus"
@@ -106,6 +107,11 @@ func newVault(reporter *sentry.Reporter, locations *locations.Locations, keychai
 		return nil, false, corrupt, fmt.Errorf("could not create vault: %w", err)
 	}
 
+	// Gluon can't read the messages of a user whose gluon key rotation was interrupted, so it is completed first.
+	if err := userVault.ResumeGluonKeyRotations(imapsmtpserver.ApplyGluonCachePathSuffix(userVault.GetGluonCacheDir())); err != nil {
+		logrus.WithError(err).Error("Could not resume gluon key rotation")
+	}
+
 	// Remember the last successfully used keychain and store that as the user preference.
 	if err := vault.SetHelper(vaultDir, lastUsedHelper); err != nil {
 		logrus.WithError(err).Error("Could not store last used keychain helper")
@@ -126,6 +132,11 @@ func loadVaultKey(vaultDir string, keychains *keychain.List) (key []byte, keycha
 		return nil, keychainHelper, fmt.Errorf("could not create keychain: %w", err)
 	}
 
+	// Complete the vault key rotation if it was interrupted.
+	if err := vault.RecoverRekey(vaultDir, kc); err != nil {
+		logrus.WithError(err).Error("Could not recover vault key rotation")
+	}
+
 	key, err = vault.GetVaultKey(kc)
 	if err != nil {
 		if keychain.IsErrKeychainNoItem(err) {
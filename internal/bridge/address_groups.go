@@ -0,0 +1,178 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/bradenaw/juniper/xslices"
+	"golang.org/x/exp/slices"
+)
+
+// AddressGroupInfo describes a group of addresses which share a single mailbox in split mode,
+// the other addresses having their own.
+type AddressGroupInfo struct {
+	Name string
+
+	// Addresses are the email addresses of the group. The ID of the address is given instead if it is
+	// no longer an active address of the user.
+	Addresses []string
+}
+
+// GetAddressGroups returns the address groups of the given user.
+func (bridge *Bridge) GetAddressGroups(userID string) ([]AddressGroupInfo, error) {
+	return safe.RLockRetErr(func() ([]AddressGroupInfo, error) {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return nil, ErrNoSuchUser
+		}
+
+		emails := user.AddressEmails()
+
+		return xslices.Map(user.AddressGroups(), func(group vault.AddressGroup) AddressGroupInfo {
+			return AddressGroupInfo{
+				Name: group.Name,
+				Addresses: xslices.Map(group.AddrIDs, func(addrID string) string {
+					if email, ok := emails[addrID]; ok {
+						return email
+					}
+
+					return addrID
+				}),
+			}
+		}), nil
+	}, bridge.usersLock)
+}
+
+// SetAddressGroup creates or replaces the address group with the given name. When the user is in split mode,
+// only the mailboxes of the addresses joining or leaving the group are recreated and resynced.
+func (bridge *Bridge) SetAddressGroup(ctx context.Context, userID, name string, addresses []string) error {
+	logUser.WithField("userID", userID).WithField("name", name).Info("Setting address group")
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrInvalidAddressGroupName
+	}
+
+	if err := safe.RLockRet(func() error {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		addrIDs, err := getGroupAddrIDs(user.AddressEmails(), addresses)
+		if err != nil {
+			return err
+		}
+
+		groups := slices.Clone(user.AddressGroups())
+
+		for _, group := range groups {
+			if strings.EqualFold(group.Name, name) {
+				continue
+			}
+
+			for _, addrID := range addrIDs {
+				if slices.Contains(group.AddrIDs, addrID) {
+					return fmt.Errorf("%w: %v", ErrAddressAlreadyGrouped, group.Name)
+				}
+			}
+		}
+
+		if idx := xslices.IndexFunc(groups, func(group vault.AddressGroup) bool {
+			return strings.EqualFold(group.Name, name)
+		}); idx >= 0 {
+			groups[idx] = vault.AddressGroup{Name: name, AddrIDs: addrIDs}
+		} else {
+			groups = append(groups, vault.AddressGroup{Name: name, AddrIDs: addrIDs})
+		}
+
+		return user.SetAddressGroups(ctx, groups)
+	}, bridge.usersLock); err != nil {
+		return err
+	}
+
+	bridge.publish(events.AddressGroupsChanged{UserID: userID})
+
+	return nil
+}
+
+// RemoveAddressGroup removes the address group with the given name, its addresses getting their own mailbox again.
+func (bridge *Bridge) RemoveAddressGroup(ctx context.Context, userID, name string) error {
+	logUser.WithField("userID", userID).WithField("name", name).Info("Removing address group")
+
+	if err := safe.RLockRet(func() error {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		groups := xslices.Filter(user.AddressGroups(), func(group vault.AddressGroup) bool {
+			return !strings.EqualFold(group.Name, strings.TrimSpace(name))
+		})
+
+		if len(groups) == len(user.AddressGroups()) {
+			return ErrNoSuchAddressGroup
+		}
+
+		return user.SetAddressGroups(ctx, groups)
+	}, bridge.usersLock); err != nil {
+		return err
+	}
+
+	bridge.publish(events.AddressGroupsChanged{UserID: userID})
+
+	return nil
+}
+
+// getGroupAddrIDs returns the IDs of the given email addresses, in the same order and without duplicates.
+func getGroupAddrIDs(emails map[string]string, addresses []string) ([]string, error) {
+	var addrIDs []string
+
+	for _, address := range addresses {
+		addrID, ok := getAddrIDByEmail(emails, strings.TrimSpace(address))
+		if !ok {
+			return nil, fmt.Errorf("%w: %v", ErrNoSuchAddress, address)
+		}
+
+		if !slices.Contains(addrIDs, addrID) {
+			addrIDs = append(addrIDs, addrID)
+		}
+	}
+
+	if len(addrIDs) == 0 {
+		return nil, ErrNoSuchAddress
+	}
+
+	return addrIDs, nil
+}
+
+func getAddrIDByEmail(emails map[string]string, email string) (string, bool) {
+	for addrID, addrEmail := range emails {
+		if strings.EqualFold(addrEmail, email) {
+			return addrID, true
+		}
+	}
+
+	return "", false
+}
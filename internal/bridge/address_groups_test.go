@@ -0,0 +1,119 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/emersion/go-imap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_AddressGroups(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		userID, addrID, err := s.CreateUser("user", password)
+		require.NoError(t, err)
+
+		salesAddrID, err := s.CreateAddress(userID, "sales@"+s.GetDomain(), password, true)
+		require.NoError(t, err)
+
+		supportAddrID, err := s.CreateAddress(userID, "support@"+s.GetDomain(), password, true)
+		require.NoError(t, err)
+
+		// Each address receives one message.
+		withClient(ctx, t, s, "user", password, func(ctx context.Context, c *proton.Client) {
+			for _, addrID := range []string{addrID, salesAddrID, supportAddrID} {
+				createNumMessages(ctx, t, c, addrID, proton.InboxLabel, 1)
+			}
+		})
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, "user", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			require.NoError(t, b.SetAddressMode(ctx, userID, vault.SplitMode))
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+			require.Len(t, info.Addresses, 3)
+
+			userAddr, salesAddr, supportAddr := info.Addresses[0], info.Addresses[1], info.Addresses[2]
+
+			requireInbox := func(addr string, count uint32) {
+				require.Eventually(t, func() bool {
+					client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+					require.NoError(t, err)
+					defer func() { _ = client.Logout() }()
+
+					require.NoError(t, client.Login(addr, string(info.BridgePass)))
+
+					status, err := client.Status("INBOX", []imap.StatusItem{imap.StatusMessages})
+					require.NoError(t, err)
+
+					return status.Messages == count
+				}, 10*time.Second, 100*time.Millisecond)
+			}
+
+			requireInbox(userAddr, 1)
+			requireInbox(salesAddr, 1)
+			requireInbox(supportAddr, 1)
+
+			// Invalid groups are refused.
+			require.ErrorIs(t, b.SetAddressGroup(ctx, userID, " ", []string{salesAddr}), bridge.ErrInvalidAddressGroupName)
+			require.ErrorIs(t, b.SetAddressGroup(ctx, userID, "team", []string{"unknown@" + s.GetDomain()}), bridge.ErrNoSuchAddress)
+
+			// The grouped addresses share a mailbox, the other one keeps its own.
+			require.NoError(t, b.SetAddressGroup(ctx, userID, "team", []string{salesAddr, supportAddr, salesAddr}))
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			groups, err := b.GetAddressGroups(userID)
+			require.NoError(t, err)
+			require.Equal(t, []bridge.AddressGroupInfo{{Name: "team", Addresses: []string{salesAddr, supportAddr}}}, groups)
+
+			requireInbox(userAddr, 1)
+			requireInbox(salesAddr, 2)
+			requireInbox(supportAddr, 2)
+
+			// An address can only be part of one group.
+			require.ErrorIs(t, b.SetAddressGroup(ctx, userID, "other", []string{supportAddr}), bridge.ErrAddressAlreadyGrouped)
+
+			// Once the group is removed, the addresses have their own mailbox again.
+			require.NoError(t, b.RemoveAddressGroup(ctx, userID, "Team"))
+			require.Equal(t, userID, (<-syncCh).UserID)
+			require.ErrorIs(t, b.RemoveAddressGroup(ctx, userID, "team"), bridge.ErrNoSuchAddressGroup)
+
+			requireInbox(userAddr, 1)
+			requireInbox(salesAddr, 1)
+			requireInbox(supportAddr, 1)
+		})
+	})
+}
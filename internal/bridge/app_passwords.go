@@ -0,0 +1,154 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/bradenaw/juniper/xslices"
+)
+
+// AppPasswordInfo describes an app password, a password given to a single mail client instead of the bridge password.
+type AppPasswordInfo struct {
+	ID    string
+	Name  string
+	Scope vault.AppPasswordScope
+
+	CreatedAt time.Time
+
+	// LastUsedAt is zero if the app password was never used.
+	LastUsedAt time.Time
+}
+
+// GetAppPasswordScopes returns the scopes an app password can be created with.
+func GetAppPasswordScopes() []vault.AppPasswordScope {
+	return []vault.AppPasswordScope{
+		vault.AppPasswordScopeAll,
+		vault.AppPasswordScopeIMAP,
+		vault.AppPasswordScopeSMTP,
+		vault.AppPasswordScopeIMAPReadOnly,
+	}
+}
+
+// GetAppPasswords returns the app passwords of the given user.
+func (bridge *Bridge) GetAppPasswords(userID string) ([]AppPasswordInfo, error) {
+	return safe.RLockRetErr(func() ([]AppPasswordInfo, error) {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return nil, ErrNoSuchUser
+		}
+
+		return xslices.Map(user.AppPasswords(), newAppPasswordInfo), nil
+	}, bridge.usersLock)
+}
+
+// CreateAppPassword creates an app password for the given user. It can be used in place of the bridge password,
+// over the protocols its scope allows. The returned password can't be retrieved later.
+func (bridge *Bridge) CreateAppPassword(userID, name string, scope vault.AppPasswordScope) (AppPasswordInfo, []byte, error) {
+	logUser.WithField("userID", userID).WithField("scope", scope).Info("Creating app password")
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return AppPasswordInfo{}, nil, ErrInvalidAppPasswordName
+	}
+
+	if !scope.IsValid() {
+		return AppPasswordInfo{}, nil, fmt.Errorf("%w: %q", ErrInvalidAppPasswordScope, scope)
+	}
+
+	type result struct {
+		info     AppPasswordInfo
+		password []byte
+	}
+
+	res, err := safe.RLockRetErr(func() (result, error) {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return result{}, ErrNoSuchUser
+		}
+
+		appPassword, password, err := user.AddAppPassword(name, scope)
+		if errors.Is(err, vault.ErrAppPasswordNameTaken) {
+			return result{}, ErrAppPasswordNameTaken
+		} else if err != nil {
+			return result{}, fmt.Errorf("failed to add app password: %w", err)
+		}
+
+		return result{info: newAppPasswordInfo(appPassword), password: password}, nil
+	}, bridge.usersLock)
+	if err != nil {
+		return AppPasswordInfo{}, nil, err
+	}
+
+	bridge.publish(events.AppPasswordCreated{
+		UserID:        userID,
+		AppPasswordID: res.info.ID,
+	})
+
+	return res.info, res.password, nil
+}
+
+// RevokeAppPassword removes an app password of the given user. The IMAP and SMTP sessions using it are closed.
+func (bridge *Bridge) RevokeAppPassword(userID, appPasswordID string) error {
+	logUser.WithField("userID", userID).WithField("appPasswordID", appPasswordID).Info("Revoking app password")
+
+	if err := safe.RLockRet(func() error {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		removed, err := user.RemoveAppPassword(appPasswordID)
+		if err != nil {
+			return fmt.Errorf("failed to remove app password: %w", err)
+		}
+
+		if !removed {
+			return ErrNoSuchAppPassword
+		}
+
+		return nil
+	}, bridge.usersLock); err != nil {
+		return err
+	}
+
+	bridge.serverManager.CloseAppPasswordSessions(appPasswordID)
+
+	bridge.publish(events.AppPasswordRevoked{
+		UserID:        userID,
+		AppPasswordID: appPasswordID,
+	})
+
+	return nil
+}
+
+func newAppPasswordInfo(appPassword vault.AppPassword) AppPasswordInfo {
+	return AppPasswordInfo{
+		ID:         appPassword.ID,
+		Name:       appPassword.Name,
+		Scope:      appPassword.Scope,
+		CreatedAt:  appPassword.CreatedAt,
+		LastUsedAt: appPassword.LastUsedAt,
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/emersion/go-sasl"
+	"github.com/emersion/go-smtp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_AppPasswords(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			// Invalid names and scopes are refused.
+			_, _, err = b.CreateAppPassword(userID, " ", vault.AppPasswordScopeAll)
+			require.ErrorIs(t, err, bridge.ErrInvalidAppPasswordName)
+
+			_, _, err = b.CreateAppPassword(userID, "laptop", "pop3")
+			require.ErrorIs(t, err, bridge.ErrInvalidAppPasswordScope)
+
+			laptop, laptopPass, err := b.CreateAppPassword(userID, "laptop", vault.AppPasswordScopeIMAP)
+			require.NoError(t, err)
+
+			phone, phonePass, err := b.CreateAppPassword(userID, "phone", vault.AppPasswordScopeSMTP)
+			require.NoError(t, err)
+
+			_, _, err = b.CreateAppPassword(userID, "Laptop", vault.AppPasswordScopeAll)
+			require.ErrorIs(t, err, bridge.ErrAppPasswordNameTaken)
+
+			appPasswords, err := b.GetAppPasswords(userID)
+			require.NoError(t, err)
+			require.Len(t, appPasswords, 2)
+			require.True(t, appPasswords[0].LastUsedAt.IsZero())
+
+			// The app passwords are only accepted over the protocols of their scope.
+			client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			require.Error(t, client.Login(info.Addresses[0], string(phonePass)))
+			require.NoError(t, client.Login(info.Addresses[0], string(laptopPass)))
+
+			require.Error(t, smtpAuth(b, info.Addresses[0], laptopPass))
+			require.NoError(t, smtpAuth(b, info.Addresses[0], phonePass))
+
+			appPasswords, err = b.GetAppPasswords(userID)
+			require.NoError(t, err)
+			require.Equal(t, laptop.ID, appPasswords[0].ID)
+			require.False(t, appPasswords[0].LastUsedAt.IsZero())
+
+			// Revoking an app password closes its sessions.
+			revokedCh, done := chToType[events.Event, events.AppPasswordRevoked](b.GetEvents(events.AppPasswordRevoked{}))
+			defer done()
+
+			require.NoError(t, b.RevokeAppPassword(userID, laptop.ID))
+			require.Equal(t, laptop.ID, (<-revokedCh).AppPasswordID)
+			require.ErrorIs(t, b.RevokeAppPassword(userID, laptop.ID), bridge.ErrNoSuchAppPassword)
+
+			require.Eventually(t, func() bool {
+				return client.Noop() != nil
+			}, 10*time.Second, 100*time.Millisecond)
+
+			client, err = eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			defer func() { _ = client.Logout() }()
+
+			require.Error(t, client.Login(info.Addresses[0], string(laptopPass)))
+
+			// The bridge password and the other app passwords still work.
+			require.NoError(t, client.Login(info.Addresses[0], string(info.BridgePass)))
+			require.NoError(t, smtpAuth(b, info.Addresses[0], phonePass))
+
+			appPasswords, err = b.GetAppPasswords(userID)
+			require.NoError(t, err)
+			require.Len(t, appPasswords, 1)
+			require.Equal(t, phone.ID, appPasswords[0].ID)
+		})
+	})
+}
+
+func smtpAuth(b *bridge.Bridge, address string, password []byte) error {
+	client, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetSMTPPort())))
+	if err != nil {
+		return err
+	}
+	defer client.Close() //nolint:errcheck
+
+	if err := client.StartTLS(&tls.Config{InsecureSkipVerify: true}); err != nil { //nolint:gosec
+		return err
+	}
+
+	return client.Auth(sasl.NewPlainClient(address, address, string(password)))
+}
@@ -42,10 +42,12 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/focus"
 	"github.com/ProtonMail/proton-bridge/v3/internal/identifier"
+	"github.com/ProtonMail/proton-bridge/v3/internal/policy"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
 	"github.com/ProtonMail/proton-bridge/v3/internal/sentry"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapsmtpserver"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/notifications"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/oauthserver"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/observability"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/syncservice"
 	"github.com/ProtonMail/proton-bridge/v3/internal/telemetry"
@@ -98,6 +100,9 @@ type Bridge struct {
 	// keychains is the utils that own usable keychains found in the OS.
 	keychains *keychain.List
 
+	// policy holds the settings locked by the IT department and the accounts allowed to log in.
+	policy *policy.Policy
+
 	// focusService is used to raise the bridge window when needed.
 	focusService *focus.Service
 
@@ -143,6 +148,9 @@ type Bridge struct {
 	serverManager *imapsmtpserver.Service
 	syncService   *syncservice.Service
 
+	// oauthServer issues the tokens of the mail clients authenticating with OAuth.
+	oauthServer *oauthserver.Service
+
 	// unleashService is responsible for polling the feature flags and caching
 	unleashService *unleash.Service
 
@@ -166,6 +174,7 @@ func New(
 	updater Updater, // the updater to fetch and install updates
 	curVersion *semver.Version, // the current version of the bridge
 	keychains *keychain.List, // usable keychains
+	policy *policy.Policy, // the settings enforced by the system-wide policy file
 
 	apiURL string, // the URL of the API to use
 	cookieJar http.CookieJar, // the cookie jar to use
@@ -202,6 +211,7 @@ func New(
 		updater,
 		curVersion,
 		keychains,
+		policy,
 		panicHandler,
 		reporter,
 
@@ -238,6 +248,7 @@ func newBridge(
 	updater Updater,
 	curVersion *semver.Version,
 	keychains *keychain.List,
+	policy *policy.Policy,
 	panicHandler async.PanicHandler,
 	reporter reporter.Reporter,
 
@@ -249,6 +260,11 @@ func newBridge(
 
 	logIMAPClient, logIMAPServer, logSMTP bool,
 ) (*Bridge, error) {
+	// The locked settings are enforced before they are used.
+	if err := applyPolicy(vault, policy); err != nil {
+		return nil, fmt.Errorf("failed to apply policy: %w", err)
+	}
+
 	tlsConfig, err := loadTLSConfig(vault)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load TLS config: %w", err)
@@ -297,6 +313,7 @@ func newBridge(
 		newVersionLock: safe.NewRWMutex(),
 
 		keychains: keychains,
+		policy:    policy,
 
 		panicHandler: panicHandler,
 		reporter:     reporter,
@@ -326,9 +343,13 @@ func newBridge(
 		getHostVersion: func(host types.Host) string { return host.Info().OS.Version },
 	}
 
+	bridge.oauthServer = oauthserver.NewService(&bridgeOAuthUsers{b: bridge}, &bridgeEventPublisher{b: bridge}, panicHandler)
+
 	bridge.serverManager = imapsmtpserver.NewService(context.Background(),
 		&bridgeSMTPSettings{b: bridge},
 		&bridgeIMAPSettings{b: bridge},
+		&bridgeNetworkSettings{b: bridge},
+		&bridgeOAuthVerifier{b: bridge},
 		&bridgeEventPublisher{b: bridge},
 		panicHandler,
 		reporter,
@@ -345,6 +366,12 @@ func newBridge(
 		return nil, err
 	}
 
+	if bridge.vault.GetOAuthEnabled() {
+		if err := bridge.startOAuth(); err != nil {
+			logPkg.WithError(err).Error("Failed to start OAuth server on bridge start")
+		}
+	}
+
 	if heartbeatManager == nil {
 		bridge.heartbeat.init(bridge, bridge)
 	} else {
@@ -451,9 +478,9 @@ func (bridge *Bridge) init(tlsReporter TLSReporter) error {
 
 		useOldUpdateLogic := bridge.GetFeatureFlagValue(unleash.UpdateUseNewVersionFileStructureDisabled)
 		if useOldUpdateLogic {
-			versionLegacy, err = bridge.updater.GetVersionInfoLegacy(ctx, bridge.api, bridge.vault.GetUpdateChannel())
+			versionLegacy, err = bridge.updater.GetVersionInfoLegacy(ctx, bridge.getUpdateDownloader(), bridge.vault.GetUpdateChannel())
 		} else {
-			version, err = bridge.updater.GetVersionInfo(ctx, bridge.api)
+			version, err = bridge.updater.GetVersionInfo(ctx, bridge.getUpdateDownloader())
 		}
 
 		if err != nil {
@@ -477,6 +504,9 @@ func (bridge *Bridge) init(tlsReporter TLSReporter) error {
 	})
 	defer bridge.goUpdate()
 
+	// Run the scheduled exports of the users' messages when they are due.
+	bridge.tasks.PeriodicOrTrigger(exportCheckInterval, 0, bridge.runScheduledExports)
+
 	// Install updates when available - based on old update logic
 	bridge.tasks.Once(func(ctx context.Context) {
 		async.RangeContext(ctx, bridge.installChLegacy, func(job installJobLegacy) {
@@ -531,6 +561,8 @@ func (bridge *Bridge) Close(ctx context.Context) {
 		logPkg.WithError(err).Error("Failed to close servers")
 	}
 
+	bridge.oauthServer.Stop()
+
 	bridge.syncService.Close()
 
 	// Stop all ongoing tasks.
@@ -919,6 +919,7 @@ func withBridgeNoMocks(
 		mocks.Updater,
 		v2_3_0,
 		keychain.NewTestKeychainsList(),
+		mocks.Policy,
 
 		// The API stuff.
 		apiURL,
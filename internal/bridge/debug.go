@@ -23,10 +23,15 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/ProtonMail/gluon/imap"
 	"github.com/ProtonMail/gluon/rfc822"
 	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	imapservice "github.com/ProtonMail/proton-bridge/v3/internal/services/imapservice"
 	"github.com/ProtonMail/proton-bridge/v3/internal/user"
 	"github.com/bradenaw/juniper/iterator"
 	"github.com/bradenaw/juniper/xslices"
@@ -38,6 +43,9 @@ import (
 
 type CheckClientStateResult struct {
 	MissingMessages map[string]map[string]user.DiagMailboxMessage
+
+	// Divergences lists, per message, how the IMAP client state differs from the server state.
+	Divergences []ClientStateDivergence
 }
 
 func (c *CheckClientStateResult) AddMissingMessage(userID string, message user.DiagMailboxMessage) {
@@ -49,18 +57,79 @@ func (c *CheckClientStateResult) AddMissingMessage(userID string, message user.D
 	}
 }
 
+// ClientStateDivergenceKind is the kind of difference found between the IMAP client state and the server state.
+type ClientStateDivergenceKind string
+
+const (
+	// ClientStateMissingMessage means the message exists on the server but in no IMAP mailbox.
+	ClientStateMissingMessage ClientStateDivergenceKind = "missing-message"
+
+	// ClientStateExtraMessage means the message is visible over IMAP but no longer exists on the server.
+	ClientStateExtraMessage ClientStateDivergenceKind = "extra-message"
+
+	// ClientStateWrongMailboxes means the message is not in the IMAP mailboxes matching its server labels.
+	ClientStateWrongMailboxes ClientStateDivergenceKind = "wrong-mailboxes"
+
+	// ClientStateWrongFlags means the IMAP flags of the message do not match the server state.
+	ClientStateWrongFlags ClientStateDivergenceKind = "wrong-flags"
+)
+
+type ClientStateDivergence struct {
+	UserID    string
+	Account   string
+	MessageID string
+	Kind      ClientStateDivergenceKind
+
+	// Expected and Actual are the mailboxes, or flags for ClientStateWrongFlags, on the server and over IMAP.
+	Expected []string
+	Actual   []string
+}
+
+// clientStateCheck is the outcome of a state check, along with what is needed to repair it.
+type clientStateCheck struct {
+	result  CheckClientStateResult
+	repairs map[string]imapservice.RepairMessagesReq
+}
+
+func (c *clientStateCheck) addDivergence(divergence ClientStateDivergence, meta proton.MessageMetadata) {
+	c.result.Divergences = append(c.result.Divergences, divergence)
+
+	req := c.repairs[divergence.UserID]
+
+	if divergence.Kind == ClientStateExtraMessage {
+		if !slices.Contains(req.Delete, divergence.MessageID) {
+			req.Delete = append(req.Delete, divergence.MessageID)
+		}
+	} else if !xslices.Any(req.Update, func(m proton.MessageMetadata) bool { return m.ID == divergence.MessageID }) {
+		req.Update = append(req.Update, meta)
+	}
+
+	c.repairs[divergence.UserID] = req
+}
+
 // CheckClientState checks the current IMAP client reported state against the proton server state and reports
 // anything that is out of place.
 func (bridge *Bridge) CheckClientState(ctx context.Context, checkFlags bool, progressCB func(string)) (CheckClientStateResult, error) {
+	check, err := bridge.checkClientState(ctx, checkFlags, progressCB)
+
+	return check.result, err
+}
+
+func (bridge *Bridge) checkClientState(ctx context.Context, checkFlags bool, progressCB func(string)) (clientStateCheck, error) {
 	bridge.usersLock.RLock()
 	defer bridge.usersLock.RUnlock()
 
 	users := maps.Values(bridge.users)
 
-	result := CheckClientStateResult{
-		MissingMessages: make(map[string]map[string]user.DiagMailboxMessage),
+	check := clientStateCheck{
+		result: CheckClientStateResult{
+			MissingMessages: make(map[string]map[string]user.DiagMailboxMessage),
+		},
+		repairs: make(map[string]imapservice.RepairMessagesReq),
 	}
 
+	result := &check.result
+
 	for _, usr := range users {
 		if progressCB != nil {
 			progressCB(fmt.Sprintf("Checking state for user %v", usr.Name()))
@@ -73,7 +142,7 @@ func (bridge *Bridge) CheckClientState(ctx context.Context, checkFlags bool, pro
 		log.Debug("Retrieving all server metadata")
 		meta, err := usr.GetDiagnosticMetadata(ctx)
 		if err != nil {
-			return result, err
+			return check, err
 		}
 
 		success := true
@@ -82,17 +151,22 @@ func (bridge *Bridge) CheckClientState(ctx context.Context, checkFlags bool, pro
 			log.Errorf("Metadata (%v) and message(%v) list sizes do not match", len(meta.Metadata), len(meta.MessageIDs))
 		}
 
+		metaByID := make(map[string]proton.MessageMetadata, len(meta.Metadata))
+		for _, m := range meta.Metadata {
+			metaByID[m.ID] = m
+		}
+
 		log.Debug("Building state")
 		state, err := meta.BuildMailboxToMessageMap(ctx, usr)
 		if err != nil {
 			log.WithError(err).Error("Failed to build state")
-			return result, err
+			return check, err
 		}
 
 		info, err := bridge.GetUserInfo(usr.ID())
 		if err != nil {
 			log.WithError(err).Error("Failed to get user info")
-			return result, err
+			return check, err
 		}
 
 		addr := fmt.Sprintf("127.0.0.1:%v", bridge.GetIMAPPort())
@@ -117,26 +191,62 @@ func (bridge *Bridge) CheckClientState(ctx context.Context, checkFlags bool, pro
 				}
 
 				log := log.WithField("account", account)
+
+				mailboxes, err := clientListMailboxes(client)
+				if err != nil {
+					return fmt.Errorf("failed to list mailboxes: %w", err)
+				}
+
+				// INBOX is case-insensitive, the server state names it after the label while clients see INBOX.
 				for mboxName, messageList := range mboxMap {
-					log := log.WithField("mbox", mboxName)
-					status, err := client.Select(mboxName, true)
-					if err != nil {
-						log.WithError(err).Errorf("Failed to select mailbox %v", messageList)
-						return fmt.Errorf("failed to select '%v':%w", mboxName, err)
+					if normalized := normalizeMailboxName(mboxName); normalized != mboxName {
+						delete(mboxMap, mboxName)
+						mboxMap[normalized] = messageList
 					}
+				}
 
-					log.Debug("Checking message count")
+				// The mailboxes and flags in which every message was seen, and in which it is expected.
+				// Mailboxes that are not listed (e.g. a hidden All Mail) are not taken into account.
+				actual := make(map[string]map[string]imap.FlagSet)
+				expected := make(map[string][]string)
 
-					if int(status.Messages) != len(messageList) {
-						success = false
-						log.Errorf("Message count doesn't match, got '%v' expected '%v'", status.Messages, len(messageList))
+				for mboxName, messageList := range mboxMap {
+					if !slices.Contains(mailboxes, mboxName) {
+						log.WithField("mbox", mboxName).Warnf("Mailbox is not listed, skipping %v messages", len(messageList))
+						continue
 					}
 
+					for _, msg := range messageList {
+						expected[msg.ID] = append(expected[msg.ID], mboxName)
+					}
+				}
+
+				for _, mboxName := range mailboxes {
+					log := log.WithField("mbox", mboxName)
+
 					ids, err := clientGetMessageIDs(client, mboxName)
 					if err != nil {
+						log.WithError(err).Error("Failed to get message ids")
 						return fmt.Errorf("failed to get message ids for mbox '%v': %w", mboxName, err)
 					}
 
+					for id, flags := range ids {
+						if _, ok := actual[id]; !ok {
+							actual[id] = make(map[string]imap.FlagSet)
+						}
+
+						actual[id][mboxName] = flags
+					}
+
+					messageList := mboxMap[mboxName]
+
+					log.Debug("Checking message count")
+
+					if len(ids) != len(messageList) {
+						success = false
+						log.Errorf("Message count doesn't match, got '%v' expected '%v'", len(ids), len(messageList))
+					}
+
 					for _, msg := range messageList {
 						imapFlags, ok := ids[msg.ID]
 						if !ok {
@@ -162,6 +272,12 @@ func (bridge *Bridge) CheckClientState(ctx context.Context, checkFlags bool, pro
 					}
 				}
 
+				for _, divergence := range diffClientState(usr.ID(), account, expected, actual, mboxMap, metaByID, checkFlags) {
+					success = false
+					log.WithField("messageID", divergence.MessageID).Warnf("Found divergence '%v'", divergence.Kind)
+					check.addDivergence(divergence, metaByID[divergence.MessageID])
+				}
+
 				if !success {
 					log.Errorf("State does not match")
 				} else {
@@ -170,7 +286,7 @@ func (bridge *Bridge) CheckClientState(ctx context.Context, checkFlags bool, pro
 
 				return nil
 			}(account, mboxMap); err != nil {
-				return result, err
+				return check, err
 			}
 		}
 
@@ -202,7 +318,172 @@ func (bridge *Bridge) CheckClientState(ctx context.Context, checkFlags bool, pro
 		}
 	}
 
-	return result, nil
+	return check, nil
+}
+
+// diffClientState compares, for one account, the mailboxes and flags each message has over IMAP with the ones it should
+// have according to the server.
+func diffClientState(
+	userID, account string,
+	expected map[string][]string,
+	actual map[string]map[string]imap.FlagSet,
+	mboxMap user.AccountMailboxMap,
+	metaByID map[string]proton.MessageMetadata,
+	checkFlags bool,
+) []ClientStateDivergence {
+	var divergences []ClientStateDivergence
+
+	newDivergence := func(id string, kind ClientStateDivergenceKind, expected, actual []string) ClientStateDivergence {
+		slices.Sort(expected)
+		slices.Sort(actual)
+
+		return ClientStateDivergence{
+			UserID:    userID,
+			Account:   account,
+			MessageID: id,
+			Kind:      kind,
+			Expected:  expected,
+			Actual:    actual,
+		}
+	}
+
+	for id, wantMailboxes := range expected {
+		haveMailboxes := maps.Keys(actual[id])
+
+		switch {
+		case len(haveMailboxes) == 0:
+			divergences = append(divergences, newDivergence(id, ClientStateMissingMessage, slices.Clone(wantMailboxes), nil))
+			continue
+
+		case !xslices.Equal(sortedClone(wantMailboxes), sortedClone(haveMailboxes)):
+			divergences = append(divergences, newDivergence(id, ClientStateWrongMailboxes, slices.Clone(wantMailboxes), haveMailboxes))
+		}
+
+		if !checkFlags {
+			continue
+		}
+
+		wantFlags := getExpectedFlags(mboxMap, wantMailboxes[0], id)
+
+		for _, haveFlags := range actual[id] {
+			if !haveFlags.Equals(wantFlags) {
+				divergences = append(divergences, newDivergence(id, ClientStateWrongFlags, wantFlags.ToSlice(), haveFlags.ToSlice()))
+				break
+			}
+		}
+	}
+
+	for id, mailboxes := range actual {
+		if _, ok := expected[id]; ok {
+			continue
+		}
+
+		if _, ok := metaByID[id]; ok {
+			// The message exists on the server but belongs to another account; this is not something we can fix here.
+			logrus.WithField("pkg", "bridge/debug").WithField("messageID", id).Warn("Message is visible in an unexpected account")
+			continue
+		}
+
+		divergences = append(divergences, newDivergence(id, ClientStateExtraMessage, nil, maps.Keys(mailboxes)))
+	}
+
+	return divergences
+}
+
+func getExpectedFlags(mboxMap user.AccountMailboxMap, mboxName, messageID string) imap.FlagSet {
+	for _, msg := range mboxMap[mboxName] {
+		if msg.ID == messageID {
+			return msg.Flags
+		}
+	}
+
+	return imap.NewFlagSet()
+}
+
+func sortedClone(v []string) []string {
+	v = slices.Clone(v)
+	slices.Sort(v)
+
+	return v
+}
+
+// ClientStateRepairReport summarizes the outcome of RepairClientState.
+type ClientStateRepairReport struct {
+	DryRun      bool
+	Divergences []ClientStateDivergence
+
+	// Repaired and Failed are the IDs of the messages whose state was, or could not be, repaired.
+	Repaired []string
+	Failed   map[string]string
+}
+
+// RepairClientState checks the IMAP client state like CheckClientState, then fixes every divergence found by updating,
+// creating or removing only the affected messages in gluon. With dryRun set nothing is changed and the report only lists
+// the divergences. A ClientStateRepaired event summarizing the run is published in both cases.
+func (bridge *Bridge) RepairClientState(ctx context.Context, dryRun bool, progressCB func(string)) (ClientStateRepairReport, error) {
+	log := logrus.WithFields(logrus.Fields{"pkg": "bridge/debug", "diag": "state-repair", "dryRun": dryRun})
+
+	check, err := bridge.checkClientState(ctx, true, progressCB)
+	if err != nil {
+		return ClientStateRepairReport{}, err
+	}
+
+	report := ClientStateRepairReport{
+		DryRun:      dryRun,
+		Divergences: check.result.Divergences,
+		Failed:      make(map[string]string),
+	}
+
+	if !dryRun {
+		for userID, req := range check.repairs {
+			if progressCB != nil {
+				progressCB(fmt.Sprintf("Repairing %v messages for user %v", len(req.Update)+len(req.Delete), userID))
+			}
+
+			res, err := safe.RLockRetErr(func() (imapservice.RepairMessagesResult, error) {
+				usr, ok := bridge.users[userID]
+				if !ok {
+					return imapservice.RepairMessagesResult{}, ErrNoSuchUser
+				}
+
+				return usr.RepairMessages(ctx, req)
+			}, bridge.usersLock)
+			if err != nil {
+				log.WithError(err).WithField("userID", userID).Error("Failed to repair client state")
+
+				for _, m := range req.Update {
+					report.Failed[m.ID] = err.Error()
+				}
+
+				for _, id := range req.Delete {
+					report.Failed[id] = err.Error()
+				}
+
+				continue
+			}
+
+			report.Repaired = append(report.Repaired, res.Repaired...)
+
+			for id, err := range res.Failed {
+				report.Failed[id] = err.Error()
+			}
+		}
+	}
+
+	log.WithFields(logrus.Fields{
+		"divergences": len(report.Divergences),
+		"repaired":    len(report.Repaired),
+		"failed":      len(report.Failed),
+	}).Info("Client state repair finished")
+
+	bridge.publish(events.ClientStateRepaired{
+		DryRun:      dryRun,
+		Divergences: len(report.Divergences),
+		Repaired:    len(report.Repaired),
+		Failed:      len(report.Failed),
+	})
+
+	return report, nil
 }
 
 func (bridge *Bridge) DebugDownloadFailedMessages(
@@ -233,6 +514,40 @@ func (bridge *Bridge) DebugDownloadFailedMessages(
 	return nil
 }
 
+// clientListMailboxes returns the names of all the selectable mailboxes of the account.
+func clientListMailboxes(client *goimapclient.Client) ([]string, error) {
+	resCh := make(chan *goimap.MailboxInfo)
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- client.List("", "*", resCh)
+	}()
+
+	var names []string
+
+	for info := range resCh {
+		if slices.Contains(info.Attributes, goimap.NoSelectAttr) {
+			continue
+		}
+
+		names = append(names, normalizeMailboxName(info.Name))
+	}
+
+	if err := <-errCh; err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
+func normalizeMailboxName(name string) string {
+	if strings.EqualFold(name, imap.Inbox) {
+		return imap.Inbox
+	}
+
+	return name
+}
+
 func clientGetMessageIDs(client *goimapclient.Client, mailbox string) (map[string]imap.FlagSet, error) {
 	status, err := client.Select(mailbox, true)
 	if err != nil {
@@ -0,0 +1,131 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/emersion/go-imap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_RepairClientState(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		_, addrID, err := s.CreateUser("imap", password)
+		require.NoError(t, err)
+
+		withClient(ctx, t, s, "imap", password, func(ctx context.Context, c *proton.Client) {
+			createNumMessages(ctx, t, c, addrID, proton.InboxLabel, 3)
+		})
+
+		// While blocked, the full messages cannot be fetched so newly created messages never make it to gluon.
+		var blocked atomic.Bool
+
+		s.AddStatusHook(func(req *http.Request) (int, bool) {
+			if blocked.Load() && req.Method == http.MethodGet && strings.HasPrefix(req.URL.Path, "/mail/v4/messages/") {
+				switch strings.TrimPrefix(req.URL.Path, "/mail/v4/messages/") {
+				case "ids", "count":
+					return 0, false
+
+				default:
+					return http.StatusUnprocessableEntity, true
+				}
+			}
+
+			return 0, false
+		})
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, "imap", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			require.NoError(t, client.Login(info.Addresses[0], string(info.BridgePass)))
+			defer func() { _ = client.Logout() }()
+
+			blocked.Store(true)
+
+			var missingID string
+
+			withClient(ctx, t, s, "imap", password, func(ctx context.Context, c *proton.Client) {
+				missingID = createNumMessages(ctx, t, c, addrID, proton.InboxLabel, 1)[0]
+			})
+
+			repairCh, repairDone := chToType[events.Event, events.ClientStateRepaired](b.GetEvents(events.ClientStateRepaired{}))
+			defer repairDone()
+
+			// The dry run reports the missing message without fixing it.
+			require.Eventually(t, func() bool {
+				report, err := b.RepairClientState(ctx, true, nil)
+				require.NoError(t, err)
+				require.True(t, (<-repairCh).DryRun)
+
+				return len(report.Divergences) == 1
+			}, 10*time.Second, 100*time.Millisecond)
+
+			report, err := b.RepairClientState(ctx, true, nil)
+			require.NoError(t, err)
+			require.Equal(t, missingID, report.Divergences[0].MessageID)
+			require.Equal(t, bridge.ClientStateMissingMessage, report.Divergences[0].Kind)
+			require.Empty(t, report.Repaired)
+			require.Equal(t, events.ClientStateRepaired{DryRun: true, Divergences: 1}, <-repairCh)
+
+			status, err := client.Select("INBOX", true)
+			require.NoError(t, err)
+			require.Equal(t, uint32(3), status.Messages)
+
+			blocked.Store(false)
+
+			// The actual repair only creates the missing message.
+			report, err = b.RepairClientState(ctx, false, nil)
+			require.NoError(t, err)
+			require.Equal(t, []string{missingID}, report.Repaired)
+			require.Empty(t, report.Failed)
+			require.Equal(t, events.ClientStateRepaired{Divergences: 1, Repaired: 1}, <-repairCh)
+
+			require.Eventually(t, func() bool {
+				status, err := client.Status("INBOX", []imap.StatusItem{imap.StatusMessages})
+				return err == nil && status.Messages == 4
+			}, 10*time.Second, 100*time.Millisecond)
+
+			report, err = b.RepairClientState(ctx, true, nil)
+			require.NoError(t, err)
+			require.Empty(t, report.Divergences)
+			<-repairCh
+		})
+	}, server.WithTLS(false))
+}
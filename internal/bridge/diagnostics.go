@@ -0,0 +1,342 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/user"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+)
+
+// DefaultMaxDiagnosticsLogsSize is the maximum size of the logs archive included in a diagnostics bundle. As the bundle is not
+// uploaded, the limit is much more generous than the one of bug reports.
+const DefaultMaxDiagnosticsLogsSize = 100 * 1024 * 1024
+
+type DiagnosticsReq struct {
+	// IncludeClientState runs the IMAP client state check and includes its result.
+	IncludeClientState bool
+
+	// IncludeFailedMessages downloads the messages reported missing by the client state check. Messages are stored unencrypted.
+	IncludeFailedMessages bool
+}
+
+type diagnosticsVersion struct {
+	AppName   string
+	Version   string
+	Revision  string
+	Tag       string
+	BuildTime string
+	OS        string
+	Arch      string
+	Time      time.Time
+}
+
+type diagnosticsUser struct {
+	Info       UserInfo
+	SyncStatus *diagnosticsSyncStatus `json:",omitempty"`
+	Metadata   *diagnosticsMetadata   `json:",omitempty"`
+	Errors     []string               `json:",omitempty"`
+}
+
+type diagnosticsSyncStatus struct {
+	HasLabels           bool
+	HasMessages         bool
+	HasMessageCount     bool
+	FailedMessageIDs    []string
+	LastSyncedMessageID string
+	NumSyncedMessages   int64
+	TotalMessageCount   int64
+}
+
+type diagnosticsMetadata struct {
+	MessageIDs       []string
+	FailedMessageIDs []string
+	Metadata         []proton.MessageMetadata
+}
+
+// ExportDiagnostics writes a diagnostics bundle to a zip file in the given directory and returns the path of that file.
+// Unlike ReportBug, nothing is sent to Proton. The bundle contains the logs, the settings without secrets, version information
+// and, for every user, the diagnostic metadata and sync status. Errors encountered while collecting a part of the bundle are
+// recorded in the bundle rather than aborting the export.
+func (bridge *Bridge) ExportDiagnostics(ctx context.Context, dir string, req DiagnosticsReq, progressCB func(string)) (string, error) {
+	log := logrus.WithField("pkg", "bridge/diagnostics")
+
+	progress := func(msg string) {
+		log.Info(msg)
+
+		if progressCB != nil {
+			progressCB(msg)
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create directory '%v': %w", dir, err)
+	}
+
+	filename := filepath.Join(dir, fmt.Sprintf("bridge_diagnostics_%v.zip", time.Now().Format("20060102_150405")))
+
+	file, err := os.CreateTemp(dir, "bridge_diagnostics_*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("failed to create diagnostics file: %w", err)
+	}
+
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+
+	writer := newDiagnosticsWriter(file)
+
+	progress("Collecting version and settings")
+
+	writer.addJSON("version.json", diagnosticsVersion{
+		AppName:   constants.FullAppName,
+		Version:   constants.Version,
+		Revision:  constants.Revision,
+		Tag:       constants.Tag,
+		BuildTime: constants.BuildTime,
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+		Time:      time.Now(),
+	})
+
+	writer.addJSON("settings.json", bridge.vault.GetRedactedSettings())
+
+	progress("Collecting logs")
+
+	if logsPath, err := bridge.locator.ProvideLogsPath(); err != nil {
+		writer.addError("logs.zip", err)
+	} else if buffer, err := logging.ZipLogsForBugReport(logsPath, DefaultMaxSessionCountForBugReport, DefaultMaxDiagnosticsLogsSize); err != nil {
+		writer.addError("logs.zip", err)
+	} else {
+		writer.addReader("logs.zip", buffer)
+	}
+
+	for _, userID := range bridge.GetUserIDs() {
+		progress(fmt.Sprintf("Collecting diagnostics for user %v", userID))
+
+		writer.addJSON(path.Join("users", userID+".json"), bridge.getUserDiagnostics(ctx, userID))
+	}
+
+	if req.IncludeClientState {
+		progress("Checking client state")
+
+		result, err := bridge.CheckClientState(ctx, true, progress)
+		if err != nil {
+			writer.addError("client_state.json", err)
+		} else {
+			writer.addJSON("client_state.json", result)
+		}
+
+		if err == nil && req.IncludeFailedMessages && len(result.MissingMessages) > 0 {
+			progress("Downloading failed messages")
+
+			if err := bridge.addFailedMessagesToDiagnostics(ctx, writer, result); err != nil {
+				writer.addError("failed_messages", err)
+			}
+		}
+	}
+
+	if err := writer.close(); err != nil {
+		return "", fmt.Errorf("failed to write diagnostics bundle: %w", err)
+	}
+
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to close diagnostics bundle: %w", err)
+	}
+
+	if err := os.Rename(file.Name(), filename); err != nil {
+		return "", fmt.Errorf("failed to move diagnostics bundle: %w", err)
+	}
+
+	progress(fmt.Sprintf("Diagnostics bundle written to %v", filename))
+
+	return filename, nil
+}
+
+func (bridge *Bridge) getUserDiagnostics(ctx context.Context, userID string) diagnosticsUser {
+	var diag diagnosticsUser
+
+	info, err := bridge.GetUserInfo(userID)
+	if err != nil {
+		diag.Errors = append(diag.Errors, err.Error())
+	}
+
+	// The bridge password must never end up in the bundle.
+	info.BridgePass = nil
+	diag.Info = info
+
+	// The user is queried without the lock, so that logging in or out isn't blocked by the requests to the API.
+	usr, err := safe.RLockRetErr(func() (*user.User, error) {
+		usr, ok := bridge.users[userID]
+		if !ok {
+			return nil, ErrNoSuchUser
+		}
+
+		return usr, nil
+	}, bridge.usersLock)
+	if err != nil {
+		return diag
+	}
+
+	if status, err := usr.GetSyncStatus(ctx); err != nil {
+		diag.Errors = append(diag.Errors, fmt.Sprintf("failed to get sync status: %v", err))
+	} else {
+		diag.SyncStatus = &diagnosticsSyncStatus{
+			HasLabels:           status.HasLabels,
+			HasMessages:         status.HasMessages,
+			HasMessageCount:     status.HasMessageCount,
+			FailedMessageIDs:    maps.Keys(status.FailedMessages),
+			LastSyncedMessageID: status.LastSyncedMessageID,
+			NumSyncedMessages:   status.NumSyncedMessages,
+			TotalMessageCount:   status.TotalMessageCount,
+		}
+	}
+
+	if meta, err := usr.GetDiagnosticMetadata(ctx); err != nil {
+		diag.Errors = append(diag.Errors, fmt.Sprintf("failed to get diagnostic metadata: %v", err))
+	} else {
+		diag.Metadata = &diagnosticsMetadata{
+			MessageIDs:       meta.MessageIDs,
+			FailedMessageIDs: maps.Keys(meta.FailedMessageIDs),
+			Metadata:         meta.Metadata,
+		}
+	}
+
+	return diag
+}
+
+func (bridge *Bridge) addFailedMessagesToDiagnostics(ctx context.Context, writer *diagnosticsWriter, result CheckClientStateResult) error {
+	tmpDir, err := os.MkdirTemp("", "bridge-diagnostics-*")
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	if err := bridge.DebugDownloadFailedMessages(ctx, result, tmpDir, nil); err != nil {
+		return err
+	}
+
+	return filepath.WalkDir(tmpDir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(tmpDir, filePath)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(filePath) //nolint:gosec
+		if err != nil {
+			return err
+		}
+
+		defer func() { _ = file.Close() }()
+
+		writer.addReader(path.Join("failed_messages", filepath.ToSlash(rel)), file)
+
+		return nil
+	})
+}
+
+// diagnosticsWriter writes the entries of a diagnostics bundle. The first error is kept and returned by close.
+type diagnosticsWriter struct {
+	zip *zip.Writer
+	err error
+}
+
+func newDiagnosticsWriter(w io.Writer) *diagnosticsWriter {
+	return &diagnosticsWriter{zip: zip.NewWriter(w)}
+}
+
+func (w *diagnosticsWriter) addReader(name string, r io.Reader) {
+	if w.err != nil {
+		return
+	}
+
+	entry, err := w.zip.Create(name)
+	if err != nil {
+		w.err = err
+		return
+	}
+
+	_, w.err = io.Copy(entry, r)
+}
+
+func (w *diagnosticsWriter) addJSON(name string, v any) {
+	if w.err != nil {
+		return
+	}
+
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		w.addError(name, err)
+		return
+	}
+
+	entry, err := w.zip.Create(name)
+	if err != nil {
+		w.err = err
+		return
+	}
+
+	_, w.err = entry.Write(b)
+}
+
+// addError records that an entry of the bundle could not be collected.
+func (w *diagnosticsWriter) addError(name string, err error) {
+	logrus.WithField("pkg", "bridge/diagnostics").WithError(err).Warnf("Failed to collect %v", name)
+
+	if w.err != nil {
+		return
+	}
+
+	entry, zipErr := w.zip.Create(name + ".error.txt")
+	if zipErr != nil {
+		w.err = zipErr
+		return
+	}
+
+	_, w.err = entry.Write([]byte(err.Error()))
+}
+
+func (w *diagnosticsWriter) close() error {
+	if err := w.zip.Close(); err != nil && w.err == nil {
+		w.err = err
+	}
+
+	return w.err
+}
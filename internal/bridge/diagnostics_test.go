@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"archive/zip"
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_ExportDiagnostics(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			dir := t.TempDir()
+
+			path, err := b.ExportDiagnostics(ctx, dir, bridge.DiagnosticsReq{}, nil)
+			require.NoError(t, err)
+			require.Equal(t, dir, filepath.Dir(path))
+
+			reader, err := zip.OpenReader(path)
+			require.NoError(t, err)
+			defer func() { _ = reader.Close() }()
+
+			names := xslices.Map(reader.File, func(f *zip.File) string { return f.Name })
+			require.Contains(t, names, "version.json")
+			require.Contains(t, names, "settings.json")
+			require.Contains(t, names, "users/"+userID+".json")
+			require.NotContains(t, names, "client_state.json")
+
+			// The bridge password must not be part of the bundle.
+			for _, file := range reader.File {
+				rc, err := file.Open()
+				require.NoError(t, err)
+
+				content, err := io.ReadAll(rc)
+				require.NoError(t, err)
+				require.NoError(t, rc.Close())
+
+				require.NotContains(t, string(content), string(info.BridgePass))
+			}
+
+			// Only the bundle is left in the directory.
+			matches, err := filepath.Glob(filepath.Join(dir, "*"))
+			require.NoError(t, err)
+			require.Equal(t, []string{path}, matches)
+		})
+	})
+}
@@ -17,7 +17,12 @@
 
 package bridge
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/user"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+)
 
 var (
 	ErrVaultInsecure = errors.New("the vault is insecure")
@@ -30,4 +35,39 @@ var (
 	ErrNotImplemented      = errors.New("not implemented")
 
 	ErrSizeTooLarge = errors.New("file is too big")
+
+	ErrUnknownDesktopNotificationEvent = errors.New("unknown desktop notification event")
+	ErrUnknownSystemView               = errors.New("unknown system view")
+
+	ErrInvalidBindAddress   = errors.New("the bind address must be an IP address")
+	ErrInvalidNetwork       = errors.New("the network must be in CIDR notation")
+	ErrIMAPSSLRequiredOnLAN = errors.New("IMAP must use SSL when listening on a non-loopback address")
+
+	ErrInvalidAppPasswordName  = errors.New("the app password name must not be empty")
+	ErrAppPasswordNameTaken    = vault.ErrAppPasswordNameTaken
+	ErrInvalidAppPasswordScope = errors.New("unknown app password scope")
+	ErrNoSuchAppPassword       = errors.New("no such app password")
+
+	ErrNoSuchOAuthRequest = errors.New("no such OAuth authorization request")
+
+	ErrInvalidAddressGroupName = errors.New("the address group name must not be empty")
+	ErrNoSuchAddressGroup      = errors.New("no such address group")
+	ErrNoSuchAddress           = errors.New("no such address")
+	ErrAddressAlreadyGrouped   = errors.New("the address is already part of another group")
+
+	ErrManagedByPolicy   = errors.New("the setting is managed by policy")
+	ErrAccountNotAllowed = errors.New("the account is not allowed to log in by policy")
+
+	ErrInvalidImportMailbox = user.ErrInvalidImportMailbox
+
+	ErrInvalidExportFormat   = user.ErrInvalidExportFormat
+	ErrExportFormatMismatch  = user.ErrExportFormatMismatch
+	ErrExportInProgress      = user.ErrExportInProgress
+	ErrInvalidExportInterval = errors.New("the export interval must be at least one hour")
+	ErrInvalidExportDest     = errors.New("the export destination must not be empty")
+
+	ErrUpdateNotNewer         = errors.New("the update is not newer than the current version")
+	ErrUpdateVersionMismatch  = errors.New("the update does not have the expected version")
+	ErrUpdateNotAllowed       = errors.New("the update is a bad version or newer than the pinned version")
+	ErrInvalidUpdateMirrorURL = errors.New("the update mirror must be an http or https URL")
 )
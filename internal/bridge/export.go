@@ -0,0 +1,197 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/user"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	// exportCheckInterval is how often the scheduled exports are checked, and run if due.
+	exportCheckInterval = 5 * time.Minute
+
+	// minExportInterval is the shortest interval of a scheduled export, each of which lists all the messages.
+	minExportInterval = time.Hour
+)
+
+// ExportMessages exports the decrypted messages of the given user into the given folder, one Maildir directory or
+// mbox file per mailbox. Only the messages which were not exported into the folder before are exported.
+func (bridge *Bridge) ExportMessages(
+	ctx context.Context,
+	userID string,
+	opts user.ExportOptions,
+	progressCB func(user.ExportProgress),
+) (user.ExportReport, error) {
+	logUser.WithFields(logrus.Fields{
+		"userID": userID,
+		"format": opts.Format,
+		"dest":   opts.Dest,
+	}).Info("Exporting messages")
+
+	if !opts.Format.IsValid() {
+		return user.ExportReport{}, ErrInvalidExportFormat
+	}
+
+	if opts.Dest == "" {
+		return user.ExportReport{}, ErrInvalidExportDest
+	}
+
+	dest, err := filepath.Abs(opts.Dest)
+	if err != nil {
+		return user.ExportReport{}, err
+	}
+
+	opts.Dest = dest
+
+	// The export can take hours, during which the user must not be locked.
+	usr, err := safe.RLockRetErr(func() (*user.User, error) {
+		usr, ok := bridge.users[userID]
+		if !ok {
+			return nil, ErrNoSuchUser
+		}
+
+		return usr, nil
+	}, bridge.usersLock)
+	if err != nil {
+		return user.ExportReport{}, err
+	}
+
+	return usr.Export(ctx, opts, progressCB)
+}
+
+// GetExportSchedule returns the periodic export of the messages of the given user.
+func (bridge *Bridge) GetExportSchedule(userID string) (vault.ExportSchedule, error) {
+	return safe.RLockRetErr(func() (vault.ExportSchedule, error) {
+		usr, ok := bridge.users[userID]
+		if !ok {
+			return vault.ExportSchedule{}, ErrNoSuchUser
+		}
+
+		return usr.ExportSchedule(), nil
+	}, bridge.usersLock)
+}
+
+// SetExportSchedule exports the messages of the given user into the given folder every interval, in the background,
+// the first time one interval from now. A zero interval stops the periodic export.
+func (bridge *Bridge) SetExportSchedule(userID string, format user.ExportFormat, dest string, interval time.Duration) error {
+	logUser.WithFields(logrus.Fields{
+		"userID":   userID,
+		"format":   format,
+		"dest":     dest,
+		"interval": interval,
+	}).Info("Setting export schedule")
+
+	var schedule vault.ExportSchedule
+
+	if interval != 0 {
+		if !format.IsValid() {
+			return ErrInvalidExportFormat
+		}
+
+		if dest == "" {
+			return ErrInvalidExportDest
+		}
+
+		if interval < minExportInterval {
+			return ErrInvalidExportInterval
+		}
+
+		absDest, err := filepath.Abs(dest)
+		if err != nil {
+			return err
+		}
+
+		schedule = vault.ExportSchedule{
+			Format:   string(format),
+			Dest:     absDest,
+			Interval: interval,
+			LastRun:  time.Now(),
+		}
+	}
+
+	return safe.RLockRet(func() error {
+		usr, ok := bridge.users[userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		return usr.SetExportSchedule(schedule)
+	}, bridge.usersLock)
+}
+
+// runScheduledExports runs the scheduled exports which are due, one after the other.
+func (bridge *Bridge) runScheduledExports(ctx context.Context) {
+	users := safe.RLockRet(func() []*user.User {
+		return xslices.Filter(maps.Values(bridge.users), func(usr *user.User) bool {
+			schedule := usr.ExportSchedule()
+			return schedule.IsEnabled() && time.Since(schedule.LastRun) >= schedule.Interval
+		})
+	}, bridge.usersLock)
+
+	for _, usr := range users {
+		schedule := usr.ExportSchedule()
+		log := logUser.WithField("userID", usr.ID()).WithField("dest", schedule.Dest)
+
+		log.Info("Running scheduled export")
+
+		report, err := usr.Export(ctx, user.ExportOptions{Format: user.ExportFormat(schedule.Format), Dest: schedule.Dest}, nil)
+		if ctx.Err() != nil {
+			return
+		}
+
+		// An export run by hand is in progress, the scheduled one runs at the next check.
+		if errors.Is(err, user.ErrExportInProgress) {
+			continue
+		}
+
+		var lastError string
+
+		switch {
+		case err != nil:
+			log.WithError(err).Error("Scheduled export failed")
+			lastError = err.Error()
+
+		case len(report.Failures) > 0:
+			log.WithField("failed", len(report.Failures)).Warn("Scheduled export finished with failures")
+			lastError = fmt.Sprintf("%v messages could not be exported", len(report.Failures))
+
+		default:
+			log.WithField("exported", report.Exported).Info("Scheduled export finished")
+		}
+
+		// The schedule may have been changed during the export.
+		if current := usr.ExportSchedule(); current.Format == schedule.Format && current.Dest == schedule.Dest {
+			current.LastRun, current.LastError = time.Now(), lastError
+
+			if err := usr.SetExportSchedule(current); err != nil {
+				log.WithError(err).Error("Failed to record the scheduled export")
+			}
+		}
+	}
+}
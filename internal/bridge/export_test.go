@@ -0,0 +1,224 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_ExportMessages_Maildir(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		userID, addrID, err := s.CreateUser("user", password)
+		require.NoError(t, err)
+
+		workID, err := s.CreateLabel(userID, "Work", "", proton.LabelTypeFolder)
+		require.NoError(t, err)
+
+		var inboxIDs, workIDs []string
+
+		withClient(ctx, t, s, "user", password, func(ctx context.Context, c *proton.Client) {
+			inboxIDs = createNumMessages(ctx, t, c, addrID, proton.InboxLabel, 2)
+			workIDs = createNumMessages(ctx, t, c, addrID, workID, 1)
+
+			require.NoError(t, c.MarkMessagesUnread(ctx, inboxIDs[1]))
+			require.NoError(t, c.LabelMessages(ctx, []string{inboxIDs[0]}, proton.StarredLabel))
+		})
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, "user", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			dest := t.TempDir()
+
+			// Invalid options are refused.
+			_, err = b.ExportMessages(ctx, userID, user.ExportOptions{Format: "zip", Dest: dest}, nil)
+			require.ErrorIs(t, err, bridge.ErrInvalidExportFormat)
+
+			_, err = b.ExportMessages(ctx, "unknown", user.ExportOptions{Format: user.ExportFormatMaildir, Dest: dest}, nil)
+			require.ErrorIs(t, err, bridge.ErrNoSuchUser)
+
+			opts := user.ExportOptions{Format: user.ExportFormatMaildir, Dest: dest}
+
+			var progress []user.ExportProgress
+
+			report, err := b.ExportMessages(ctx, userID, opts, func(p user.ExportProgress) {
+				progress = append(progress, p)
+			})
+			require.NoError(t, err)
+			require.Equal(t, user.ExportReport{Exported: 3}, report)
+			require.Equal(t, user.ExportProgress{Total: 3, Exported: 3}, progress[len(progress)-1])
+
+			// Each mailbox is a Maildir directory, the starred message being in Starred too, not in All Mail.
+			require.Len(t, readMaildir(t, filepath.Join(dest, "Inbox")), 2)
+			require.Len(t, readMaildir(t, filepath.Join(dest, "Folders", "Work")), 1)
+			require.Len(t, readMaildir(t, filepath.Join(dest, "Starred")), 1)
+			require.NoDirExists(t, filepath.Join(dest, "All Mail"))
+
+			// The flags are kept as Maildir info, the messages being decrypted.
+			inbox := readMaildir(t, filepath.Join(dest, "Inbox"))
+			require.Contains(t, inbox, "2,FS")
+			require.Contains(t, inbox, "2,")
+			require.Contains(t, inbox["2,FS"], "Subject: Test")
+			require.Contains(t, inbox["2,FS"], "X-Pm-Internal-Id: "+inboxIDs[0])
+
+			// Exporting again only updates the flags of the messages exported before and exports the new ones.
+			withClient(ctx, t, s, "user", password, func(ctx context.Context, c *proton.Client) {
+				require.NoError(t, c.MarkMessagesRead(ctx, inboxIDs[1]))
+				require.NoError(t, c.MarkMessagesUnread(ctx, workIDs[0]))
+				createNumMessages(ctx, t, c, addrID, workID, 1)
+			})
+
+			report, err = b.ExportMessages(ctx, userID, opts, nil)
+			require.NoError(t, err)
+			require.Equal(t, user.ExportReport{Exported: 1, Skipped: 3}, report)
+
+			inbox = readMaildir(t, filepath.Join(dest, "Inbox"))
+			require.Contains(t, inbox, "2,FS")
+			require.Contains(t, inbox, "2,S")
+
+			work := readMaildir(t, filepath.Join(dest, "Folders", "Work"))
+			require.Contains(t, work, "2,S")
+			require.Contains(t, work, "2,")
+
+			// The destination cannot hold exports in another format.
+			_, err = b.ExportMessages(ctx, userID, user.ExportOptions{Format: user.ExportFormatMbox, Dest: dest}, nil)
+			require.ErrorIs(t, err, bridge.ErrExportFormatMismatch)
+		})
+	})
+}
+
+func TestBridge_ExportMessages_Mbox(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		_, addrID, err := s.CreateUser("user", password)
+		require.NoError(t, err)
+
+		withClient(ctx, t, s, "user", password, func(ctx context.Context, c *proton.Client) {
+			createNumMessages(ctx, t, c, addrID, proton.InboxLabel, 2)
+		})
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, "user", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			dest := t.TempDir()
+			opts := user.ExportOptions{Format: user.ExportFormatMbox, Dest: dest}
+
+			report, err := b.ExportMessages(ctx, userID, opts, nil)
+			require.NoError(t, err)
+			require.Equal(t, user.ExportReport{Exported: 2}, report)
+
+			mbox, err := os.ReadFile(filepath.Join(dest, "Inbox.mbox"))
+			require.NoError(t, err)
+			require.Equal(t, 2, strings.Count(string(mbox), "From MAILER-DAEMON "))
+			require.Equal(t, 2, strings.Count(string(mbox), "Status: RO\n"))
+
+			// The messages of an interrupted export, written but not recorded, are dropped by the next one.
+			require.NoError(t, os.WriteFile(filepath.Join(dest, "Inbox.mbox"), append(mbox, "From MAILER-DAEMON partial"...), 0o600))
+
+			withClient(ctx, t, s, "user", password, func(ctx context.Context, c *proton.Client) {
+				createNumMessages(ctx, t, c, addrID, proton.InboxLabel, 1)
+			})
+
+			report, err = b.ExportMessages(ctx, userID, opts, nil)
+			require.NoError(t, err)
+			require.Equal(t, user.ExportReport{Exported: 1, Skipped: 2}, report)
+
+			mbox, err = os.ReadFile(filepath.Join(dest, "Inbox.mbox"))
+			require.NoError(t, err)
+			require.Equal(t, 3, strings.Count(string(mbox), "From MAILER-DAEMON "))
+			require.NotContains(t, string(mbox), "partial")
+		})
+	})
+}
+
+func TestBridge_ExportSchedule(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		_, _, err := s.CreateUser("user", password)
+		require.NoError(t, err)
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			userID, err := b.LoginFull(ctx, "user", password, nil, nil)
+			require.NoError(t, err)
+
+			dest := t.TempDir()
+
+			// Invalid schedules are refused.
+			require.ErrorIs(t, b.SetExportSchedule(userID, user.ExportFormatMbox, dest, time.Minute), bridge.ErrInvalidExportInterval)
+			require.ErrorIs(t, b.SetExportSchedule(userID, "zip", dest, time.Hour), bridge.ErrInvalidExportFormat)
+			require.ErrorIs(t, b.SetExportSchedule(userID, user.ExportFormatMbox, "", time.Hour), bridge.ErrInvalidExportDest)
+			require.ErrorIs(t, b.SetExportSchedule("unknown", user.ExportFormatMbox, dest, time.Hour), bridge.ErrNoSuchUser)
+
+			require.NoError(t, b.SetExportSchedule(userID, user.ExportFormatMbox, dest, 24*time.Hour))
+
+			schedule, err := b.GetExportSchedule(userID)
+			require.NoError(t, err)
+			require.True(t, schedule.IsEnabled())
+			require.Equal(t, "mbox", schedule.Format)
+			require.Equal(t, dest, schedule.Dest)
+			require.Equal(t, 24*time.Hour, schedule.Interval)
+			require.WithinDuration(t, time.Now(), schedule.LastRun, time.Minute)
+
+			// A zero interval stops the periodic export.
+			require.NoError(t, b.SetExportSchedule(userID, "", "", 0))
+
+			schedule, err = b.GetExportSchedule(userID)
+			require.NoError(t, err)
+			require.False(t, schedule.IsEnabled())
+		})
+	})
+}
+
+// readMaildir returns the messages of the Maildir directory, by their flags.
+func readMaildir(t *testing.T, dir string) map[string]string {
+	entries, err := os.ReadDir(filepath.Join(dir, "cur"))
+	require.NoError(t, err)
+
+	messages := make(map[string]string)
+
+	for _, entry := range entries {
+		_, flags, ok := strings.Cut(entry.Name(), ":")
+		require.True(t, ok)
+
+		literal, err := os.ReadFile(filepath.Join(dir, "cur", entry.Name()))
+		require.NoError(t, err)
+
+		messages[flags] = string(literal)
+	}
+
+	return messages
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/user"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+)
+
+// ImportOptions are the options of an import of local messages.
+type ImportOptions struct {
+	// Mapping maps source folders, e.g. `Work/Projects`, to mailboxes named as in the mail clients,
+	// e.g. `Folders/Projects`, `Labels/Work` or `Archive`.
+	Mapping map[string]string
+
+	// AsLabels imports the folders which are neither mapped nor system mailboxes as labels rather than folders.
+	AsLabels bool
+
+	// Address is the address the messages are imported into, by default the address they were sent to or from.
+	Address string
+
+	// Restart imports all the messages again, rather than resuming the previous import of the same source.
+	Restart bool
+}
+
+// ImportMessages imports the messages of a local mbox file, Maildir directory or directory of .eml files into the
+// account of the given user. The messages already imported by a previous import of the same source into the same
+// account are skipped, unless restarting, so that an interrupted import can be resumed by running it again.
+func (bridge *Bridge) ImportMessages(
+	ctx context.Context,
+	userID, source string,
+	opts ImportOptions,
+	progressCB func(user.ImportProgress),
+) (user.ImportReport, error) {
+	logUser.WithFields(logrus.Fields{
+		"userID": userID,
+		"source": source,
+	}).Info("Importing messages")
+
+	source, err := filepath.Abs(source)
+	if err != nil {
+		return user.ImportReport{}, err
+	}
+
+	if _, err := os.Stat(source); err != nil {
+		return user.ImportReport{}, err
+	}
+
+	// The import can take hours, during which the user must not be locked.
+	usr, err := safe.RLockRetErr(func() (*user.User, error) {
+		usr, ok := bridge.users[userID]
+		if !ok {
+			return nil, ErrNoSuchUser
+		}
+
+		if opts.Address != "" && !slices.ContainsFunc(usr.Emails(), func(email string) bool {
+			return strings.EqualFold(email, opts.Address)
+		}) {
+			return nil, fmt.Errorf("%w: %v", ErrNoSuchAddress, opts.Address)
+		}
+
+		return usr, nil
+	}, bridge.usersLock)
+	if err != nil {
+		return user.ImportReport{}, err
+	}
+
+	journalPath, err := bridge.getImportJournalPath(userID, source)
+	if err != nil {
+		return user.ImportReport{}, fmt.Errorf("failed to get import journal path: %w", err)
+	}
+
+	if opts.Restart {
+		if err := os.Remove(journalPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return user.ImportReport{}, fmt.Errorf("failed to remove import journal: %w", err)
+		}
+	}
+
+	return usr.Import(ctx, source, user.ImportOptions{
+		Mapping:     opts.Mapping,
+		AsLabels:    opts.AsLabels,
+		Address:     opts.Address,
+		JournalPath: journalPath,
+	}, progressCB)
+}
+
+// getImportJournalPath returns the path of the journal of the imports of the given source into the given account.
+func (bridge *Bridge) getImportJournalPath(userID, source string) (string, error) {
+	dir, err := bridge.locator.ProvideImportsPath()
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256([]byte(userID + "\x00" + source))
+
+	return filepath.Join(dir, hex.EncodeToString(hash[:16])), nil
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/user"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_ImportMessages(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		_, _, err := s.CreateUser("user", password)
+		require.NoError(t, err)
+
+		// A Maildir directory whose root is the inbox, with Maildir++ subfolders.
+		source := t.TempDir()
+
+		writeImportFile(t, filepath.Join(source, "new", "1.host"), "From: a@example.com\r\nTo: user@"+s.GetDomain()+"\r\nSubject: unseen\r\nReceived: by example.com\r\n\r\nbody\r\n")
+		writeImportFile(t, filepath.Join(source, "cur", "2.host:2,FS"), "From: a@example.com\r\nTo: user@"+s.GetDomain()+"\r\nSubject: flagged\r\nReceived: by example.com\r\n\r\nbody\r\n")
+		writeImportFile(t, filepath.Join(source, ".Sent Items", "cur", "3.host:2,S"), "From: user@"+s.GetDomain()+"\r\nTo: a@example.com\r\nSubject: sent\r\n\r\nbody\r\n")
+		writeImportFile(t, filepath.Join(source, ".Work.Projects", "cur", "4.host:2,S"), "From: a@example.com\r\nTo: user@"+s.GetDomain()+"\r\nSubject: project\r\nReceived: by example.com\r\n\r\nbody\r\n")
+		writeImportFile(t, filepath.Join(source, ".Work.Reports", "cur", "5.host:2,S"), "From: a@example.com\r\nTo: user@"+s.GetDomain()+"\r\nSubject: report\r\nReceived: by example.com\r\n\r\nbody\r\n")
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, "user", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			// Invalid options are refused.
+			_, err = b.ImportMessages(ctx, userID, source, bridge.ImportOptions{Mapping: map[string]string{"Work": "Nowhere"}}, nil)
+			require.ErrorIs(t, err, bridge.ErrInvalidImportMailbox)
+
+			_, err = b.ImportMessages(ctx, userID, source, bridge.ImportOptions{Address: "unknown@" + s.GetDomain()}, nil)
+			require.ErrorIs(t, err, bridge.ErrNoSuchAddress)
+
+			_, err = b.ImportMessages(ctx, "unknown", source, bridge.ImportOptions{}, nil)
+			require.ErrorIs(t, err, bridge.ErrNoSuchUser)
+
+			opts := bridge.ImportOptions{Mapping: map[string]string{"work/reports": "Labels/Reports"}}
+
+			var progress []user.ImportProgress
+
+			report, err := b.ImportMessages(ctx, userID, source, opts, func(p user.ImportProgress) {
+				progress = append(progress, p)
+			})
+			require.NoError(t, err)
+			require.Equal(t, user.ImportReport{Imported: 5}, report)
+			require.Equal(t, []user.ImportProgress{{Imported: 5}}, progress)
+
+			withClient(ctx, t, s, "user", password, func(ctx context.Context, c *proton.Client) {
+				labels, err := c.GetLabels(ctx, proton.LabelTypeFolder, proton.LabelTypeLabel)
+				require.NoError(t, err)
+
+				labelIDs := make(map[string]string)
+				for _, label := range labels {
+					labelIDs[label.Name] = label.ID
+				}
+
+				require.Contains(t, labelIDs, "Work")
+				require.Contains(t, labelIDs, "Projects")
+				require.Contains(t, labelIDs, "Reports")
+
+				metadata, err := c.GetMessageMetadata(ctx, proton.MessageFilter{})
+				require.NoError(t, err)
+				require.Len(t, metadata, 5)
+
+				bySubject := make(map[string]proton.MessageMetadata)
+				for _, m := range metadata {
+					bySubject[m.Subject] = m
+				}
+
+				require.True(t, bool(bySubject["unseen"].Unread))
+				require.Contains(t, bySubject["unseen"].LabelIDs, proton.InboxLabel)
+
+				require.False(t, bool(bySubject["flagged"].Unread))
+				require.Contains(t, bySubject["flagged"].LabelIDs, proton.StarredLabel)
+
+				require.Contains(t, bySubject["sent"].LabelIDs, proton.SentLabel)
+				require.True(t, bySubject["sent"].Flags.Has(proton.MessageFlagSent))
+
+				require.Contains(t, bySubject["project"].LabelIDs, labelIDs["Projects"])
+				require.True(t, bySubject["project"].Flags.Has(proton.MessageFlagReceived))
+
+				require.Contains(t, bySubject["report"].LabelIDs, labelIDs["Reports"])
+				require.Contains(t, bySubject["report"].LabelIDs, proton.ArchiveLabel)
+			})
+
+			// Importing the same source again skips the messages already imported, new ones being imported.
+			writeImportFile(t, filepath.Join(source, "new", "6.host"), "From: a@example.com\r\nTo: user@"+s.GetDomain()+"\r\nSubject: later\r\n\r\nbody\r\n")
+
+			report, err = b.ImportMessages(ctx, userID, source, opts, nil)
+			require.NoError(t, err)
+			require.Equal(t, user.ImportReport{Imported: 1, Skipped: 5}, report)
+
+			withClient(ctx, t, s, "user", password, func(ctx context.Context, c *proton.Client) {
+				metadata, err := c.GetMessageMetadata(ctx, proton.MessageFilter{})
+				require.NoError(t, err)
+				require.Len(t, metadata, 6)
+
+				// Without a date, the message is given the date it was delivered.
+				later := metadata[xslices.IndexFunc(metadata, func(m proton.MessageMetadata) bool { return m.Subject == "later" })]
+				msg, err := c.GetMessage(ctx, later.ID)
+				require.NoError(t, err)
+				require.Contains(t, msg.Header, "Date: "+time.Now().Format("02 Jan 06"))
+			})
+		})
+	})
+}
+
+func writeImportFile(t *testing.T, path, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
@@ -17,8 +17,49 @@
 
 package bridge
 
-import "golang.org/x/exp/maps"
+import (
+	"fmt"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+)
 
 func (bridge *Bridge) GetHelpersNames() []string {
 	return maps.Keys(bridge.keychains.GetHelpers())
 }
+
+// migrateVaultKey copies the vault key to the keychain of the given helper, so that the vault can still be opened
+// after switching to it. The helpers that aren't usable on this system are left alone.
+func (bridge *Bridge) migrateVaultKey(from, to string) error {
+	helpers := bridge.keychains.GetHelpers()
+
+	if _, ok := helpers[to]; !ok {
+		logrus.WithField("helper", to).Warn("Keychain helper is not usable, the vault key is not migrated")
+		return nil
+	}
+
+	fromKC, from, err := keychain.NewKeychain(from, constants.KeyChainName, helpers, bridge.keychains.GetDefaultHelper())
+	if err != nil {
+		return fmt.Errorf("could not open keychain: %w", err)
+	}
+
+	if from == to {
+		return nil
+	}
+
+	toKC, _, err := keychain.NewKeychain(to, constants.KeyChainName, helpers, bridge.keychains.GetDefaultHelper())
+	if err != nil {
+		return fmt.Errorf("could not open keychain: %w", err)
+	}
+
+	if err := vault.MigrateVaultKey(fromKC, toKC); err != nil {
+		return fmt.Errorf("could not migrate vault key from %v to %v: %w", from, to, err)
+	}
+
+	logrus.WithFields(logrus.Fields{"from": from, "to": to}).Info("Vault key migrated")
+
+	return nil
+}
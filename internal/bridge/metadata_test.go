@@ -0,0 +1,117 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+package bridge_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/emersion/go-imap"
+	"github.com/emersion/go-imap/client"
+	"github.com/emersion/go-imap/responses"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_MailboxMetadata(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		userID, _, err := s.CreateUser("user", password)
+		require.NoError(t, err)
+
+		workID, err := s.CreateLabel(userID, "Work", "", proton.LabelTypeFolder)
+		require.NoError(t, err)
+
+		// The test server doesn't store the colour of the labels, so the updates are checked on the requests.
+		updateCh := make(chan []byte, 1)
+
+		s.AddCallWatcher(func(call server.Call) {
+			if call.Method == http.MethodPut {
+				updateCh <- call.RequestBody
+			}
+		}, "/core/v4/labels/"+workID)
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, "user", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			client := readOnlyLogin(t, b, info.Addresses[0], info.BridgePass)
+			defer func() { _ = client.Logout() }()
+
+			caps, err := client.Capability()
+			require.NoError(t, err)
+			require.True(t, caps["METADATA"])
+
+			// The entries are read from the labels.
+			require.NoError(t, getMetadata(client, "Folders/Work", "/private/color"))
+			require.Error(t, getMetadata(client, "Folders/Home", "/private/color"))
+
+			// Setting the colour of a folder updates its label.
+			require.NoError(t, setMetadata(client, "Folders/Work", "/private/color", "#ff0000"))
+
+			var req proton.UpdateLabelReq
+
+			require.NoError(t, json.Unmarshal(<-updateCh, &req))
+			require.Equal(t, proton.UpdateLabelReq{Name: "Work", Color: "#ff0000"}, req)
+
+			// The other entries cannot be set, nor can the colour of the system mailboxes.
+			require.Error(t, setMetadata(client, "Folders/Work", "/private/comment", "work"))
+			require.Error(t, setMetadata(client, "INBOX", "/private/color", "#ff0000"))
+		})
+	})
+}
+
+func getMetadata(c *client.Client, mailbox, entry string) error {
+	status, err := c.Execute(&imap.Command{
+		Name:      "GETMETADATA",
+		Arguments: []interface{}{mailbox, imap.RawString(entry)},
+	}, responses.HandlerFunc(func(resp imap.Resp) error {
+		if name, _, ok := imap.ParseNamedResp(resp); !ok || name != "METADATA" {
+			return responses.ErrUnhandled
+		}
+
+		return nil
+	}))
+	if err != nil {
+		return err
+	}
+
+	return status.Err()
+}
+
+func setMetadata(c *client.Client, mailbox, entry, value string) error {
+	status, err := c.Execute(&imap.Command{
+		Name:      "SETMETADATA",
+		Arguments: []interface{}{mailbox, []interface{}{imap.RawString(entry), value}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	return status.Err()
+}
@@ -2,16 +2,20 @@ package bridge
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
 	"os"
+	"slices"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge/mocks"
+	"github.com/ProtonMail/proton-bridge/v3/internal/policy"
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
+	"github.com/ProtonMail/proton-bridge/v3/internal/versioner"
 	"github.com/golang/mock/gomock"
 )
 
@@ -26,6 +30,9 @@ type Mocks struct {
 	CrashHandler *mocks.MockPanicHandler
 	Reporter     *mocks.MockReporter
 	Heartbeat    *mocks.MockHeartbeatManager
+
+	// Policy is the policy enforced by bridge, which locks no setting by default.
+	Policy *policy.Policy
 }
 
 func NewMocks(tb testing.TB, version, minAuto *semver.Version) *Mocks {
@@ -42,6 +49,8 @@ func NewMocks(tb testing.TB, version, minAuto *semver.Version) *Mocks {
 		CrashHandler: mocks.NewMockPanicHandler(ctl),
 		Reporter:     mocks.NewMockReporter(ctl),
 		Heartbeat:    mocks.NewMockHeartbeatManager(ctl),
+
+		Policy: &policy.Policy{},
 	}
 
 	// When getting the TLS issue channel, we want to return the test channel.
@@ -124,6 +133,9 @@ func (provider *TestLocationsProvider) UserCache() string {
 type TestUpdater struct {
 	latest   updater.VersionInfoLegacy
 	releases updater.VersionInfo
+	pinned   *semver.Version
+	bad      []*semver.Version
+	crashes  int
 	lock     sync.RWMutex
 }
 
@@ -135,6 +147,7 @@ func NewTestUpdater(version, minAuto *semver.Version) *TestUpdater {
 
 			RolloutProportion: 1.0,
 		},
+		crashes: versioner.DefaultRollbackCrashes,
 	}
 }
 
@@ -182,3 +195,65 @@ func (testUpdater *TestUpdater) GetVersionInfo(_ context.Context, _ updater.Down
 func (testUpdater *TestUpdater) InstallUpdate(_ context.Context, _ updater.Downloader, _ updater.Release) error {
 	return nil
 }
+
+// InstallUpdateFromFile installs the given test package, which holds its version.
+func (testUpdater *TestUpdater) InstallUpdateFromFile(pkg, _ []byte, canInstall func(*semver.Version) error) (*semver.Version, error) {
+	version, err := semver.NewVersion(string(pkg))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", updater.ErrPackageVerify, err)
+	}
+
+	return version, canInstall(version)
+}
+
+func (testUpdater *TestUpdater) GetPinnedVersion() (*semver.Version, error) {
+	testUpdater.lock.RLock()
+	defer testUpdater.lock.RUnlock()
+
+	return testUpdater.pinned, nil
+}
+
+func (testUpdater *TestUpdater) SetPinnedVersion(version *semver.Version) error {
+	testUpdater.lock.Lock()
+	defer testUpdater.lock.Unlock()
+
+	testUpdater.pinned = version
+
+	return nil
+}
+
+func (testUpdater *TestUpdater) GetRollbackCrashes() (int, error) {
+	testUpdater.lock.RLock()
+	defer testUpdater.lock.RUnlock()
+
+	return testUpdater.crashes, nil
+}
+
+func (testUpdater *TestUpdater) SetRollbackCrashes(crashes int) error {
+	testUpdater.lock.Lock()
+	defer testUpdater.lock.Unlock()
+
+	testUpdater.crashes = crashes
+
+	return nil
+}
+
+func (testUpdater *TestUpdater) IsVersionAllowed(version *semver.Version) bool {
+	testUpdater.lock.RLock()
+	defer testUpdater.lock.RUnlock()
+
+	if testUpdater.pinned != nil && version.GreaterThan(testUpdater.pinned) {
+		return false
+	}
+
+	return !slices.ContainsFunc(testUpdater.bad, version.Equal)
+}
+
+func (testUpdater *TestUpdater) Rollback(version *semver.Version) error {
+	testUpdater.lock.Lock()
+	defer testUpdater.lock.Unlock()
+
+	testUpdater.bad = append(testUpdater.bad, version)
+
+	return nil
+}
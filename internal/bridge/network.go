@@ -0,0 +1,205 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapsmtpserver"
+	"github.com/sirupsen/logrus"
+)
+
+// defaultAllowedNetworks are the networks from which other hosts are accepted when none were configured.
+//
+//nolint:gochecknoglobals
+var defaultAllowedNetworks = []string{
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"169.254.0.0/16",
+	"fc00::/7",
+	"fe80::/10",
+}
+
+// GetBindAddress returns the IP address the IMAP and SMTP servers listen on.
+func (bridge *Bridge) GetBindAddress() string {
+	if address := bridge.vault.GetBindAddress(); address != "" {
+		return address
+	}
+
+	return constants.Host
+}
+
+// SetBindAddress changes the IP address the IMAP and SMTP servers listen on; 0.0.0.0 or :: listens on all interfaces.
+// When the address is reachable from other hosts (LAN mode), IMAP is switched to SSL and SMTP requires TLS to
+// authenticate, and only the clients of the allowed networks are accepted.
+func (bridge *Bridge) SetBindAddress(ctx context.Context, address string) error {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return fmt.Errorf("%w: %q", ErrInvalidBindAddress, address)
+	}
+
+	address = ip.String()
+	if address == bridge.GetBindAddress() {
+		return nil
+	}
+
+	if !ip.IsLoopback() {
+		logrus.WithField("address", address).Warn("IMAP and SMTP servers will be reachable from other hosts")
+
+		if !bridge.vault.GetIMAPSSL() {
+			if err := bridge.vault.SetIMAPSSL(true); err != nil {
+				return err
+			}
+
+			bridge.heartbeat.SetIMAPConnectionMode(true)
+		}
+	}
+
+	if address == constants.Host {
+		address = ""
+	}
+
+	if err := bridge.vault.SetBindAddress(address); err != nil {
+		return err
+	}
+
+	if err := bridge.restartIMAP(ctx); err != nil {
+		return err
+	}
+
+	return bridge.restartSMTP(ctx)
+}
+
+// IsLANMode returns whether the IMAP and SMTP servers are reachable from other hosts.
+func (bridge *Bridge) IsLANMode() bool {
+	ip := net.ParseIP(bridge.GetBindAddress())
+
+	return ip != nil && !ip.IsLoopback()
+}
+
+// GetHost returns the address local clients should connect to.
+func (bridge *Bridge) GetHost() string {
+	if ip := net.ParseIP(bridge.GetBindAddress()); ip != nil && !ip.IsUnspecified() {
+		return ip.String()
+	}
+
+	return constants.Host
+}
+
+// GetAllowedNetworks returns the networks, in CIDR notation, from which other hosts are accepted in LAN mode.
+func (bridge *Bridge) GetAllowedNetworks() []string {
+	if networks := bridge.vault.GetAllowedNetworks(); networks != nil {
+		return networks
+	}
+
+	return append([]string{}, defaultAllowedNetworks...)
+}
+
+// SetAllowedNetworks changes the networks from which other hosts are accepted in LAN mode.
+// A nil list restores the default private networks; it applies to new connections only.
+func (bridge *Bridge) SetAllowedNetworks(networks []string) error {
+	if networks == nil {
+		return bridge.vault.SetAllowedNetworks(nil)
+	}
+
+	normalized := make([]string, 0, len(networks))
+
+	for _, network := range networks {
+		_, ipNet, err := net.ParseCIDR(network)
+		if err != nil {
+			return fmt.Errorf("%w: %q", ErrInvalidNetwork, network)
+		}
+
+		normalized = append(normalized, ipNet.String())
+	}
+
+	return bridge.vault.SetAllowedNetworks(normalized)
+}
+
+func (bridge *Bridge) GetUnixSockets() bool {
+	return bridge.vault.GetUnixSockets()
+}
+
+// SetUnixSockets enables or disables the IMAP and SMTP Unix sockets; they are served alongside the TCP ports.
+func (bridge *Bridge) SetUnixSockets(ctx context.Context, enabled bool) error {
+	if enabled == bridge.vault.GetUnixSockets() {
+		return nil
+	}
+
+	if err := bridge.vault.SetUnixSockets(enabled); err != nil {
+		return err
+	}
+
+	if err := bridge.restartIMAP(ctx); err != nil {
+		return err
+	}
+
+	return bridge.restartSMTP(ctx)
+}
+
+// GetIMAPSocketPath returns the path of the IMAP Unix socket, whether it is enabled or not.
+func (bridge *Bridge) GetIMAPSocketPath() (string, error) {
+	return bridge.getSocketPath(imapsmtpserver.IMAPSocketName)
+}
+
+// GetSMTPSocketPath returns the path of the SMTP Unix socket, whether it is enabled or not.
+func (bridge *Bridge) GetSMTPSocketPath() (string, error) {
+	return bridge.getSocketPath(imapsmtpserver.SMTPSocketName)
+}
+
+func (bridge *Bridge) getSocketPath(name string) (string, error) {
+	dir, err := bridge.locator.ProvideSocketsPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, name), nil
+}
+
+type bridgeNetworkSettings struct {
+	b *Bridge
+}
+
+func (b *bridgeNetworkSettings) BindAddress() string {
+	return b.b.GetBindAddress()
+}
+
+func (b *bridgeNetworkSettings) AllowedNetworks() []*net.IPNet {
+	networks := make([]*net.IPNet, 0)
+
+	for _, network := range b.b.GetAllowedNetworks() {
+		if _, ipNet, err := net.ParseCIDR(network); err == nil {
+			networks = append(networks, ipNet)
+		}
+	}
+
+	return networks
+}
+
+func (b *bridgeNetworkSettings) SocketDirectory() (string, error) {
+	if !b.b.vault.GetUnixSockets() {
+		return "", nil
+	}
+
+	return b.b.locator.ProvideSocketsPath()
+}
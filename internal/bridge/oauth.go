@@ -0,0 +1,237 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/oauthserver"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultOAuthPort is the port of the local OAuth server when none was configured.
+const DefaultOAuthPort = 1180
+
+// GetOAuth returns whether the local OAuth server is enabled.
+func (bridge *Bridge) GetOAuth() bool {
+	return bridge.vault.GetOAuthEnabled()
+}
+
+// SetOAuth enables or disables the local OAuth server. When enabled, mail clients can obtain short-lived tokens
+// from it once the user allowed their request, and authenticate with them over IMAP and SMTP using the OAUTHBEARER
+// and XOAUTH2 mechanisms. Disabling it invalidates all the issued tokens.
+func (bridge *Bridge) SetOAuth(ctx context.Context, enabled bool) error {
+	if enabled == bridge.vault.GetOAuthEnabled() {
+		return nil
+	}
+
+	if enabled {
+		if err := bridge.startOAuth(); err != nil {
+			return err
+		}
+	} else {
+		bridge.oauthServer.Stop()
+	}
+
+	if err := bridge.vault.SetOAuthEnabled(enabled); err != nil {
+		return err
+	}
+
+	if err := bridge.restartIMAP(ctx); err != nil {
+		return err
+	}
+
+	return bridge.restartSMTP(ctx)
+}
+
+// GetOAuthPort returns the port of the local OAuth server.
+func (bridge *Bridge) GetOAuthPort() int {
+	if port := bridge.vault.GetOAuthPort(); port != 0 {
+		return port
+	}
+
+	return DefaultOAuthPort
+}
+
+// SetOAuthPort changes the port of the local OAuth server, restarting it if it is enabled.
+func (bridge *Bridge) SetOAuthPort(_ context.Context, port int) error {
+	if port == bridge.GetOAuthPort() {
+		return nil
+	}
+
+	if err := bridge.vault.SetOAuthPort(port); err != nil {
+		return err
+	}
+
+	if !bridge.vault.GetOAuthEnabled() {
+		return nil
+	}
+
+	bridge.oauthServer.Stop()
+
+	return bridge.startOAuth()
+}
+
+// GetOAuthAuthorizeURL returns the authorization endpoint of the local OAuth server.
+func (bridge *Bridge) GetOAuthAuthorizeURL() string {
+	return fmt.Sprintf("http://%v:%v/authorize", constants.Host, bridge.GetOAuthPort())
+}
+
+// GetOAuthTokenURL returns the token endpoint of the local OAuth server.
+func (bridge *Bridge) GetOAuthTokenURL() string {
+	return fmt.Sprintf("http://%v:%v/token", constants.Host, bridge.GetOAuthPort())
+}
+
+// GetOAuthRequests returns the OAuth authorization requests waiting for the user's decision.
+func (bridge *Bridge) GetOAuthRequests() []oauthserver.Request {
+	return bridge.oauthServer.Requests()
+}
+
+// ResolveOAuthRequest allows or denies an OAuth authorization request. When allowed, the mail client gets tokens to
+// authenticate as the user of the request.
+func (bridge *Bridge) ResolveOAuthRequest(requestID string, allow bool) error {
+	logPkg.WithField("requestID", requestID).WithField("allow", allow).Info("Resolving OAuth authorization request")
+
+	if err := bridge.oauthServer.Resolve(requestID, allow); err != nil {
+		if errors.Is(err, oauthserver.ErrNoSuchRequest) {
+			return ErrNoSuchOAuthRequest
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (bridge *Bridge) startOAuth() error {
+	port, err := bridge.oauthServer.Start(bridge.GetOAuthPort())
+	if err != nil {
+		return fmt.Errorf("failed to start OAuth server: %w", err)
+	}
+
+	logPkg.WithField("port", port).Info("OAuth server started")
+
+	return nil
+}
+
+type bridgeOAuthUsers struct {
+	b *Bridge
+}
+
+// ResolveOAuthUser returns the user owning the given address, or the only user if the address is empty.
+func (b *bridgeOAuthUsers) ResolveOAuthUser(address string) (string, string, error) {
+	type result struct {
+		userID, email string
+	}
+
+	res, err := safe.RLockRetErr(func() (result, error) {
+		if address == "" {
+			if len(b.b.users) != 1 {
+				return result{}, fmt.Errorf("%w: a login hint is required when several users are logged in", ErrNoSuchUser)
+			}
+
+			for _, user := range b.b.users {
+				if emails := user.Emails(); len(emails) > 0 {
+					return result{userID: user.ID(), email: emails[0]}, nil
+				}
+			}
+
+			return result{}, ErrNoSuchUser
+		}
+
+		for _, user := range b.b.users {
+			if idx := xslices.IndexFunc(user.Emails(), func(email string) bool {
+				return strings.EqualFold(email, address)
+			}); idx >= 0 {
+				return result{userID: user.ID(), email: user.Emails()[idx]}, nil
+			}
+		}
+
+		return result{}, ErrNoSuchUser
+	}, b.b.usersLock)
+
+	return res.userID, res.email, err
+}
+
+type bridgeOAuthVerifier struct {
+	b *Bridge
+}
+
+func (b *bridgeOAuthVerifier) OAuthEnabled() bool {
+	return b.b.vault.GetOAuthEnabled()
+}
+
+// VerifyOAuthToken returns the address of the user the token was issued to, the given one if the user owns it, and
+// the login password standing for the token, which is checked again, with its scope, by the user.
+func (b *bridgeOAuthVerifier) VerifyOAuthToken(username, token string) (string, string, error) {
+	userID, _, err := b.b.oauthServer.VerifyToken(token)
+	if err != nil {
+		return "", "", err
+	}
+
+	email, err := safe.RLockRetErr(func() (string, error) {
+		user, ok := b.b.users[userID]
+		if !ok {
+			return "", ErrNoSuchUser
+		}
+
+		emails := user.Emails()
+
+		if username == "" {
+			if len(emails) == 0 {
+				return "", ErrNoSuchUser
+			}
+
+			return emails[0], nil
+		}
+
+		if !xslices.Any(emails, func(email string) bool { return strings.EqualFold(email, username) }) {
+			logrus.WithField("userID", userID).Warn("OAuth token used for an address of another user")
+			return "", oauthserver.ErrInvalidToken
+		}
+
+		return username, nil
+	}, b.b.usersLock)
+	if err != nil {
+		return "", "", err
+	}
+
+	return email, b.b.oauthServer.LoginPassword(token), nil
+}
+
+// VerifyOAuthUserPassword returns the scope of the token of the given login password and the ID of its authorization
+// if it was issued to the given user while OAuth is enabled.
+func (b *bridgeOAuthVerifier) VerifyOAuthUserPassword(userID, password string) (vault.AppPasswordScope, string, bool) {
+	if !b.OAuthEnabled() {
+		return "", "", false
+	}
+
+	tokenUserID, grantID, scope, err := b.b.oauthServer.VerifyLoginPassword(password)
+	if err != nil || tokenUserID != userID {
+		return "", "", false
+	}
+
+	return scope, grantID, true
+}
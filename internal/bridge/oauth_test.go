@@ -0,0 +1,245 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/ports"
+	"github.com/emersion/go-sasl"
+	"github.com/emersion/go-smtp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_OAuth(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			// The OAuth mechanisms are not offered by default.
+			client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+
+			ok, err := client.SupportAuth(sasl.OAuthBearer)
+			require.NoError(t, err)
+			require.False(t, ok)
+			require.NoError(t, client.Logout())
+
+			require.NoError(t, b.SetOAuthPort(ctx, ports.FindFreePortFrom(bridge.DefaultOAuthPort)))
+			require.NoError(t, b.SetOAuth(ctx, true))
+
+			// The user allows the authorization request of the client.
+			requestCh, done := chToType[events.Event, events.OAuthAuthorizationRequested](b.GetEvents(events.OAuthAuthorizationRequested{}))
+			defer done()
+
+			codeCh := make(chan string, 1)
+
+			go func() {
+				codeCh <- oauthAuthorize(b, info.Addresses[0], "")
+			}()
+
+			request := <-requestCh
+			require.Equal(t, userID, request.UserID)
+			require.Equal(t, info.Addresses[0], request.Email)
+			require.Equal(t, "all", request.Scope)
+			require.Len(t, b.GetOAuthRequests(), 1)
+			require.NoError(t, b.ResolveOAuthRequest(request.RequestID, true))
+			require.ErrorIs(t, b.ResolveOAuthRequest(request.RequestID, true), bridge.ErrNoSuchOAuthRequest)
+
+			token := oauthToken(t, b, <-codeCh)
+
+			// The token authenticates over IMAP and SMTP.
+			client, err = eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+
+			ok, err = client.SupportAuth(sasl.OAuthBearer)
+			require.NoError(t, err)
+			require.True(t, ok)
+
+			require.Error(t, client.Authenticate(sasl.NewOAuthBearerClient(&sasl.OAuthBearerOptions{Username: info.Addresses[0], Token: "wrong"})))
+			require.NoError(t, client.Authenticate(sasl.NewOAuthBearerClient(&sasl.OAuthBearerOptions{Username: info.Addresses[0], Token: token})))
+			require.NoError(t, client.Noop())
+			require.NoError(t, client.Logout())
+
+			client, err = eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			require.NoError(t, client.Authenticate(&xoauth2Client{username: info.Addresses[0], token: token}))
+			require.NoError(t, client.Logout())
+
+			require.Error(t, smtpOAuth(b, sasl.NewOAuthBearerClient(&sasl.OAuthBearerOptions{Token: "wrong"})))
+			require.NoError(t, smtpOAuth(b, sasl.NewOAuthBearerClient(&sasl.OAuthBearerOptions{Token: token})))
+			require.NoError(t, smtpOAuth(b, &xoauth2Client{username: info.Addresses[0], token: token}))
+
+			// The token is not a password.
+			client, err = eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			require.Error(t, client.Login(info.Addresses[0], token))
+			require.NoError(t, client.Logout())
+
+			require.Error(t, smtpOAuth(b, sasl.NewPlainClient("", info.Addresses[0], token)))
+
+			// A token is limited to its scope, like an app password.
+			go func() {
+				codeCh <- oauthAuthorize(b, info.Addresses[0], "imap-readonly")
+			}()
+
+			request = <-requestCh
+			require.Equal(t, "imap-readonly", request.Scope)
+			require.NoError(t, b.ResolveOAuthRequest(request.RequestID, true))
+
+			readOnlyToken := oauthToken(t, b, <-codeCh)
+
+			client, err = eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			require.NoError(t, client.Authenticate(sasl.NewOAuthBearerClient(&sasl.OAuthBearerOptions{Token: readOnlyToken})))
+			require.Error(t, client.Create("Folders/OAuth"))
+			require.NoError(t, client.Logout())
+
+			require.Error(t, smtpOAuth(b, sasl.NewOAuthBearerClient(&sasl.OAuthBearerOptions{Token: readOnlyToken})))
+
+			// Logging the user out revokes the tokens and closes the sessions authenticated with them.
+			smtpClient, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetSMTPPort())))
+			require.NoError(t, err)
+			defer smtpClient.Close() //nolint:errcheck
+
+			require.NoError(t, smtpClient.StartTLS(&tls.Config{InsecureSkipVerify: true})) //nolint:gosec
+			require.NoError(t, smtpClient.Auth(sasl.NewOAuthBearerClient(&sasl.OAuthBearerOptions{Token: token})))
+			require.NoError(t, smtpClient.Noop())
+
+			require.NoError(t, b.LogoutUser(ctx, userID))
+			require.Eventually(t, func() bool { return smtpClient.Noop() != nil }, 5*time.Second, 100*time.Millisecond)
+			require.Error(t, smtpOAuth(b, sasl.NewOAuthBearerClient(&sasl.OAuthBearerOptions{Token: token})))
+
+			// Disabling OAuth invalidates the tokens.
+			require.NoError(t, b.SetOAuth(ctx, false))
+			require.Error(t, smtpOAuth(b, sasl.NewOAuthBearerClient(&sasl.OAuthBearerOptions{Token: token})))
+
+			client, err = eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			defer func() { _ = client.Logout() }()
+
+			ok, err = client.SupportAuth(sasl.OAuthBearer)
+			require.NoError(t, err)
+			require.False(t, ok)
+		})
+	})
+}
+
+// xoauth2Client implements the client side of the XOAUTH2 mechanism.
+type xoauth2Client struct {
+	username, token string
+}
+
+func (c *xoauth2Client) Start() (string, []byte, error) {
+	return "XOAUTH2", []byte("user=" + c.username + "\x01auth=Bearer " + c.token + "\x01\x01"), nil
+}
+
+func (c *xoauth2Client) Next([]byte) ([]byte, error) {
+	return []byte{}, nil
+}
+
+// oauthCodeVerifier is the PKCE code verifier of the authorization requests.
+const oauthCodeVerifier = "a-code-verifier-long-enough-for-the-test"
+
+// oauthAuthorize requests an authorization code with the given scope for the given address.
+func oauthAuthorize(b *bridge.Bridge, address, scope string) string {
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+
+	challenge := sha256.Sum256([]byte(oauthCodeVerifier))
+
+	res, err := client.Get(b.GetOAuthAuthorizeURL() + "?" + url.Values{ //nolint:noctx
+		"response_type":         {"code"},
+		"client_id":             {"client"},
+		"redirect_uri":          {"http://127.0.0.1:4321/callback"},
+		"login_hint":            {address},
+		"scope":                 {scope},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}.Encode())
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	location, err := res.Location()
+	if err != nil {
+		return ""
+	}
+
+	return location.Query().Get("code")
+}
+
+// oauthToken exchanges the given authorization code for an access token.
+func oauthToken(t *testing.T, b *bridge.Bridge, code string) string {
+	res, err := http.PostForm(b.GetOAuthTokenURL(), url.Values{ //nolint:noctx
+		"grant_type":    {"authorization_code"},
+		"client_id":     {"client"},
+		"redirect_uri":  {"http://127.0.0.1:4321/callback"},
+		"code":          {code},
+		"code_verifier": {oauthCodeVerifier},
+	})
+	require.NoError(t, err)
+	defer func() { _ = res.Body.Close() }()
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var tokens struct {
+		AccessToken string `json:"access_token"`
+	}
+
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&tokens))
+
+	return tokens.AccessToken
+}
+
+func smtpOAuth(b *bridge.Bridge, auth sasl.Client) error {
+	client, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetSMTPPort())))
+	if err != nil {
+		return err
+	}
+	defer client.Close() //nolint:errcheck
+
+	if err := client.StartTLS(&tls.Config{InsecureSkipVerify: true}); err != nil { //nolint:gosec
+		return err
+	}
+
+	return client.Auth(auth)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/policy"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapsmtpserver"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+)
+
+// GetLockedSettings returns the names of the settings locked by the policy, which cannot be changed.
+func (bridge *Bridge) GetLockedSettings() []string {
+	return bridge.policy.LockedSettings()
+}
+
+// IsSettingLocked returns whether the setting with the given name is locked by the policy.
+func (bridge *Bridge) IsSettingLocked(name string) bool {
+	return bridge.policy.IsLocked(name)
+}
+
+// checkPolicy returns ErrManagedByPolicy if the setting with the given name is locked and would be changed.
+func (bridge *Bridge) checkPolicy(name string, changed bool) error {
+	if changed && bridge.policy.IsLocked(name) {
+		return ErrManagedByPolicy
+	}
+
+	return nil
+}
+
+// applyPolicy writes the values of the locked settings to the vault.
+func applyPolicy(v *vault.Vault, policy *policy.Policy) error {
+	if len(policy.LockedSettings()) > 0 {
+		logPkg.WithField("locked", policy.LockedSettings()).Info("Applying policy")
+	}
+
+	for _, apply := range []func() error{
+		lockSetting(policy.IMAPPort, v.GetIMAPPort, v.SetIMAPPort),
+		lockSetting(policy.SMTPPort, v.GetSMTPPort, v.SetSMTPPort),
+		lockSetting(policy.IMAPSSL, v.GetIMAPSSL, v.SetIMAPSSL),
+		lockSetting(policy.SMTPSSL, v.GetSMTPSSL, v.SetSMTPSSL),
+		lockSetting(policy.TelemetryDisabled, v.GetTelemetryDisabled, v.SetTelemetryDisabled),
+		lockSetting(policy.AutoUpdate, v.GetAutoUpdate, v.SetAutoUpdate),
+		lockSetting(policy.UpdateChannel, v.GetUpdateChannel, v.SetUpdateChannel),
+		lockSetting(policy.ProxyAllowed, v.GetProxyAllowed, v.SetProxyAllowed),
+		lockSetting(policy.ShowAllMail, v.GetShowAllMail, v.SetShowAllMail),
+		lockGluonDir(v, policy.DiskCachePath),
+	} {
+		if err := apply(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lockGluonDir returns a function moving the gluon cache into the directory locked by the policy, if any, like
+// SetGluonDir does. It is called before the gluon server is started, so the cache can be moved right away.
+func lockGluonDir(v *vault.Vault, locked *string) func() error {
+	return func() error {
+		if locked == nil {
+			return nil
+		}
+
+		oldGluonDir, newGluonDir := v.GetGluonCacheDir(), filepath.Join(*locked, "gluon")
+		if oldGluonDir == newGluonDir {
+			return nil
+		}
+
+		// There is nothing to move if gluon never stored anything yet.
+		if _, err := os.Stat(imapsmtpserver.ApplyGluonCachePathSuffix(oldGluonDir)); errors.Is(err, os.ErrNotExist) {
+			return v.SetGluonDir(newGluonDir)
+		}
+
+		if err := imapsmtpserver.MoveGluonCacheDir(oldGluonDir, newGluonDir, v.SetGluonDir); err != nil {
+			return fmt.Errorf("failed to move the gluon cache to the disk cache path of the policy: %w", err)
+		}
+
+		return nil
+	}
+}
+
+// lockSetting returns a function setting the given locked value, if any, when the current value differs.
+func lockSetting[T comparable](locked *T, get func() T, set func(T) error) func() error {
+	return func() error {
+		if locked == nil || get() == *locked {
+			return nil
+		}
+
+		return set(*locked)
+	}
+}
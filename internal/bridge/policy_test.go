@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/policy"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapsmtpserver"
+	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_Policy(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withMocks(t, func(mocks *bridge.Mocks) {
+			showAllMail, telemetryDisabled, channel := false, true, updater.EarlyChannel
+
+			var oldGluonDir string
+
+			mocks.Policy = &policy.Policy{
+				ShowAllMail:       &showAllMail,
+				TelemetryDisabled: &telemetryDisabled,
+				UpdateChannel:     &channel,
+				AllowedAccounts:   []string{"*@example.com"},
+			}
+
+			withBridgeNoMocks(ctx, t, mocks, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge) {
+				// The locked settings are enforced at startup.
+				require.False(t, b.GetShowAllMail())
+				require.True(t, b.GetTelemetryDisabled())
+				require.Equal(t, updater.EarlyChannel, b.GetUpdateChannel())
+				require.Equal(t, []string{policy.SettingShowAllMail, policy.SettingTelemetryDisabled, policy.SettingUpdateChannel}, b.GetLockedSettings())
+
+				// They cannot be changed, but setting the locked value is not an error.
+				require.ErrorIs(t, b.SetShowAllMail(true), bridge.ErrManagedByPolicy)
+				require.ErrorIs(t, b.SetUpdateChannel(updater.StableChannel), bridge.ErrManagedByPolicy)
+				require.NoError(t, b.SetTelemetryDisabled(true))
+
+				_, err := b.SetSetting(ctx, "telemetry-disabled", "false")
+				require.ErrorIs(t, err, bridge.ErrManagedByPolicy)
+				require.True(t, b.GetTelemetryDisabled())
+
+				// The other settings can still be changed.
+				require.False(t, b.IsSettingLocked("doh"))
+				require.NoError(t, b.SetAutoUpdate(false))
+
+				// Only the allowed accounts can log in.
+				_, err = b.LoginFull(ctx, username, password, nil, nil)
+				require.ErrorIs(t, err, bridge.ErrAccountNotAllowed)
+				require.Empty(t, b.GetUserIDs())
+
+				mocks.Policy.AllowedAccounts = append(mocks.Policy.AllowedAccounts, username)
+
+				_, err = b.LoginFull(ctx, username, password, nil, nil)
+				require.NoError(t, err)
+
+				oldGluonDir = b.GetGluonCacheDir()
+				require.DirExists(t, imapsmtpserver.ApplyGluonCachePathSuffix(oldGluonDir))
+			}, false)
+
+			cacheDir := t.TempDir()
+
+			mocks.Policy = &policy.Policy{
+				DiskCachePath:   &cacheDir,
+				AllowedAccounts: []string{"*@example.com"},
+			}
+
+			withBridgeNoMocks(ctx, t, mocks, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge) {
+				// The gluon cache is moved into the locked disk cache path.
+				require.Equal(t, filepath.Join(cacheDir, "gluon"), b.GetGluonCacheDir())
+				require.DirExists(t, imapsmtpserver.ApplyGluonCachePathSuffix(b.GetGluonCacheDir()))
+				require.NoDirExists(t, imapsmtpserver.ApplyGluonCachePathSuffix(oldGluonDir))
+
+				// The accounts which are no longer allowed are not loaded.
+				require.Len(t, b.GetUserIDs(), 1)
+
+				info, err := b.GetUserInfo(b.GetUserIDs()[0])
+				require.NoError(t, err)
+				require.Equal(t, bridge.Locked, info.State)
+			}, false)
+		})
+	})
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/emersion/go-imap"
+	"github.com/emersion/go-imap/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_ReadOnly(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		_, addrID, err := s.CreateUser("imap", password)
+		require.NoError(t, err)
+
+		withClient(ctx, t, s, "imap", password, func(ctx context.Context, c *proton.Client) {
+			messageIDs := createNumMessages(ctx, t, c, addrID, proton.InboxLabel, 2)
+			require.NoError(t, c.MarkMessagesUnread(ctx, messageIDs...))
+		})
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, "imap", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+			require.False(t, info.ReadOnly)
+
+			_, archiverPass, err := b.CreateAppPassword(userID, "archiver", vault.AppPasswordScopeIMAPReadOnly)
+			require.NoError(t, err)
+
+			// The sessions of a read-only app password can't change the mailbox.
+			archiver := readOnlyLogin(t, b, info.Addresses[0], archiverPass)
+			defer func() { _ = archiver.Logout() }()
+
+			requireReadOnly(t, archiver)
+			require.Error(t, smtpAuth(b, info.Addresses[0], archiverPass))
+
+			// The bridge password is not affected.
+			client := readOnlyLogin(t, b, info.Addresses[0], info.BridgePass)
+			defer func() { _ = client.Logout() }()
+
+			status, err := client.Select("INBOX", false)
+			require.NoError(t, err)
+			require.False(t, status.ReadOnly)
+			require.NoError(t, smtpAuth(b, info.Addresses[0], info.BridgePass))
+
+			// Once the user is read-only, so are all its sessions, and SMTP is disabled.
+			readOnlyCh, done := chToType[events.Event, events.UserReadOnlyChanged](b.GetEvents(events.UserReadOnlyChanged{}))
+			defer done()
+
+			require.NoError(t, b.SetUserReadOnly(userID, true))
+			require.True(t, (<-readOnlyCh).ReadOnly)
+
+			info, err = b.GetUserInfo(userID)
+			require.NoError(t, err)
+			require.True(t, info.ReadOnly)
+
+			require.Error(t, client.Create("Folders/archive"))
+			require.Error(t, smtpAuth(b, info.Addresses[0], info.BridgePass))
+
+			readOnly := readOnlyLogin(t, b, info.Addresses[0], info.BridgePass)
+			defer func() { _ = readOnly.Logout() }()
+
+			requireReadOnly(t, readOnly)
+
+			// Making the user read-write again restores the access.
+			require.NoError(t, b.SetUserReadOnly(userID, false))
+			require.False(t, (<-readOnlyCh).ReadOnly)
+
+			require.NoError(t, client.Create("Folders/archive"))
+			require.NoError(t, smtpAuth(b, info.Addresses[0], info.BridgePass))
+		})
+	})
+}
+
+// readOnlyLogin logs in over IMAP after STARTTLS.
+func readOnlyLogin(t *testing.T, b *bridge.Bridge, address string, password []byte) *client.Client {
+	client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+	require.NoError(t, err)
+
+	require.NoError(t, client.StartTLS(&tls.Config{InsecureSkipVerify: true})) //nolint:gosec
+	require.NoError(t, client.Login(address, string(password)))
+
+	return client
+}
+
+// requireReadOnly checks that the INBOX is opened read-only and that the session can't change the mailbox.
+func requireReadOnly(t *testing.T, client *client.Client) {
+	status, err := client.Select("INBOX", false)
+	require.NoError(t, err)
+	require.True(t, status.ReadOnly)
+	require.Equal(t, uint32(2), status.Messages)
+
+	// Fetching a message doesn't mark it as seen.
+	messages, err := clientFetch(client, "INBOX", imap.FetchRFC822)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+
+	messages, err = clientFetch(client, "INBOX")
+	require.NoError(t, err)
+	require.NotContains(t, messages[0].Flags, imap.SeenFlag)
+
+	seqSet := new(imap.SeqSet)
+	seqSet.AddNum(1)
+
+	require.Error(t, clientStore(client, 1, 1, false, imap.AddFlags, imap.DeletedFlag))
+	require.Error(t, client.Move(seqSet, "Trash"))
+	require.Error(t, client.Create("Folders/archive"))
+	require.Error(t, client.Append("INBOX", nil, time.Now(), imap.Literal(bytes.NewReader([]byte("To: someone@pm.me\r\n\r\nhello")))))
+}
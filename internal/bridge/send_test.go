@@ -34,6 +34,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	smtpservice "github.com/ProtonMail/proton-bridge/v3/internal/services/smtp"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/emersion/go-imap"
 	"github.com/emersion/go-sasl"
 	"github.com/emersion/go-smtp"
@@ -735,3 +736,101 @@ func TestBridge_SendAddressDisabled(t *testing.T) {
 		})
 	})
 }
+
+func TestBridge_SendStrictSplitMode(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		_, _, err := s.CreateUser("recipient", password)
+		require.NoError(t, err)
+
+		senderUserID, _, err := s.CreateUser("sender", password)
+		require.NoError(t, err)
+
+		_, err = s.CreateAddress(senderUserID, "other@"+s.GetDomain(), password, true)
+		require.NoError(t, err)
+
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			senderUserID, err := b.LoginFull(ctx, "sender", password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, senderUserID, (<-syncCh).UserID)
+
+			_, err = b.LoginFull(ctx, "recipient", password, nil, nil)
+			require.NoError(t, err)
+			<-syncCh
+
+			require.NoError(t, b.SetAddressMode(ctx, senderUserID, vault.SplitMode))
+			require.Equal(t, senderUserID, (<-syncCh).UserID)
+
+			require.NoError(t, b.SetUserStrictSplitMode(senderUserID, true))
+
+			senderInfo, err := b.GetUserInfo(senderUserID)
+			require.NoError(t, err)
+			require.True(t, senderInfo.StrictSplitMode)
+			require.Len(t, senderInfo.Addresses, 2)
+
+			authAddr, otherAddr := senderInfo.Addresses[0], senderInfo.Addresses[1]
+
+			// Each message differs so that none is skipped as a duplicate of the previous ones.
+			var sent int
+
+			send := func(from string) error {
+				sent++
+
+				client, err := smtp.Dial(net.JoinHostPort(constants.Host, fmt.Sprint(b.GetSMTPPort())))
+				require.NoError(t, err)
+				defer client.Close() //nolint:errcheck
+
+				require.NoError(t, client.StartTLS(&tls.Config{InsecureSkipVerify: true}))
+				require.NoError(t, client.Auth(sasl.NewLoginClient(authAddr, string(senderInfo.BridgePass))))
+
+				return client.SendMail(
+					from,
+					[]string{"recipient@" + s.GetDomain()},
+					strings.NewReader(fmt.Sprintf("From: %v\r\nSubject: Test %v from %v\r\n\r\nHello world!", from, sent, from)),
+				)
+			}
+
+			// The session authenticated with the first address can't send as the second one.
+			err = send(otherAddr)
+			require.Error(t, err)
+			require.Equal(t, fmt.Sprintf("Error: %v", smtpservice.NewErrSenderNotAuthenticated(otherAddr, authAddr).Error()), err.Error())
+
+			// It can send as the first address.
+			require.NoError(t, send(authAddr))
+
+			// The Sent copies only land in the mailbox of the first address.
+			countSent := func(addr string) uint32 {
+				client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+				require.NoError(t, err)
+				require.NoError(t, client.Login(addr, string(senderInfo.BridgePass)))
+				defer func() { _ = client.Logout() }()
+
+				status, err := client.Status("Sent", []imap.StatusItem{imap.StatusMessages})
+				require.NoError(t, err)
+
+				return status.Messages
+			}
+
+			require.Eventually(t, func() bool {
+				return countSent(authAddr) == 1
+			}, 10*time.Second, 100*time.Millisecond)
+
+			require.Zero(t, countSent(otherAddr))
+
+			// The addresses sharing a mailbox through a group can send as each other.
+			require.NoError(t, b.SetAddressGroup(ctx, senderUserID, "team", []string{authAddr, otherAddr}))
+			require.Equal(t, senderUserID, (<-syncCh).UserID)
+			require.NoError(t, send(otherAddr))
+
+			require.NoError(t, b.RemoveAddressGroup(ctx, senderUserID, "team"))
+			require.Equal(t, senderUserID, (<-syncCh).UserID)
+			require.Error(t, send(otherAddr))
+
+			// Without the strict split mode, the session can send as the second address again.
+			require.NoError(t, b.SetUserStrictSplitMode(senderUserID, false))
+			require.NoError(t, send(otherAddr))
+		})
+	})
+}
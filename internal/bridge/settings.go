@@ -20,13 +20,19 @@ package bridge
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/proton-bridge/v3/internal/kb"
+	"github.com/ProtonMail/proton-bridge/v3/internal/policy"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapservice"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/userevents"
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/bradenaw/juniper/xslices"
 )
 
 func (bridge *Bridge) GetKeychainApp() (string, error) {
@@ -44,6 +50,16 @@ func (bridge *Bridge) SetKeychainApp(helper string) error {
 		return err
 	}
 
+	current, err := vault.GetHelper(vaultDir)
+	if err != nil {
+		return err
+	}
+
+	// The vault key is copied over first; otherwise, a new one would be generated on the next start.
+	if err := bridge.migrateVaultKey(current, helper); err != nil {
+		return err
+	}
+
 	bridge.heartbeat.SetKeyChainPref(helper)
 
 	return vault.SetHelper(vaultDir, helper)
@@ -54,6 +70,10 @@ func (bridge *Bridge) GetIMAPPort() int {
 }
 
 func (bridge *Bridge) SetIMAPPort(ctx context.Context, newPort int) error {
+	if err := bridge.checkPolicy(policy.SettingIMAPPort, newPort != bridge.vault.GetIMAPPort()); err != nil {
+		return err
+	}
+
 	if newPort == bridge.vault.GetIMAPPort() {
 		return nil
 	}
@@ -72,10 +92,18 @@ func (bridge *Bridge) GetIMAPSSL() bool {
 }
 
 func (bridge *Bridge) SetIMAPSSL(ctx context.Context, newSSL bool) error {
+	if err := bridge.checkPolicy(policy.SettingIMAPSSL, newSSL != bridge.vault.GetIMAPSSL()); err != nil {
+		return err
+	}
+
 	if newSSL == bridge.vault.GetIMAPSSL() {
 		return nil
 	}
 
+	if !newSSL && bridge.IsLANMode() {
+		return ErrIMAPSSLRequiredOnLAN
+	}
+
 	if err := bridge.vault.SetIMAPSSL(newSSL); err != nil {
 		return err
 	}
@@ -90,6 +118,10 @@ func (bridge *Bridge) GetSMTPPort() int {
 }
 
 func (bridge *Bridge) SetSMTPPort(ctx context.Context, newPort int) error {
+	if err := bridge.checkPolicy(policy.SettingSMTPPort, newPort != bridge.vault.GetSMTPPort()); err != nil {
+		return err
+	}
+
 	if newPort == bridge.vault.GetSMTPPort() {
 		return nil
 	}
@@ -108,6 +140,10 @@ func (bridge *Bridge) GetSMTPSSL() bool {
 }
 
 func (bridge *Bridge) SetSMTPSSL(ctx context.Context, newSSL bool) error {
+	if err := bridge.checkPolicy(policy.SettingSMTPSSL, newSSL != bridge.vault.GetSMTPSSL()); err != nil {
+		return err
+	}
+
 	if newSSL == bridge.vault.GetSMTPSSL() {
 		return nil
 	}
@@ -130,6 +166,10 @@ func (bridge *Bridge) GetGluonDataDir() (string, error) {
 }
 
 func (bridge *Bridge) SetGluonDir(ctx context.Context, newGluonDir string) error {
+	if err := bridge.checkPolicy(policy.SettingDiskCachePath, newGluonDir != bridge.vault.GetGluonCacheDir()); err != nil {
+		return err
+	}
+
 	bridge.usersLock.RLock()
 
 	defer func() {
@@ -170,6 +210,10 @@ func (bridge *Bridge) GetProxyAllowed() bool {
 }
 
 func (bridge *Bridge) SetProxyAllowed(allowed bool) error {
+	if err := bridge.checkPolicy(policy.SettingProxyAllowed, allowed != bridge.vault.GetProxyAllowed()); err != nil {
+		return err
+	}
+
 	if allowed {
 		bridge.proxyCtl.AllowProxy()
 	} else {
@@ -186,6 +230,10 @@ func (bridge *Bridge) GetShowAllMail() bool {
 }
 
 func (bridge *Bridge) SetShowAllMail(show bool) error {
+	if err := bridge.checkPolicy(policy.SettingShowAllMail, show != bridge.vault.GetShowAllMail()); err != nil {
+		return err
+	}
+
 	return safe.RLockRet(func() error {
 		for _, user := range bridge.users {
 			user.SetShowAllMail(show)
@@ -197,6 +245,77 @@ func (bridge *Bridge) SetShowAllMail(show bool) error {
 	}, bridge.usersLock)
 }
 
+// The system views which can be shown to the mail clients.
+const (
+	SystemViewStarred       = "starred"
+	SystemViewSnoozed       = "snoozed"
+	SystemViewScheduled     = "scheduled"
+	SystemViewAlmostAllMail = "almost-all-mail"
+)
+
+// GetSystemViewNames returns the names of the system views which can be shown to the mail clients.
+func GetSystemViewNames() []string {
+	return []string{
+		SystemViewStarred,
+		SystemViewSnoozed,
+		SystemViewScheduled,
+		SystemViewAlmostAllMail,
+	}
+}
+
+// GetSystemViews returns the system views shown to the mail clients.
+func (bridge *Bridge) GetSystemViews() []string {
+	if names := bridge.vault.GetSystemViews(); names != nil {
+		return names
+	}
+
+	return []string{SystemViewStarred, SystemViewScheduled}
+}
+
+// SetSystemViews sets the system views shown to the mail clients.
+func (bridge *Bridge) SetSystemViews(names []string) error {
+	for _, name := range names {
+		if !slices.Contains(GetSystemViewNames(), name) {
+			return fmt.Errorf("%w: %q", ErrUnknownSystemView, name)
+		}
+	}
+
+	// An empty selection must not be mistaken for the default selection.
+	if names == nil {
+		names = []string{}
+	}
+
+	return safe.RLockRet(func() error {
+		for _, user := range bridge.users {
+			user.SetSystemViews(getSystemViewLabelIDs(names))
+		}
+
+		return bridge.vault.SetSystemViews(names)
+	}, bridge.usersLock)
+}
+
+// getSystemViewLabelIDs returns the IDs of the labels of the given system views.
+func getSystemViewLabelIDs(names []string) []string {
+	return xslices.Map(names, func(name string) string {
+		switch name {
+		case SystemViewStarred:
+			return proton.StarredLabel
+
+		case SystemViewSnoozed:
+			return imapservice.SnoozedLabel
+
+		case SystemViewScheduled:
+			return proton.AllScheduledLabel
+
+		case SystemViewAlmostAllMail:
+			return imapservice.AlmostAllMailLabel
+
+		default:
+			return ""
+		}
+	})
+}
+
 func (bridge *Bridge) GetAutostart() bool {
 	return bridge.vault.GetAutostart()
 }
@@ -237,6 +356,10 @@ func (bridge *Bridge) GetAutoUpdate() bool {
 }
 
 func (bridge *Bridge) SetAutoUpdate(autoUpdate bool) error {
+	if err := bridge.checkPolicy(policy.SettingAutoUpdate, autoUpdate != bridge.vault.GetAutoUpdate()); err != nil {
+		return err
+	}
+
 	if bridge.vault.GetAutoUpdate() == autoUpdate {
 		return nil
 	}
@@ -257,6 +380,10 @@ func (bridge *Bridge) GetTelemetryDisabled() bool {
 }
 
 func (bridge *Bridge) SetTelemetryDisabled(isDisabled bool) error {
+	if err := bridge.checkPolicy(policy.SettingTelemetryDisabled, isDisabled != bridge.vault.GetTelemetryDisabled()); err != nil {
+		return err
+	}
+
 	if err := bridge.vault.SetTelemetryDisabled(isDisabled); err != nil {
 		return err
 	}
@@ -270,11 +397,75 @@ func (bridge *Bridge) SetTelemetryDisabled(isDisabled bool) error {
 	return nil
 }
 
+// The events which can raise a desktop notification.
+const (
+	DesktopNotificationUserDeauth      = "user-deauth"
+	DesktopNotificationUserBadEvent    = "user-bad-event"
+	DesktopNotificationIMAPLoginFailed = "imap-login-failed"
+	DesktopNotificationTLSIssue        = "tls-issue"
+	DesktopNotificationUpdateAvailable = "update-available"
+	DesktopNotificationSyncFailed      = "sync-failed"
+)
+
+// GetDesktopNotificationEventNames returns the names of the events which can raise a desktop notification.
+func GetDesktopNotificationEventNames() []string {
+	return []string{
+		DesktopNotificationUserDeauth,
+		DesktopNotificationUserBadEvent,
+		DesktopNotificationIMAPLoginFailed,
+		DesktopNotificationTLSIssue,
+		DesktopNotificationUpdateAvailable,
+		DesktopNotificationSyncFailed,
+	}
+}
+
+func (bridge *Bridge) GetDesktopNotifications() bool {
+	return bridge.vault.GetDesktopNotifications()
+}
+
+func (bridge *Bridge) SetDesktopNotifications(enabled bool) error {
+	return bridge.vault.SetDesktopNotifications(enabled)
+}
+
+// GetDesktopNotificationEvents returns the events raising a desktop notification.
+func (bridge *Bridge) GetDesktopNotificationEvents() []string {
+	if names := bridge.vault.GetDesktopNotificationEvents(); names != nil {
+		return names
+	}
+
+	return GetDesktopNotificationEventNames()
+}
+
+// SetDesktopNotificationEvents sets the events raising a desktop notification.
+func (bridge *Bridge) SetDesktopNotificationEvents(names []string) error {
+	for _, name := range names {
+		if !slices.Contains(GetDesktopNotificationEventNames(), name) {
+			return fmt.Errorf("%w: %q", ErrUnknownDesktopNotificationEvent, name)
+		}
+	}
+
+	// An empty selection must not be mistaken for the default selection of all events.
+	if names == nil {
+		names = []string{}
+	}
+
+	return bridge.vault.SetDesktopNotificationEvents(names)
+}
+
+// IsDesktopNotificationEnabled returns whether the given event should raise a desktop notification.
+func (bridge *Bridge) IsDesktopNotificationEnabled(name string) bool {
+	return bridge.GetDesktopNotifications() && slices.Contains(bridge.GetDesktopNotificationEvents(), name)
+}
+
 func (bridge *Bridge) GetUpdateChannel() updater.Channel {
 	return bridge.vault.GetUpdateChannel()
 }
 
 func (bridge *Bridge) SetUpdateChannel(channel updater.Channel) error {
+	if err := bridge.checkPolicy(policy.SettingUpdateChannel, channel != bridge.vault.GetUpdateChannel()); err != nil {
+		return err
+	}
+
 	if bridge.vault.GetUpdateChannel() == channel {
 		return nil
 	}
@@ -290,6 +481,32 @@ func (bridge *Bridge) SetUpdateChannel(channel updater.Channel) error {
 	return nil
 }
 
+func (bridge *Bridge) GetUpdateMirrorURL() string {
+	return bridge.vault.GetUpdateMirrorURL()
+}
+
+// SetUpdateMirrorURL sets the mirror the updates are downloaded from, with the same layout as the update host.
+// An empty URL restores the update host.
+func (bridge *Bridge) SetUpdateMirrorURL(mirrorURL string) error {
+	if mirrorURL != "" {
+		if u, err := url.Parse(mirrorURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ErrInvalidUpdateMirrorURL
+		}
+	}
+
+	if bridge.vault.GetUpdateMirrorURL() == mirrorURL {
+		return nil
+	}
+
+	if err := bridge.vault.SetUpdateMirrorURL(mirrorURL); err != nil {
+		return err
+	}
+
+	bridge.goUpdate()
+
+	return nil
+}
+
 func (bridge *Bridge) GetCurrentVersion() *semver.Version {
 	return bridge.curVersion
 }
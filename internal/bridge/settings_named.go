@@ -0,0 +1,350 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
+	"golang.org/x/exp/maps"
+)
+
+var (
+	ErrUnknownSetting      = errors.New("unknown setting")
+	ErrInvalidSettingValue = errors.New("invalid setting value")
+)
+
+// namedSetting exposes a setting under a stable name, with its value as a string, for scripting purposes.
+type namedSetting struct {
+	get func(bridge *Bridge) (string, error)
+	set func(ctx context.Context, bridge *Bridge, value string) error
+}
+
+//nolint:gochecknoglobals
+var namedSettings = map[string]namedSetting{
+	"imap-port": portSetting((*Bridge).GetIMAPPort, (*Bridge).SetIMAPPort),
+	"smtp-port": portSetting((*Bridge).GetSMTPPort, (*Bridge).SetSMTPPort),
+	"imap-ssl":  boolSetting((*Bridge).GetIMAPSSL, (*Bridge).SetIMAPSSL),
+	"smtp-ssl":  boolSetting((*Bridge).GetSMTPSSL, (*Bridge).SetSMTPSSL),
+
+	"bind-address": {
+		get: func(bridge *Bridge) (string, error) {
+			return bridge.GetBindAddress(), nil
+		},
+		set: func(ctx context.Context, bridge *Bridge, value string) error {
+			if err := bridge.SetBindAddress(ctx, value); err != nil {
+				if errors.Is(err, ErrInvalidBindAddress) {
+					return fmt.Errorf("%w: %v", ErrInvalidSettingValue, err)
+				}
+
+				return err
+			}
+
+			return nil
+		},
+	},
+
+	"allowed-networks": {
+		get: func(bridge *Bridge) (string, error) {
+			return strings.Join(bridge.GetAllowedNetworks(), ","), nil
+		},
+		set: func(_ context.Context, bridge *Bridge, value string) error {
+			var networks []string
+
+			// An empty value restores the default private networks.
+			for _, network := range strings.Split(value, ",") {
+				if network = strings.TrimSpace(network); network != "" {
+					networks = append(networks, network)
+				}
+			}
+
+			if err := bridge.SetAllowedNetworks(networks); err != nil {
+				return fmt.Errorf("%w: %v", ErrInvalidSettingValue, err)
+			}
+
+			return nil
+		},
+	},
+
+	"unix-sockets": boolSetting((*Bridge).GetUnixSockets, (*Bridge).SetUnixSockets),
+
+	"oauth":      boolSetting((*Bridge).GetOAuth, (*Bridge).SetOAuth),
+	"oauth-port": portSetting((*Bridge).GetOAuthPort, (*Bridge).SetOAuthPort),
+
+	"show-all-mail":      boolSetting((*Bridge).GetShowAllMail, withoutContext((*Bridge).SetShowAllMail)),
+	"doh":                boolSetting((*Bridge).GetProxyAllowed, withoutContext((*Bridge).SetProxyAllowed)),
+	"autostart":          boolSetting((*Bridge).GetAutostart, withoutContext((*Bridge).SetAutostart)),
+	"auto-update":        boolSetting((*Bridge).GetAutoUpdate, withoutContext((*Bridge).SetAutoUpdate)),
+	"telemetry-disabled": boolSetting((*Bridge).GetTelemetryDisabled, withoutContext((*Bridge).SetTelemetryDisabled)),
+
+	"desktop-notifications": boolSetting((*Bridge).GetDesktopNotifications, withoutContext((*Bridge).SetDesktopNotifications)),
+
+	"desktop-notification-events": {
+		get: func(bridge *Bridge) (string, error) {
+			return strings.Join(bridge.GetDesktopNotificationEvents(), ","), nil
+		},
+		set: func(_ context.Context, bridge *Bridge, value string) error {
+			names := []string{}
+
+			for _, name := range strings.Split(value, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					names = append(names, name)
+				}
+			}
+
+			if err := bridge.SetDesktopNotificationEvents(names); err != nil {
+				return fmt.Errorf("%w: %v, expected a comma separated list of %v",
+					ErrInvalidSettingValue, err, strings.Join(GetDesktopNotificationEventNames(), ", "))
+			}
+
+			return nil
+		},
+	},
+
+	"system-views": {
+		get: func(bridge *Bridge) (string, error) {
+			return strings.Join(bridge.GetSystemViews(), ","), nil
+		},
+		set: func(_ context.Context, bridge *Bridge, value string) error {
+			names := []string{}
+
+			for _, name := range strings.Split(value, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					names = append(names, name)
+				}
+			}
+
+			if err := bridge.SetSystemViews(names); err != nil {
+				if errors.Is(err, ErrUnknownSystemView) {
+					return fmt.Errorf("%w: %v, expected a comma separated list of %v",
+						ErrInvalidSettingValue, err, strings.Join(GetSystemViewNames(), ", "))
+				}
+
+				return err
+			}
+
+			return nil
+		},
+	},
+
+	"update-channel": {
+		get: func(bridge *Bridge) (string, error) {
+			return string(bridge.GetUpdateChannel()), nil
+		},
+		set: func(_ context.Context, bridge *Bridge, value string) error {
+			switch channel := updater.Channel(value); channel {
+			case updater.StableChannel, updater.EarlyChannel:
+				return bridge.SetUpdateChannel(channel)
+
+			default:
+				return fmt.Errorf("%w: expected %v or %v", ErrInvalidSettingValue, updater.StableChannel, updater.EarlyChannel)
+			}
+		},
+	},
+
+	"update-mirror-url": {
+		get: func(bridge *Bridge) (string, error) {
+			return bridge.GetUpdateMirrorURL(), nil
+		},
+		set: func(_ context.Context, bridge *Bridge, value string) error {
+			if err := bridge.SetUpdateMirrorURL(strings.TrimSpace(value)); err != nil {
+				if errors.Is(err, ErrInvalidUpdateMirrorURL) {
+					return fmt.Errorf("%w: %v", ErrInvalidSettingValue, err)
+				}
+
+				return err
+			}
+
+			return nil
+		},
+	},
+
+	"update-pin": {
+		get: func(bridge *Bridge) (string, error) {
+			version, err := bridge.GetPinnedVersion()
+			if err != nil || version == nil {
+				return "", err
+			}
+
+			return version.String(), nil
+		},
+		set: func(_ context.Context, bridge *Bridge, value string) error {
+			// An empty value removes the pin.
+			if value = strings.TrimSpace(value); value == "" {
+				return bridge.SetPinnedVersion(nil)
+			}
+
+			version, err := semver.StrictNewVersion(value)
+			if err != nil {
+				return fmt.Errorf("%w: expected a version such as 3.15.0", ErrInvalidSettingValue)
+			}
+
+			return bridge.SetPinnedVersion(version)
+		},
+	},
+
+	"update-rollback-crashes": {
+		get: func(bridge *Bridge) (string, error) {
+			crashes, err := bridge.GetRollbackCrashes()
+			if err != nil {
+				return "", err
+			}
+
+			return strconv.Itoa(crashes), nil
+		},
+		set: func(_ context.Context, bridge *Bridge, value string) error {
+			crashes, err := strconv.Atoi(value)
+			if err != nil || crashes < 0 {
+				return fmt.Errorf("%w: expected a number of crashes, 0 never rolling back", ErrInvalidSettingValue)
+			}
+
+			return bridge.SetRollbackCrashes(crashes)
+		},
+	},
+
+	"log-level": {
+		get: func(_ *Bridge) (string, error) {
+			return logging.GetLevels(), nil
+		},
+		set: func(_ context.Context, _ *Bridge, value string) error {
+			if err := logging.SetLevels(value); err != nil {
+				return fmt.Errorf("%w: %v", ErrInvalidSettingValue, err)
+			}
+
+			return nil
+		},
+	},
+
+	"disk-cache-path": {
+		get: func(bridge *Bridge) (string, error) {
+			return bridge.GetGluonCacheDir(), nil
+		},
+		set: func(ctx context.Context, bridge *Bridge, value string) error {
+			if value == "" {
+				return fmt.Errorf("%w: the path cannot be empty", ErrInvalidSettingValue)
+			}
+
+			return bridge.SetGluonDir(ctx, value)
+		},
+	},
+
+	"keychain": {
+		get: func(bridge *Bridge) (string, error) {
+			return bridge.GetKeychainApp()
+		},
+		set: func(_ context.Context, bridge *Bridge, value string) error {
+			if _, ok := bridge.keychains.GetHelpers()[value]; !ok {
+				return fmt.Errorf("%w: unknown keychain %q", ErrInvalidSettingValue, value)
+			}
+
+			return bridge.SetKeychainApp(value)
+		},
+	},
+}
+
+// GetSettingNames returns the sorted names of the settings that can be read and changed by name.
+func GetSettingNames() []string {
+	names := maps.Keys(namedSettings)
+	sort.Strings(names)
+
+	return names
+}
+
+// GetSetting returns the current value of the setting with the given name.
+func (bridge *Bridge) GetSetting(name string) (string, error) {
+	setting, ok := namedSettings[name]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownSetting, name)
+	}
+
+	return setting.get(bridge)
+}
+
+// SetSetting changes the setting with the given name. Nothing is done if the setting already has the requested value,
+// which is reported by the returned boolean.
+func (bridge *Bridge) SetSetting(ctx context.Context, name, value string) (bool, error) {
+	setting, ok := namedSettings[name]
+	if !ok {
+		return false, fmt.Errorf("%w: %q", ErrUnknownSetting, name)
+	}
+
+	current, err := setting.get(bridge)
+	if err != nil {
+		return false, err
+	}
+
+	if err := setting.set(ctx, bridge, value); err != nil {
+		return false, err
+	}
+
+	updated, err := setting.get(bridge)
+	if err != nil {
+		return false, err
+	}
+
+	return updated != current, nil
+}
+
+func withoutContext[T any](set func(*Bridge, T) error) func(*Bridge, context.Context, T) error {
+	return func(bridge *Bridge, _ context.Context, value T) error {
+		return set(bridge, value)
+	}
+}
+
+func boolSetting(get func(*Bridge) bool, set func(*Bridge, context.Context, bool) error) namedSetting {
+	return namedSetting{
+		get: func(bridge *Bridge) (string, error) {
+			return strconv.FormatBool(get(bridge)), nil
+		},
+		set: func(ctx context.Context, bridge *Bridge, value string) error {
+			v, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("%w: expected true or false", ErrInvalidSettingValue)
+			}
+
+			if v == get(bridge) {
+				return nil
+			}
+
+			return set(bridge, ctx, v)
+		},
+	}
+}
+
+func portSetting(get func(*Bridge) int, set func(*Bridge, context.Context, int) error) namedSetting {
+	return namedSetting{
+		get: func(bridge *Bridge) (string, error) {
+			return strconv.Itoa(get(bridge)), nil
+		},
+		set: func(ctx context.Context, bridge *Bridge, value string) error {
+			port, err := strconv.Atoi(value)
+			if err != nil || port < 1 || port > 65535 {
+				return fmt.Errorf("%w: expected a port number between 1 and 65535", ErrInvalidSettingValue)
+			}
+
+			return set(bridge, ctx, port)
+		},
+	}
+}
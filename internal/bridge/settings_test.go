@@ -18,14 +18,22 @@
 package bridge_test
 
 import (
+	"bufio"
 	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/go-proton-api/server"
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/emersion/go-imap"
 	"github.com/stretchr/testify/require"
 )
 
@@ -123,6 +131,88 @@ func TestBridge_Settings_IMAPSSL(t *testing.T) {
 	})
 }
 
+func TestBridge_Settings_BindAddress(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			// By default, the servers listen on the loopback address.
+			require.Equal(t, constants.Host, b.GetBindAddress())
+			require.False(t, b.IsLANMode())
+			require.False(t, b.GetIMAPSSL())
+
+			require.ErrorIs(t, b.SetBindAddress(ctx, "localhost"), bridge.ErrInvalidBindAddress)
+
+			// Listening on all interfaces forces IMAP SSL.
+			require.NoError(t, b.SetBindAddress(ctx, "0.0.0.0"))
+			require.True(t, b.IsLANMode())
+			require.True(t, b.GetIMAPSSL())
+			require.Equal(t, constants.Host, b.GetHost())
+			require.ErrorIs(t, b.SetIMAPSSL(ctx, false), bridge.ErrIMAPSSLRequiredOnLAN)
+
+			// Local clients can still connect.
+			conn, err := tls.Dial("tcp", fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()), &tls.Config{InsecureSkipVerify: true}) //nolint:gosec
+			require.NoError(t, err)
+			require.NoError(t, conn.Close())
+
+			// The allowed networks default to the private networks.
+			require.Contains(t, b.GetAllowedNetworks(), "192.168.0.0/16")
+			require.NoError(t, b.SetAllowedNetworks([]string{"192.168.1.7/24"}))
+			require.Equal(t, []string{"192.168.1.0/24"}, b.GetAllowedNetworks())
+			require.ErrorIs(t, b.SetAllowedNetworks([]string{"192.168.1.7"}), bridge.ErrInvalidNetwork)
+
+			// Going back to the loopback address leaves IMAP SSL as is.
+			require.NoError(t, b.SetBindAddress(ctx, constants.Host))
+			require.False(t, b.IsLANMode())
+			require.NoError(t, b.SetIMAPSSL(ctx, false))
+		})
+	})
+}
+
+func TestBridge_Settings_UnixSockets(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			imapPath, err := b.GetIMAPSocketPath()
+			require.NoError(t, err)
+
+			smtpPath, err := b.GetSMTPSocketPath()
+			require.NoError(t, err)
+
+			// By default, there are no Unix sockets.
+			require.False(t, b.GetUnixSockets())
+			require.NoFileExists(t, imapPath)
+
+			require.NoError(t, b.SetUnixSockets(ctx, true))
+
+			// The servers greet their clients on the sockets, which only the user can access.
+			for path, greeting := range map[string]string{imapPath: "* OK", smtpPath: "220 "} {
+				info, err := os.Stat(path)
+				require.NoError(t, err)
+				require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+				conn, err := net.Dial("unix", path)
+				require.NoError(t, err)
+
+				line, err := bufio.NewReader(conn).ReadString('\n')
+				require.NoError(t, err)
+				require.True(t, strings.HasPrefix(line, greeting), line)
+				require.NoError(t, conn.Close())
+			}
+
+			// The TCP ports are still served.
+			client, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			require.NoError(t, client.Logout())
+
+			// The sockets follow the servers restarts.
+			require.NoError(t, b.SetIMAPSSL(ctx, true))
+			require.FileExists(t, imapPath)
+
+			require.NoError(t, b.SetUnixSockets(ctx, false))
+			require.NoFileExists(t, imapPath)
+			require.NoFileExists(t, smtpPath)
+		})
+	})
+}
+
 func TestBridge_Settings_SMTPPort(t *testing.T) {
 	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
 		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, _ *bridge.Mocks) {
@@ -196,6 +286,150 @@ func TestBridge_Settings_Autostart(t *testing.T) {
 	})
 }
 
+func TestBridge_Settings_Named(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			require.Contains(t, bridge.GetSettingNames(), "imap-port")
+
+			// Setting a new value reports a change.
+			changed, err := b.SetSetting(ctx, "imap-port", "1144")
+			require.NoError(t, err)
+			require.True(t, changed)
+			require.Equal(t, 1144, b.GetIMAPPort())
+
+			value, err := b.GetSetting("imap-port")
+			require.NoError(t, err)
+			require.Equal(t, "1144", value)
+
+			// Setting the same value again is a no-op.
+			changed, err = b.SetSetting(ctx, "imap-port", "1144")
+			require.NoError(t, err)
+			require.False(t, changed)
+
+			changed, err = b.SetSetting(ctx, "show-all-mail", "false")
+			require.NoError(t, err)
+			require.True(t, changed)
+			require.False(t, b.GetShowAllMail())
+
+			_, err = b.SetSetting(ctx, "imap-port", "99999")
+			require.ErrorIs(t, err, bridge.ErrInvalidSettingValue)
+
+			_, err = b.SetSetting(ctx, "show-all-mail", "maybe")
+			require.ErrorIs(t, err, bridge.ErrInvalidSettingValue)
+
+			_, err = b.GetSetting("no-such-setting")
+			require.ErrorIs(t, err, bridge.ErrUnknownSetting)
+		})
+	})
+}
+
+func TestBridge_Settings_UpdateMirrorURL(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			// By default, the updates are downloaded from the update host.
+			require.Empty(t, b.GetUpdateMirrorURL())
+
+			changed, err := b.SetSetting(ctx, "update-mirror-url", "https://mirror.example.com/download")
+			require.NoError(t, err)
+			require.True(t, changed)
+			require.Equal(t, "https://mirror.example.com/download", b.GetUpdateMirrorURL())
+
+			// Only http and https URLs are accepted.
+			_, err = b.SetSetting(ctx, "update-mirror-url", "ftp://mirror.example.com")
+			require.ErrorIs(t, err, bridge.ErrInvalidSettingValue)
+			require.ErrorIs(t, b.SetUpdateMirrorURL("mirror.example.com"), bridge.ErrInvalidUpdateMirrorURL)
+
+			// An empty value restores the update host.
+			changed, err = b.SetSetting(ctx, "update-mirror-url", "")
+			require.NoError(t, err)
+			require.True(t, changed)
+			require.Empty(t, b.GetUpdateMirrorURL())
+		})
+	})
+}
+
+func TestBridge_Settings_DesktopNotifications(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			// By default, desktop notifications are disabled but all events are selected.
+			require.False(t, b.GetDesktopNotifications())
+			require.Equal(t, bridge.GetDesktopNotificationEventNames(), b.GetDesktopNotificationEvents())
+			require.False(t, b.IsDesktopNotificationEnabled(bridge.DesktopNotificationSyncFailed))
+
+			require.NoError(t, b.SetDesktopNotifications(true))
+			require.True(t, b.IsDesktopNotificationEnabled(bridge.DesktopNotificationSyncFailed))
+
+			// Only the selected events raise a notification.
+			changed, err := b.SetSetting(ctx, "desktop-notification-events", "user-deauth, tls-issue")
+			require.NoError(t, err)
+			require.True(t, changed)
+			require.True(t, b.IsDesktopNotificationEnabled(bridge.DesktopNotificationTLSIssue))
+			require.False(t, b.IsDesktopNotificationEnabled(bridge.DesktopNotificationSyncFailed))
+
+			// An empty selection disables all events.
+			require.NoError(t, b.SetDesktopNotificationEvents(nil))
+			require.Empty(t, b.GetDesktopNotificationEvents())
+
+			require.ErrorIs(t, b.SetDesktopNotificationEvents([]string{"no-such-event"}), bridge.ErrUnknownDesktopNotificationEvent)
+
+			_, err = b.SetSetting(ctx, "desktop-notification-events", "no-such-event")
+			require.ErrorIs(t, err, bridge.ErrInvalidSettingValue)
+		})
+	})
+}
+
+func TestBridge_Settings_SystemViews(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			// By default, Starred and Scheduled are shown.
+			require.Equal(t, []string{bridge.SystemViewStarred, bridge.SystemViewScheduled}, b.GetSystemViews())
+
+			require.NoError(t, getErr(b.LoginFull(ctx, username, password, nil, nil)))
+
+			info, err := b.QueryUserInfo(username)
+			require.NoError(t, err)
+
+			cli, err := eventuallyDial(fmt.Sprintf("%v:%v", constants.Host, b.GetIMAPPort()))
+			require.NoError(t, err)
+			require.NoError(t, cli.Login(info.Addresses[0], string(info.BridgePass)))
+			defer func() { _ = cli.Logout() }()
+
+			findMailbox := func(name string) *imap.MailboxInfo {
+				for _, mailbox := range clientList(cli) {
+					if mailbox.Name == name {
+						return mailbox
+					}
+				}
+
+				return nil
+			}
+
+			// Starred is listed with the \Flagged special-use attribute.
+			require.Eventually(t, func() bool {
+				return findMailbox("Starred") != nil
+			}, 10*time.Second, 100*time.Millisecond)
+			require.Contains(t, findMailbox("Starred").Attributes, `\Flagged`)
+			require.Contains(t, findMailbox("Trash").Attributes, `\Trash`)
+
+			// Starred is no longer listed once hidden.
+			changed, err := b.SetSetting(ctx, "system-views", "snoozed, almost-all-mail")
+			require.NoError(t, err)
+			require.True(t, changed)
+			require.Equal(t, []string{bridge.SystemViewSnoozed, bridge.SystemViewAlmostAllMail}, b.GetSystemViews())
+			require.Nil(t, findMailbox("Starred"))
+
+			// An empty selection hides all the system views.
+			require.NoError(t, b.SetSystemViews(nil))
+			require.Empty(t, b.GetSystemViews())
+
+			require.ErrorIs(t, b.SetSystemViews([]string{"no-such-view"}), bridge.ErrUnknownSystemView)
+
+			_, err = b.SetSetting(ctx, "system-views", "no-such-view")
+			require.ErrorIs(t, err, bridge.ErrInvalidSettingValue)
+		})
+	})
+}
+
 func TestBridge_Settings_FirstStart(t *testing.T) {
 	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
 		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, storeKey, func(bridge *bridge.Bridge, _ *bridge.Mocks) {
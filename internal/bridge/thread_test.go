@@ -0,0 +1,180 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package bridge_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/go-proton-api/server"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/emersion/go-imap"
+	"github.com/emersion/go-imap/client"
+	"github.com/emersion/go-imap/responses"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge_Thread(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridge.Locator, storeKey []byte) {
+		// The test server doesn't return the conversation of the messages, which is added by subject.
+		hostURL, closeProxy := newConversationProxy(t, s.GetHostURL(), map[string]string{
+			"Hello":           "c1",
+			"Other":           "c2",
+			"Changed subject": "c1",
+		})
+		defer closeProxy()
+
+		withBridge(ctx, t, hostURL, netCtl, locator, storeKey, func(b *bridge.Bridge, _ *bridge.Mocks) {
+			syncCh, done := chToType[events.Event, events.SyncFinished](b.GetEvents(events.SyncFinished{}))
+			defer done()
+
+			userID, err := b.LoginFull(ctx, username, password, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, userID, (<-syncCh).UserID)
+
+			info, err := b.GetUserInfo(userID)
+			require.NoError(t, err)
+
+			client := readOnlyLogin(t, b, info.Addresses[0], info.BridgePass)
+			defer func() { _ = client.Logout() }()
+
+			caps, err := client.Capability()
+			require.NoError(t, err)
+			require.True(t, caps["THREAD=REFERENCES"])
+			require.True(t, caps["X-PM-CONVERSATION"])
+
+			for _, header := range []string{
+				"Subject: Hello\r\nDate: Wed, 01 Jan 2025 10:00:00 +0000",
+				"Subject: Other\r\nDate: Wed, 01 Jan 2025 11:00:00 +0000",
+				"Subject: Changed subject\r\nDate: Wed, 01 Jan 2025 12:00:00 +0000",
+			} {
+				literal := "From: sender@pm.me\r\nTo: " + info.Addresses[0] + "\r\n" + header + "\r\n\r\nhello"
+				require.NoError(t, client.Append("INBOX", nil, time.Now(), imap.Literal(bytes.NewReader([]byte(literal)))))
+			}
+
+			_, err = client.Select("INBOX", false)
+			require.NoError(t, err)
+
+			// The messages of a conversation are a thread whatever their subject.
+			threads, err := clientThread(client, "REFERENCES")
+			require.NoError(t, err)
+			require.Equal(t, "[[[1] [3]] [2]]", threads)
+
+			_, err = clientThread(client, "ORDEREDSUBJECT")
+			require.Error(t, err)
+
+			messages := make(chan *imap.Message, 3)
+			require.NoError(t, client.Fetch(&imap.SeqSet{Set: []imap.Seq{{Start: 1, Stop: 3}}}, []imap.FetchItem{"X-PM-CONVERSATION-ID"}, messages))
+
+			var conversationIDs []interface{}
+
+			for message := range messages {
+				conversationIDs = append(conversationIDs, message.Items["X-PM-CONVERSATION-ID"])
+			}
+
+			require.Equal(t, []interface{}{"c1", "c2", "c1"}, conversationIDs)
+		})
+	})
+}
+
+// newConversationProxy returns the URL of a proxy to the API which adds the conversation ID of the messages returned
+// alone, given by subject, and a function closing it.
+func newConversationProxy(t *testing.T, hostURL string, conversationIDs map[string]string) (string, func()) {
+	target, err := url.Parse(hostURL)
+	require.NoError(t, err)
+
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	transport := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}} //nolint:gosec
+	proxy.Transport = transport
+
+	proxy.ModifyResponse = func(res *http.Response) error {
+		if res.Request.Method != http.MethodGet || !regexp.MustCompile(`^/mail/v4/messages/[^/]+$`).MatchString(res.Request.URL.Path) {
+			return nil
+		}
+
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return err
+		}
+
+		var data map[string]interface{}
+
+		if err := json.Unmarshal(body, &data); err == nil {
+			if message, ok := data["Message"].(map[string]interface{}); ok {
+				if subject, ok := message["Subject"].(string); ok {
+					message["ConversationID"] = conversationIDs[subject]
+				}
+
+				if body, err = json.Marshal(data); err != nil {
+					return err
+				}
+			}
+		}
+
+		res.Body = io.NopCloser(bytes.NewReader(body))
+		res.ContentLength = int64(len(body))
+		res.Header.Set("Content-Length", strconv.Itoa(len(body)))
+
+		return nil
+	}
+
+	server := httptest.NewTLSServer(proxy)
+
+	return server.URL, func() {
+		server.Close()
+		transport.CloseIdleConnections()
+	}
+}
+
+// clientThread returns the threads of the messages of the selected mailbox, as formatted by fmt.
+func clientThread(c *client.Client, algorithm string) (string, error) {
+	var threads string
+
+	status, err := c.Execute(&imap.Command{
+		Name:      "THREAD",
+		Arguments: []interface{}{imap.RawString(algorithm), imap.RawString("UTF-8"), imap.RawString("ALL")},
+	}, responses.HandlerFunc(func(resp imap.Resp) error {
+		name, fields, ok := imap.ParseNamedResp(resp)
+		if !ok || name != "THREAD" {
+			return responses.ErrUnhandled
+		}
+
+		threads = fmt.Sprint(fields)
+
+		return nil
+	}))
+	if err != nil {
+		return "", err
+	}
+
+	return threads, status.Err()
+}
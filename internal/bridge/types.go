@@ -20,6 +20,7 @@ package bridge
 import (
 	"context"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
 )
 
@@ -34,6 +35,8 @@ type Locator interface {
 	ProvideIMAPSyncConfigPath() (string, error)
 	ProvideUnleashCachePath() (string, error)
 	ProvideNotificationsCachePath() (string, error)
+	ProvideSocketsPath() (string, error)
+	ProvideImportsPath() (string, error)
 }
 
 type ProxyController interface {
@@ -57,4 +60,11 @@ type Updater interface {
 	RemoveOldUpdates() error
 	GetVersionInfo(context.Context, updater.Downloader) (updater.VersionInfo, error)
 	InstallUpdate(context.Context, updater.Downloader, updater.Release) error
+	InstallUpdateFromFile([]byte, []byte, func(*semver.Version) error) (*semver.Version, error)
+	GetPinnedVersion() (*semver.Version, error)
+	SetPinnedVersion(*semver.Version) error
+	GetRollbackCrashes() (int, error)
+	SetRollbackCrashes(int) error
+	IsVersionAllowed(*semver.Version) bool
+	Rollback(*semver.Version) error
 }
@@ -20,6 +20,8 @@ package bridge
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/gluon/reporter"
@@ -108,6 +110,11 @@ func (bridge *Bridge) handleUpdate(version updater.VersionInfo) {
 			continue
 		}
 
+		if !bridge.updater.IsVersionAllowed(release.Version) {
+			log.Debug("Update version is bad or newer than the pinned version")
+			continue
+		}
+
 		if release.RolloutProportion < updateRollout {
 			log.Debug("Update has not been rolled out yet")
 			continue
@@ -199,6 +206,11 @@ func (bridge *Bridge) handleUpdateLegacy(version updater.VersionInfoLegacy) {
 
 		bridge.publish(events.UpdateNotAvailable{})
 
+	case !bridge.updater.IsVersionAllowed(version.Version):
+		log.Info("An update is available but is bad or newer than the pinned version")
+
+		bridge.publish(events.UpdateNotAvailable{})
+
 	case version.RolloutProportion < bridge.vault.GetUpdateRollout():
 		log.Info("An update is available but has not been rolled out yet")
 
@@ -254,7 +266,7 @@ func (bridge *Bridge) installUpdateLegacy(ctx context.Context, job installJobLeg
 			Silent:        job.silent,
 		})
 
-		err := bridge.updater.InstallUpdateLegacy(ctx, bridge.api, job.version)
+		err := bridge.updater.InstallUpdateLegacy(ctx, bridge.getUpdateDownloader(), job.version)
 
 		switch {
 		case errors.Is(err, updater.ErrDownloadVerify):
@@ -318,7 +330,7 @@ func (bridge *Bridge) installUpdate(ctx context.Context, job installJob) {
 			Silent:     job.Silent,
 		})
 
-		err := bridge.updater.InstallUpdate(ctx, bridge.api, job.Release)
+		err := bridge.updater.InstallUpdate(ctx, bridge.getUpdateDownloader(), job.Release)
 		switch {
 		case errors.Is(err, updater.ErrReleaseUpdatePackageMissing):
 			log.WithError(err).Error("The update could not be installed but we will fail silently")
@@ -364,6 +376,107 @@ func (bridge *Bridge) installUpdate(ctx context.Context, job installJob) {
 	}, bridge.newVersionLock)
 }
 
+// InstallUpdateFromFile installs the update package at the given path, for machines which cannot reach the update host.
+// It must be signed like the downloaded packages, the detached signature being at sigPath. Its version is read from the
+// package; if expected is not nil, the package must have this version.
+func (bridge *Bridge) InstallUpdateFromFile(pkgPath, sigPath string, expected *semver.Version) error {
+	pkg, err := os.ReadFile(pkgPath) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("could not read update package: %w", err)
+	}
+
+	sig, err := os.ReadFile(sigPath) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("could not read update package signature: %w", err)
+	}
+
+	return safe.LockRet(func() error {
+		log := logrus.WithFields(logrus.Fields{
+			"current": bridge.curVersion,
+			"package": pkgPath,
+		})
+
+		log.Info("Installing update from file")
+
+		version, err := bridge.updater.InstallUpdateFromFile(pkg, sig, func(version *semver.Version) error {
+			if expected != nil && !version.Equal(expected) {
+				return fmt.Errorf("%w: the package has version %v", ErrUpdateVersionMismatch, version)
+			}
+
+			if !version.GreaterThan(bridge.curVersion) {
+				return ErrUpdateNotNewer
+			}
+
+			if !bridge.updater.IsVersionAllowed(version) {
+				return fmt.Errorf("%w: %v", ErrUpdateNotAllowed, version)
+			}
+
+			return nil
+		})
+		if err != nil && !errors.Is(err, updater.ErrUpdateAlreadyInstalled) {
+			log.WithError(err).Error("The update could not be installed")
+			return err
+		}
+
+		log.WithField("version", version).Info("The update was installed successfully")
+
+		release := updater.Release{Version: version}
+
+		bridge.publish(events.UpdateInstalled{
+			Release: release,
+			Silent:  false,
+		})
+
+		if version.GreaterThan(bridge.newVersion) {
+			bridge.newVersion = version
+		}
+
+		return nil
+	}, bridge.newVersionLock)
+}
+
+// GetPinnedVersion returns the version bridge is pinned to, or nil if it isn't.
+func (bridge *Bridge) GetPinnedVersion() (*semver.Version, error) {
+	return bridge.updater.GetPinnedVersion()
+}
+
+// SetPinnedVersion pins bridge to the given version, or removes the pin if nil. Newer versions are neither
+// installed nor started by the launcher, which starts the pinned version if it is installed.
+func (bridge *Bridge) SetPinnedVersion(version *semver.Version) error {
+	if err := bridge.updater.SetPinnedVersion(version); err != nil {
+		return err
+	}
+
+	bridge.goUpdate()
+
+	return nil
+}
+
+// GetRollbackCrashes returns the number of consecutive startup crashes after which bridge rolls back to the previous
+// version, 0 meaning never.
+func (bridge *Bridge) GetRollbackCrashes() (int, error) {
+	return bridge.updater.GetRollbackCrashes()
+}
+
+// SetRollbackCrashes sets the number of consecutive startup crashes after which bridge rolls back to the previous
+// version, 0 meaning never.
+func (bridge *Bridge) SetRollbackCrashes(crashes int) error {
+	return bridge.updater.SetRollbackCrashes(crashes)
+}
+
+// Rollback marks the current version as bad, so that the previous version is started once bridge is restarted
+// by the launcher. The current version is not installed again.
+func (bridge *Bridge) Rollback() error {
+	logrus.WithField("version", bridge.curVersion).Warn("Rolling back to the previous version")
+
+	return bridge.updater.Rollback(bridge.curVersion)
+}
+
+// getUpdateDownloader returns the downloader of the version file and update packages, from the mirror if one is set.
+func (bridge *Bridge) getUpdateDownloader() updater.Downloader {
+	return updater.NewMirrorDownloader(bridge.api, bridge.vault.GetUpdateMirrorURL())
+}
+
 func (bridge *Bridge) RemoveOldUpdates() {
 	if err := bridge.updater.RemoveOldUpdates(); err != nil {
 		logrus.WithError(err).Error("Remove old updates fails")
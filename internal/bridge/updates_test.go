@@ -19,6 +19,9 @@ package bridge_test
 
 import (
 	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
 	"time"
@@ -698,3 +701,122 @@ func Test_Update_UpdateFromMinVer_NoCompatibleVersionForceManual_BetaMismatch(t
 		})
 	})
 }
+
+func Test_Update_InstallFromFile(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridgePkg.Locator, vaultKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(bridge *bridgePkg.Bridge, _ *bridgePkg.Mocks) {
+			updateCh, done := bridge.GetEvents(events.UpdateInstalled{})
+			defer done()
+
+			bridge.SetCurrentVersionTest(semver.MustParse("2.1.1"))
+
+			// The test packages hold their version.
+			pkgPath := filepath.Join(t.TempDir(), "bridge_3.0.0_linux.tgz")
+			require.NoError(t, os.WriteFile(pkgPath, []byte("2.1.1"), 0o600))
+
+			// The signature must be supplied along with the package.
+			require.ErrorIs(t, bridge.InstallUpdateFromFile(pkgPath, pkgPath+".sig", nil), fs.ErrNotExist)
+			require.NoError(t, os.WriteFile(pkgPath+".sig", []byte("signature"), 0o600))
+
+			// Only newer versions can be installed, whatever the name of the package.
+			require.ErrorIs(t, bridge.InstallUpdateFromFile(pkgPath, pkgPath+".sig", nil), bridgePkg.ErrUpdateNotNewer)
+
+			require.NoError(t, os.WriteFile(pkgPath, []byte("2.1.2"), 0o600))
+
+			// The package must have the expected version, if any.
+			require.ErrorIs(t, bridge.InstallUpdateFromFile(pkgPath, pkgPath+".sig", semver.MustParse("3.0.0")), bridgePkg.ErrUpdateVersionMismatch)
+
+			// Versions newer than the pinned one are not installed.
+			require.NoError(t, bridge.SetPinnedVersion(semver.MustParse("2.1.1")))
+			require.ErrorIs(t, bridge.InstallUpdateFromFile(pkgPath, pkgPath+".sig", nil), bridgePkg.ErrUpdateNotAllowed)
+			require.NoError(t, bridge.SetPinnedVersion(nil))
+
+			require.NoError(t, bridge.InstallUpdateFromFile(pkgPath, pkgPath+".sig", nil))
+			require.Equal(t, semver.MustParse("2.1.2"), (<-updateCh).(events.UpdateInstalled).Release.Version)
+		})
+	})
+}
+
+func Test_Update_Pinned(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridgePkg.Locator, vaultKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(bridge *bridgePkg.Bridge, mocks *bridgePkg.Mocks) {
+			updateCh, done := bridge.GetEvents(events.UpdateNotAvailable{})
+			defer done()
+
+			require.NoError(t, bridge.SetUpdateChannel(updater.StableChannel))
+
+			bridge.SetCurrentVersionTest(semver.MustParse("2.1.1"))
+
+			// Only valid versions can be pinned.
+			_, err := bridge.SetSetting(ctx, "update-pin", "latest")
+			require.ErrorIs(t, err, bridgePkg.ErrInvalidSettingValue)
+
+			changed, err := bridge.SetSetting(ctx, "update-pin", "2.1.1")
+			require.NoError(t, err)
+			require.True(t, changed)
+
+			pin, err := bridge.GetSetting("update-pin")
+			require.NoError(t, err)
+			require.Equal(t, "2.1.1", pin)
+
+			mocks.Updater.SetLatestVersion(updater.VersionInfo{Releases: []updater.Release{
+				{
+					ReleaseCategory:   updater.StableReleaseCategory,
+					Version:           semver.MustParse("2.1.2"),
+					SystemVersion:     versioncompare.SystemVersion{},
+					RolloutProportion: 1.0,
+					MinAuto:           &semver.Version{},
+					File: []updater.File{
+						{
+							URL:        "RANDOM_INSTALLER_URL",
+							Identifier: updater.InstallerIdentifier,
+						},
+						{
+							URL:        "RANDOM_PACKAGE_URL",
+							Identifier: updater.PackageIdentifier,
+						},
+					},
+				},
+			}})
+
+			// The release is newer than the pinned version, so it is not offered.
+			bridge.CheckForUpdates()
+			require.Equal(t, events.UpdateNotAvailable{}, <-updateCh)
+
+			// An empty value removes the pin.
+			_, err = bridge.SetSetting(ctx, "update-pin", "")
+			require.NoError(t, err)
+
+			pinned, err := bridge.GetPinnedVersion()
+			require.NoError(t, err)
+			require.Nil(t, pinned)
+		})
+	})
+}
+
+func Test_Update_Rollback(t *testing.T) {
+	withEnv(t, func(ctx context.Context, s *server.Server, netCtl *proton.NetCtl, locator bridgePkg.Locator, vaultKey []byte) {
+		withBridge(ctx, t, s.GetHostURL(), netCtl, locator, vaultKey, func(bridge *bridgePkg.Bridge, mocks *bridgePkg.Mocks) {
+			bridge.SetCurrentVersionTest(semver.MustParse("2.1.2"))
+
+			require.True(t, mocks.Updater.IsVersionAllowed(semver.MustParse("2.1.2")))
+			require.NoError(t, bridge.Rollback())
+
+			// The rolled back version is never offered or started again.
+			require.False(t, mocks.Updater.IsVersionAllowed(semver.MustParse("2.1.2")))
+			require.True(t, mocks.Updater.IsVersionAllowed(semver.MustParse("2.1.3")))
+
+			// The number of startup crashes after which bridge rolls back by itself is a setting.
+			crashes, err := bridge.GetSetting("update-rollback-crashes")
+			require.NoError(t, err)
+			require.Equal(t, "3", crashes)
+
+			_, err = bridge.SetSetting(ctx, "update-rollback-crashes", "-1")
+			require.ErrorIs(t, err, bridgePkg.ErrInvalidSettingValue)
+
+			changed, err := bridge.SetSetting(ctx, "update-rollback-crashes", "0")
+			require.NoError(t, err)
+			require.True(t, changed)
+		})
+	})
+}
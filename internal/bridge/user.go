@@ -68,6 +68,12 @@ type UserInfo struct {
 	// AddressMode is the user's address mode.
 	AddressMode vault.AddressMode
 
+	// ReadOnly is true if the mail clients can only read the user's mailbox.
+	ReadOnly bool
+
+	// StrictSplitMode is true if, in split mode, an SMTP session can only send from the address it authenticated with.
+	StrictSplitMode bool
+
 	// BridgePass is the user's bridge password.
 	BridgePass []byte
 
@@ -102,7 +108,7 @@ func (bridge *Bridge) GetUserInfo(userID string) (UserInfo, error) {
 			if len(user.AuthUID()) == 0 {
 				state = SignedOut
 			}
-			info = getUserInfo(user.UserID(), user.Username(), user.PrimaryEmail(), state, user.AddressMode())
+			info = getUserInfo(user.UserID(), user.Username(), user.PrimaryEmail(), state, user.AddressMode(), user.ReadOnly(), user.StrictSplitMode())
 		}); err != nil {
 			return UserInfo{}, fmt.Errorf("failed to get user info: %w", err)
 		}
@@ -336,6 +342,64 @@ func (bridge *Bridge) SetAddressMode(ctx context.Context, userID string, mode va
 	}, bridge.usersLock)
 }
 
+// SetUserReadOnly sets whether the mail clients can only read the mailbox of the given user, whatever the password
+// they authenticate with. The changes of read-only clients are refused and they can't send over SMTP.
+// Clients which authenticated before the user was made read-only can't change the mailbox anymore,
+// but keep the mailboxes they selected read-write until they log in again.
+func (bridge *Bridge) SetUserReadOnly(userID string, readOnly bool) error {
+	logUser.WithField("userID", userID).WithField("readOnly", readOnly).Info("Setting read-only mode")
+
+	if err := safe.RLockRet(func() error {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		if err := user.SetReadOnly(readOnly); err != nil {
+			return fmt.Errorf("failed to set read-only mode: %w", err)
+		}
+
+		return nil
+	}, bridge.usersLock); err != nil {
+		return err
+	}
+
+	bridge.publish(events.UserReadOnlyChanged{
+		UserID:   userID,
+		ReadOnly: readOnly,
+	})
+
+	return nil
+}
+
+// SetUserStrictSplitMode sets whether, when the given user is in split mode, an SMTP session can only send from the
+// address it authenticated with (or one of its aliases). Messages sent from another address are then refused.
+func (bridge *Bridge) SetUserStrictSplitMode(userID string, strict bool) error {
+	logUser.WithField("userID", userID).WithField("strict", strict).Info("Setting strict split mode")
+
+	if err := safe.RLockRet(func() error {
+		user, ok := bridge.users[userID]
+		if !ok {
+			return ErrNoSuchUser
+		}
+
+		if err := user.SetStrictSplitMode(strict); err != nil {
+			return fmt.Errorf("failed to set strict split mode: %w", err)
+		}
+
+		return nil
+	}, bridge.usersLock); err != nil {
+		return err
+	}
+
+	bridge.publish(events.UserStrictSplitModeChanged{
+		UserID:          userID,
+		StrictSplitMode: strict,
+	})
+
+	return nil
+}
+
 // SendBadEventUserFeedback passes the feedback to the given user.
 func (bridge *Bridge) SendBadEventUserFeedback(_ context.Context, userID string, doResync bool) error {
 	logUser.WithField("userID", userID).WithField("doResync", doResync).Info("Passing bad event feedback to user")
@@ -375,6 +439,11 @@ func (bridge *Bridge) loginUser(ctx context.Context, client *proton.Client, auth
 		return "", fmt.Errorf("failed to get API user: %w", err)
 	}
 
+	if !bridge.policy.IsAccountAllowed(apiUser.Name, apiUser.Email) {
+		logUser.WithField("userID", apiUser.ID).Warn("The account is not allowed to log in by policy")
+		return "", ErrAccountNotAllowed
+	}
+
 	salts, err := client.GetSalts(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to get key salts: %w", err)
@@ -416,6 +485,18 @@ func (bridge *Bridge) loadUsers(ctx context.Context) error {
 			return nil
 		}
 
+		// The policy may have changed since the user logged in.
+		if !bridge.policy.IsAccountAllowed(user.Username(), user.PrimaryEmail()) {
+			log.Warn("The account is not allowed by policy (skipping)")
+
+			bridge.publish(events.UserLoadFail{
+				UserID: user.UserID(),
+				Error:  ErrAccountNotAllowed,
+			})
+
+			return nil
+		}
+
 		log.WithField("mode", user.AddressMode()).Info("Loading connected user")
 
 		bridge.publish(events.UserLoading{
@@ -541,6 +622,7 @@ func (bridge *Bridge) addUserWithVault(
 		apiUser,
 		bridge.panicHandler,
 		bridge.vault.GetShowAllMail(),
+		getSystemViewLabelIDs(bridge.GetSystemViews()),
 		bridge.vault.GetMaxSyncMemory(),
 		bridge,
 		bridge.serverManager,
@@ -552,6 +634,7 @@ func (bridge *Bridge) addUserWithVault(
 		isNew,
 		bridge.notificationStore,
 		bridge.unleashService,
+		&bridgeOAuthVerifier{b: bridge},
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create user: %w", err)
@@ -622,38 +705,47 @@ func (bridge *Bridge) logoutUser(ctx context.Context, user *user.User, withAPI,
 		logUser.WithError(err).Error("Failed to logout user")
 	}
 
+	// The sessions authenticated with the tokens are bound to their authorization like to an app password.
+	for _, grantID := range bridge.oauthServer.RevokeUserTokens(user.ID()) {
+		bridge.serverManager.CloseAppPasswordSessions(grantID)
+	}
+
 	bridge.heartbeat.SetNumberConnectedAccounts(len(bridge.users) - 1)
 
 	user.Close()
 }
 
 // getUserInfo returns information about a disconnected user.
-func getUserInfo(userID, username, primaryEmail string, state UserState, addressMode vault.AddressMode) UserInfo {
+func getUserInfo(userID, username, primaryEmail string, state UserState, addressMode vault.AddressMode, readOnly, strictSplitMode bool) UserInfo {
 	var addresses []string
 	if len(primaryEmail) > 0 {
 		addresses = []string{primaryEmail}
 	}
 
 	return UserInfo{
-		State:       state,
-		UserID:      userID,
-		Username:    username,
-		Addresses:   addresses,
-		AddressMode: addressMode,
+		State:           state,
+		UserID:          userID,
+		Username:        username,
+		Addresses:       addresses,
+		AddressMode:     addressMode,
+		ReadOnly:        readOnly,
+		StrictSplitMode: strictSplitMode,
 	}
 }
 
 // getConnUserInfo returns information about a connected user.
 func getConnUserInfo(user *user.User) UserInfo {
 	return UserInfo{
-		State:       Connected,
-		UserID:      user.ID(),
-		Username:    user.Name(),
-		Addresses:   user.Emails(),
-		AddressMode: user.GetAddressMode(),
-		BridgePass:  user.BridgePass(),
-		UsedSpace:   user.UsedSpace(),
-		MaxSpace:    user.MaxSpace(),
+		State:           Connected,
+		UserID:          user.ID(),
+		Username:        user.Name(),
+		Addresses:       user.Emails(),
+		AddressMode:     user.GetAddressMode(),
+		ReadOnly:        user.ReadOnly(),
+		StrictSplitMode: user.StrictSplitMode(),
+		BridgePass:      user.BridgePass(),
+		UsedSpace:       user.UsedSpace(),
+		MaxSpace:        user.MaxSpace(),
 	}
 }
 
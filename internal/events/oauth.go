@@ -0,0 +1,38 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package events
+
+import "fmt"
+
+// OAuthAuthorizationRequested is emitted when a mail client requests access to a user's account through the
+// local OAuth server. The request must be allowed or denied by the user.
+type OAuthAuthorizationRequested struct {
+	eventBase
+
+	RequestID string
+	ClientID  string
+	UserID    string
+	Email     string
+
+	// Scope restricts the access of the client like the scope of an app password.
+	Scope string
+}
+
+func (event OAuthAuthorizationRequested) String() string {
+	return fmt.Sprintf("OAuthAuthorizationRequested: RequestID: %s, ClientID: %s, UserID: %s, Scope: %s", event.RequestID, event.ClientID, event.UserID, event.Scope)
+}
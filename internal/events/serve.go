@@ -90,3 +90,17 @@ type SMTPServerError struct {
 func (event SMTPServerError) String() string {
 	return fmt.Sprintf("SMTPServerError: %v", event.Error)
 }
+
+// ConnectionRejected is published when an IMAP or SMTP connection from another host is refused, either because the
+// host is not in the allowed networks or because it is locked out after too many failed authentications.
+type ConnectionRejected struct {
+	eventBase
+
+	Protocol   string
+	RemoteAddr string
+	Reason     string
+}
+
+func (event ConnectionRejected) String() string {
+	return fmt.Sprintf("ConnectionRejected: Protocol: %s, RemoteAddr: %s, Reason: %s", event.Protocol, event.RemoteAddr, event.Reason)
+}
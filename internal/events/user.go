@@ -169,6 +169,69 @@ func (event AddressModeChanged) String() string {
 	return fmt.Sprintf("AddressModeChanged: UserID: %s, AddressMode: %s", event.UserID, event.AddressMode)
 }
 
+// UserReadOnlyChanged is emitted when a user is made read-only or read-write.
+type UserReadOnlyChanged struct {
+	eventBase
+
+	UserID string
+
+	ReadOnly bool
+}
+
+func (event UserReadOnlyChanged) String() string {
+	return fmt.Sprintf("UserReadOnlyChanged: UserID: %s, ReadOnly: %v", event.UserID, event.ReadOnly)
+}
+
+// UserStrictSplitModeChanged is emitted when the strict split mode of a user is enabled or disabled.
+type UserStrictSplitModeChanged struct {
+	eventBase
+
+	UserID string
+
+	StrictSplitMode bool
+}
+
+func (event UserStrictSplitModeChanged) String() string {
+	return fmt.Sprintf("UserStrictSplitModeChanged: UserID: %s, StrictSplitMode: %v", event.UserID, event.StrictSplitMode)
+}
+
+// AddressGroupsChanged is emitted when the addresses sharing a mailbox in split mode change.
+type AddressGroupsChanged struct {
+	eventBase
+
+	UserID string
+}
+
+func (event AddressGroupsChanged) String() string {
+	return fmt.Sprintf("AddressGroupsChanged: UserID: %s", event.UserID)
+}
+
+// AppPasswordCreated is emitted when an app password is created for a user.
+type AppPasswordCreated struct {
+	eventBase
+
+	UserID string
+
+	AppPasswordID string
+}
+
+func (event AppPasswordCreated) String() string {
+	return fmt.Sprintf("AppPasswordCreated: UserID: %s, AppPasswordID: %s", event.UserID, event.AppPasswordID)
+}
+
+// AppPasswordRevoked is emitted when an app password of a user is revoked. Its sessions are closed.
+type AppPasswordRevoked struct {
+	eventBase
+
+	UserID string
+
+	AppPasswordID string
+}
+
+func (event AppPasswordRevoked) String() string {
+	return fmt.Sprintf("AppPasswordRevoked: UserID: %s, AppPasswordID: %s", event.UserID, event.AppPasswordID)
+}
+
 // UsedSpaceChanged is emitted when the storage space used by the user has changed.
 type UsedSpaceChanged struct {
 	eventBase
@@ -225,3 +288,23 @@ type UserNotification struct {
 func (event UserNotification) String() string {
 	return fmt.Sprintf("UserNotification: UserID: %s, Title: %s, Subtitle: %s, Body: %s", event.UserID, event.Title, event.Subtitle, event.Body)
 }
+
+// ClientStateRepaired is emitted when a client state check and repair has finished.
+type ClientStateRepaired struct {
+	eventBase
+
+	DryRun      bool
+	Divergences int
+	Repaired    int
+	Failed      int
+}
+
+func (event ClientStateRepaired) String() string {
+	return fmt.Sprintf(
+		"ClientStateRepaired: DryRun: %t, Divergences: %d, Repaired: %d, Failed: %d",
+		event.DryRun,
+		event.Divergences,
+		event.Repaired,
+		event.Failed,
+	)
+}
@@ -25,7 +25,6 @@ import (
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
 	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
-	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 	"github.com/ProtonMail/proton-bridge/v3/internal/hv"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/abiosoft/ishell"
@@ -101,7 +100,7 @@ func (f *frontendCLI) showAccountAddressInfo(user bridge.UserInfo, address strin
 
 	f.Println(bold("Configuration for " + address))
 	f.Printf("IMAP Settings\nAddress:   %s\nIMAP port: %d\nUsername:  %s\nPassword:  %s\nSecurity:  %s\n",
-		constants.Host,
+		f.bridge.GetHost(),
 		f.bridge.GetIMAPPort(),
 		address,
 		user.BridgePass,
@@ -109,12 +108,21 @@ func (f *frontendCLI) showAccountAddressInfo(user bridge.UserInfo, address strin
 	)
 	f.Println("")
 	f.Printf("SMTP Settings\nAddress:   %s\nSMTP port: %d\nUsername:  %s\nPassword:  %s\nSecurity:  %s\n",
-		constants.Host,
+		f.bridge.GetHost(),
 		f.bridge.GetSMTPPort(),
 		address,
 		user.BridgePass,
 		smtpSecurity,
 	)
+
+	if user.ReadOnly {
+		f.Println("The account is read-only: the mailbox can't be changed over IMAP and SMTP is disabled.")
+	}
+
+	if user.StrictSplitMode && user.AddressMode == vault.SplitMode {
+		f.Println("The account is in strict split mode: this address can only send as itself over SMTP.")
+	}
+
 	f.Println("")
 }
 
@@ -360,6 +368,52 @@ func (f *frontendCLI) changeMode(c *ishell.Context) {
 	f.Printf("Address mode for account %s changed to %s\n", user.Username, targetMode)
 }
 
+func (f *frontendCLI) toggleReadOnly(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	target := "read-only"
+	if user.ReadOnly {
+		target = "read-write"
+	}
+
+	if !f.yesNoQuestion("Are you sure you want to make account " + bold(user.Username) + " " + bold(target)) {
+		return
+	}
+
+	if err := f.bridge.SetUserReadOnly(user.UserID, !user.ReadOnly); err != nil {
+		f.printAndLogError("Cannot change read-only mode:", err)
+		return
+	}
+
+	f.Printf("Account %s is now %s\n", user.Username, target)
+}
+
+func (f *frontendCLI) toggleStrictSplitMode(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	target := "enabled"
+	if user.StrictSplitMode {
+		target = "disabled"
+	}
+
+	if !f.yesNoQuestion("Are you sure you want the strict split mode of account " + bold(user.Username) + " to be " + bold(target)) {
+		return
+	}
+
+	if err := f.bridge.SetUserStrictSplitMode(user.UserID, !user.StrictSplitMode); err != nil {
+		f.printAndLogError("Cannot change strict split mode:", err)
+		return
+	}
+
+	f.Printf("Strict split mode of account %s is now %s\n", user.Username, target)
+}
+
 func (f *frontendCLI) configureAppleMail(c *ishell.Context) {
 	user := f.askUserByIndexOrName(c)
 	if user.UserID == "" {
@@ -0,0 +1,119 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/abiosoft/ishell"
+)
+
+func (f *frontendCLI) listAddressGroups(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	groups, err := f.bridge.GetAddressGroups(user.UserID)
+	if err != nil {
+		f.printAndLogError("Cannot list address groups: ", err)
+		return
+	}
+
+	if len(groups) == 0 {
+		f.Printf("Account %s has no address groups.\n", bold(user.Username))
+		return
+	}
+
+	if user.AddressMode != vault.SplitMode {
+		f.Println("The address groups only apply in split mode.")
+	}
+
+	for _, group := range groups {
+		f.Printf("%s: %s\n", bold(group.Name), strings.Join(group.Addresses, ", "))
+	}
+
+	f.Println()
+}
+
+func (f *frontendCLI) setAddressGroup(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	name := f.readStringInAttempts("Name of the group", c.ReadLine, isNotEmpty)
+	if name == "" {
+		return
+	}
+
+	addresses := f.readStringInAttempts("Addresses sharing the mailbox (comma separated)", c.ReadLine, isNotEmpty)
+	if addresses == "" {
+		return
+	}
+
+	if user.AddressMode == vault.SplitMode &&
+		!f.yesNoQuestion("The mailboxes of the addresses joining or leaving the group will be resynced. Are you sure you want to continue") {
+		return
+	}
+
+	if err := f.bridge.SetAddressGroup(context.Background(), user.UserID, name, strings.Split(addresses, ",")); err != nil {
+		f.printAndLogError("Cannot set address group: ", err)
+		return
+	}
+
+	f.Printf("Address group %s set.\n", bold(name))
+}
+
+func (f *frontendCLI) removeAddressGroup(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	var name string
+
+	if len(c.Args) > 1 {
+		name = c.Args[1]
+	} else {
+		name = f.readStringInAttempts("Name of the group", c.ReadLine, isNotEmpty)
+	}
+
+	if name == "" {
+		return
+	}
+
+	if !f.yesNoQuestion("Are you sure you want to remove address group " + bold(name)) {
+		return
+	}
+
+	if err := f.bridge.RemoveAddressGroup(context.Background(), user.UserID, name); err != nil {
+		f.printAndLogError("Cannot remove address group: ", err)
+		return
+	}
+
+	f.Printf("Address group %s removed, its addresses have their own mailbox again.\n", bold(name))
+}
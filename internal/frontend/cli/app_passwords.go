@@ -0,0 +1,148 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/abiosoft/ishell"
+	"github.com/bradenaw/juniper/xslices"
+)
+
+func isAppPasswordScope(val string) bool {
+	return vault.AppPasswordScope(val).IsValid()
+}
+
+func (f *frontendCLI) listAppPasswords(c *ishell.Context) {
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	appPasswords, err := f.bridge.GetAppPasswords(user.UserID)
+	if err != nil {
+		f.printAndLogError("Cannot list app passwords: ", err)
+		return
+	}
+
+	if len(appPasswords) == 0 {
+		f.Printf("Account %s has no app passwords.\n", bold(user.Username))
+		return
+	}
+
+	spacing := "%-2d: %-20s %-15s %-20s %-20s\n"
+	f.Printf(bold(strings.ReplaceAll(spacing, "d", "s")), "#", "name", "scope", "created", "last used")
+
+	for idx, appPassword := range appPasswords {
+		lastUsed := "never"
+		if !appPassword.LastUsedAt.IsZero() {
+			lastUsed = appPassword.LastUsedAt.Format("2006-01-02 15:04")
+		}
+
+		f.Printf(spacing, idx, appPassword.Name, appPassword.Scope, appPassword.CreatedAt.Format("2006-01-02 15:04"), lastUsed)
+	}
+
+	f.Println()
+}
+
+func (f *frontendCLI) createAppPassword(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	name := f.readStringInAttempts("Name of the email client", c.ReadLine, isNotEmpty)
+	if name == "" {
+		return
+	}
+
+	scopes := xslices.Map(bridge.GetAppPasswordScopes(), func(scope vault.AppPasswordScope) string { return string(scope) })
+
+	scope := f.readStringInAttempts("Scope ("+strings.Join(scopes, ", ")+")", c.ReadLine, isAppPasswordScope)
+	if scope == "" {
+		return
+	}
+
+	_, password, err := f.bridge.CreateAppPassword(user.UserID, name, vault.AppPasswordScope(scope))
+	if err != nil {
+		f.printAndLogError("Cannot create app password: ", err)
+		return
+	}
+
+	f.Printf("App password for %s: %s\n", bold(name), password)
+	f.Println("Use it in place of the bridge password. It will not be shown again.")
+}
+
+func (f *frontendCLI) revokeAppPassword(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	user := f.askUserByIndexOrName(c)
+	if user.UserID == "" {
+		return
+	}
+
+	appPasswords, err := f.bridge.GetAppPasswords(user.UserID)
+	if err != nil {
+		f.printAndLogError("Cannot list app passwords: ", err)
+		return
+	}
+
+	if len(appPasswords) == 0 {
+		f.Printf("Account %s has no app passwords.\n", bold(user.Username))
+		return
+	}
+
+	var arg string
+
+	if len(c.Args) > 1 {
+		arg = c.Args[1]
+	} else {
+		arg = f.readStringInAttempts("Index or name of the app password", c.ReadLine, isNotEmpty)
+	}
+
+	idx := xslices.IndexFunc(appPasswords, func(appPassword bridge.AppPasswordInfo) bool {
+		return appPassword.Name == arg
+	})
+
+	if index, err := strconv.Atoi(arg); idx < 0 && err == nil && index >= 0 && index < len(appPasswords) {
+		idx = index
+	}
+
+	if idx < 0 {
+		f.Printf("Wrong input '%s'. Use the index or the name shown by the list command.\n", bold(arg))
+		return
+	}
+
+	if !f.yesNoQuestion("Are you sure you want to revoke app password " + bold(appPasswords[idx].Name)) {
+		return
+	}
+
+	if err := f.bridge.RevokeAppPassword(user.UserID, appPasswords[idx].ID); err != nil {
+		f.printAndLogError("Cannot revoke app password: ", err)
+		return
+	}
+
+	f.Printf("App password %s revoked, the email clients using it were disconnected.\n", bold(appPasswords[idx].Name))
+}
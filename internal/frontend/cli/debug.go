@@ -21,6 +21,7 @@ import (
 	"context"
 	"os"
 
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
 	"github.com/abiosoft/ishell"
 )
 
@@ -72,3 +73,71 @@ func (f *frontendCLI) debugMailboxState(c *ishell.Context) {
 
 	c.Printf("\nMessage download finished. Data is available at %v\n", bold(location))
 }
+
+func (f *frontendCLI) debugRepairMailboxState(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	dryRun := f.yesNoQuestion("Only report the differences without repairing them (dry run)")
+
+	c.Println("Starting state check. Note that depending on your message count this may take a while.")
+
+	report, err := f.bridge.RepairClientState(context.Background(), dryRun, func(s string) {
+		c.Println(s)
+	})
+	if err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	if len(report.Divergences) == 0 {
+		c.Println("No differences found, the mailbox state matches the server state.")
+		return
+	}
+
+	c.Printf("\nFound %v differences:\n", len(report.Divergences))
+
+	for _, d := range report.Divergences {
+		c.Printf("  %v: message %v (%v) expected=%v actual=%v\n", d.Kind, d.MessageID, d.Account, d.Expected, d.Actual)
+	}
+
+	if report.DryRun {
+		c.Println("\nDry run, nothing was changed.")
+		return
+	}
+
+	c.Printf("\nRepaired %v messages, %v failed.\n", len(report.Repaired), len(report.Failed))
+
+	for id, err := range report.Failed {
+		c.Printf("  %v: %v\n", id, err)
+	}
+}
+
+func (f *frontendCLI) exportDiagnostics(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	location := f.readStringInAttempts("Enter a directory in which to save the diagnostics bundle", c.ReadLine, isNotEmpty)
+	if location == "" {
+		return
+	}
+
+	req := bridge.DiagnosticsReq{
+		IncludeClientState: f.yesNoQuestion("Also check the mailbox state of your email clients (this may take a while)"),
+	}
+
+	if req.IncludeClientState {
+		f.Println(bold("Note that failed messages are stored unencrypted in the bundle."))
+		req.IncludeFailedMessages = f.yesNoQuestion("Include messages that failed to synchronize")
+	}
+
+	path, err := f.bridge.ExportDiagnostics(context.Background(), location, req, func(s string) {
+		c.Println(s)
+	})
+	if err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	c.Printf("\nDiagnostics bundle is available at %v\n", bold(path))
+}
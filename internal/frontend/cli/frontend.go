@@ -101,6 +101,18 @@ func New(
 		Func:      fe.changeMode,
 		Completer: fe.completeUsernames,
 	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name:      "read-only",
+		Help:      "toggle whether the mail clients can only read the mailbox of the account, whatever their password. Use index or account name as parameter.",
+		Func:      fe.toggleReadOnly,
+		Completer: fe.completeUsernames,
+	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name:      "strict-split",
+		Help:      "toggle whether, in split mode, each address of the account can only send as itself over SMTP. Use index or account name as parameter.",
+		Func:      fe.toggleStrictSplitMode,
+		Completer: fe.completeUsernames,
+	})
 	changeCmd.AddCmd(&ishell.Cmd{
 		Name: "change-location",
 		Help: "change the location of the encrypted message cache",
@@ -116,6 +128,16 @@ func New(
 		Help: "change port number of SMTP server.",
 		Func: fe.changeSMTPPort,
 	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "bind-address",
+		Help: "change the address the IMAP and SMTP servers listen on, to serve other hosts of the local network.",
+		Func: fe.changeBindAddress,
+	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "allowed-networks",
+		Help: "change the networks from which other hosts can connect to the IMAP and SMTP servers.",
+		Func: fe.changeAllowedNetworks,
+	})
 	changeCmd.AddCmd(&ishell.Cmd{
 		Name:    "imap-security",
 		Help:    "change IMAP SSL settings servers.(alias: ssl-imap, starttls-imap)",
@@ -128,6 +150,11 @@ func New(
 		Aliases: []string{"ssl-smtp", "starttls-smtp"},
 		Func:    fe.changeSMTPSecurity,
 	})
+	changeCmd.AddCmd(&ishell.Cmd{
+		Name: "log-level",
+		Help: "change the log level, globally or per subsystem (e.g. info,sync=debug).",
+		Func: fe.changeLogLevel,
+	})
 	fe.AddCmd(changeCmd)
 
 	// DoH commands.
@@ -211,6 +238,23 @@ func New(
 	})
 	fe.AddCmd(allMailCmd)
 
+	// Unix sockets commands.
+	socketsCmd := &ishell.Cmd{
+		Name: "unix-sockets",
+		Help: "serve IMAP and SMTP on Unix sockets only accessible by the current user, in addition to the ports",
+	}
+	socketsCmd.AddCmd(&ishell.Cmd{
+		Name: "enable",
+		Help: "IMAP and SMTP will also be served on Unix sockets",
+		Func: fe.enableUnixSockets,
+	})
+	socketsCmd.AddCmd(&ishell.Cmd{
+		Name: "disable",
+		Help: "IMAP and SMTP will only be served on the ports",
+		Func: fe.disableUnixSockets,
+	})
+	fe.AddCmd(socketsCmd)
+
 	// Updates commands.
 	updatesCmd := &ishell.Cmd{
 		Name: "updates",
@@ -221,6 +265,26 @@ func New(
 		Help: "check for Bridge updates",
 		Func: fe.checkUpdates,
 	})
+	updatesCmd.AddCmd(&ishell.Cmd{
+		Name: "install",
+		Help: "install an update package supplied locally, signed like the downloaded ones. Use the package path as parameter",
+		Func: fe.installUpdateFromFile,
+	})
+	updatesCmd.AddCmd(&ishell.Cmd{
+		Name: "rollback",
+		Help: "mark the current version as bad and restart with the previous one",
+		Func: fe.rollbackUpdate,
+	})
+	updatesCmd.AddCmd(&ishell.Cmd{
+		Name: "pin",
+		Help: "show the pinned version, or pin bridge to a version. Newer versions are neither installed nor started. Use the version as parameter",
+		Func: fe.pinVersion,
+	})
+	updatesCmd.AddCmd(&ishell.Cmd{
+		Name: "unpin",
+		Help: "remove the pinned version",
+		Func: fe.unpinVersion,
+	})
 	autoUpdatesCmd := &ishell.Cmd{
 		Name: "autoupdates",
 		Help: "manage bridge updates",
@@ -307,6 +371,93 @@ func New(
 		Aliases:   []string{"del", "rm", "remove"},
 		Completer: fe.completeUsernames,
 	})
+	appPasswordsCmd := &ishell.Cmd{
+		Name:    "app-passwords",
+		Help:    "manage the per-client passwords of an account, used in place of the bridge password. (alias: app)",
+		Aliases: []string{"app"},
+	}
+	appPasswordsCmd.AddCmd(&ishell.Cmd{
+		Name:      "list",
+		Help:      "print the app passwords of the account. Use index or account name as parameter. (aliases: l, ls)",
+		Func:      fe.noAccountWrapper(fe.listAppPasswords),
+		Aliases:   []string{"l", "ls"},
+		Completer: fe.completeUsernames,
+	})
+	appPasswordsCmd.AddCmd(&ishell.Cmd{
+		Name:      "create",
+		Help:      "create an app password for an email client. Use index or account name as parameter. (aliases: add, new)",
+		Func:      fe.noAccountWrapper(fe.createAppPassword),
+		Aliases:   []string{"add", "new"},
+		Completer: fe.completeUsernames,
+	})
+	appPasswordsCmd.AddCmd(&ishell.Cmd{
+		Name:      "revoke",
+		Help:      "revoke an app password and disconnect its email clients. Use index or account name, then app password index or name as parameters. (aliases: rm, remove)",
+		Func:      fe.noAccountWrapper(fe.revokeAppPassword),
+		Aliases:   []string{"rm", "remove"},
+		Completer: fe.completeUsernames,
+	})
+	fe.AddCmd(appPasswordsCmd)
+	addressGroupsCmd := &ishell.Cmd{
+		Name:    "address-groups",
+		Help:    "manage the groups of addresses sharing a mailbox in split mode. (alias: groups)",
+		Aliases: []string{"groups"},
+	}
+	addressGroupsCmd.AddCmd(&ishell.Cmd{
+		Name:      "list",
+		Help:      "print the address groups of the account. Use index or account name as parameter. (aliases: l, ls)",
+		Func:      fe.noAccountWrapper(fe.listAddressGroups),
+		Aliases:   []string{"l", "ls"},
+		Completer: fe.completeUsernames,
+	})
+	addressGroupsCmd.AddCmd(&ishell.Cmd{
+		Name:      "set",
+		Help:      "create or replace an address group. Use index or account name as parameter. (aliases: add, new)",
+		Func:      fe.noAccountWrapper(fe.setAddressGroup),
+		Aliases:   []string{"add", "new"},
+		Completer: fe.completeUsernames,
+	})
+	addressGroupsCmd.AddCmd(&ishell.Cmd{
+		Name:      "remove",
+		Help:      "remove an address group. Use index or account name, then group name as parameters. (aliases: rm, delete)",
+		Func:      fe.noAccountWrapper(fe.removeAddressGroup),
+		Aliases:   []string{"rm", "delete"},
+		Completer: fe.completeUsernames,
+	})
+	fe.AddCmd(addressGroupsCmd)
+
+	// OAuth commands.
+	oauthCmd := &ishell.Cmd{
+		Name: "oauth",
+		Help: "let email clients authenticate with OAuth2 tokens, issued by a local server once you allow their request",
+	}
+	oauthCmd.AddCmd(&ishell.Cmd{
+		Name: "enable",
+		Help: "start the local OAuth server and accept the OAUTHBEARER and XOAUTH2 authentication mechanisms",
+		Func: fe.enableOAuth,
+	})
+	oauthCmd.AddCmd(&ishell.Cmd{
+		Name: "disable",
+		Help: "stop the local OAuth server, the issued tokens are no longer valid",
+		Func: fe.disableOAuth,
+	})
+	oauthCmd.AddCmd(&ishell.Cmd{
+		Name:    "list",
+		Help:    "print the pending authorization requests. (aliases: l, ls)",
+		Func:    fe.listOAuthRequests,
+		Aliases: []string{"l", "ls"},
+	})
+	oauthCmd.AddCmd(&ishell.Cmd{
+		Name: "allow",
+		Help: "allow a pending authorization request. Use the request index as parameter if several are pending",
+		Func: fe.allowOAuthRequest,
+	})
+	oauthCmd.AddCmd(&ishell.Cmd{
+		Name: "deny",
+		Help: "deny a pending authorization request. Use the request index as parameter if several are pending",
+		Func: fe.denyOAuthRequest,
+	})
+	fe.AddCmd(oauthCmd)
 	fe.AddCmd(&ishell.Cmd{
 		Name:    "repair",
 		Help:    "reload all accounts and cached data, re-download emails. Email clients remain connected. Logged out users will be repaired on next login. (aliases: rep)",
@@ -347,6 +498,28 @@ func New(
 	})
 	fe.AddCmd(telemetryCmd)
 
+	// Desktop notifications commands
+	notificationsCmd := &ishell.Cmd{
+		Name: "notifications",
+		Help: "choose whether desktop notifications are raised, and for which events",
+	}
+	notificationsCmd.AddCmd(&ishell.Cmd{
+		Name: "enable",
+		Help: "Desktop notifications will be raised for the selected events",
+		Func: fe.enableDesktopNotifications,
+	})
+	notificationsCmd.AddCmd(&ishell.Cmd{
+		Name: "disable",
+		Help: "Desktop notifications will not be raised",
+		Func: fe.disableDesktopNotifications,
+	})
+	notificationsCmd.AddCmd(&ishell.Cmd{
+		Name: "events",
+		Help: "select the events raising a desktop notification",
+		Func: fe.changeDesktopNotificationEvents,
+	})
+	fe.AddCmd(notificationsCmd)
+
 	dbgCmd := &ishell.Cmd{
 		Name: "debug",
 		Help: "Debug diagnostics ",
@@ -358,6 +531,18 @@ func New(
 		Func: fe.debugMailboxState,
 	})
 
+	dbgCmd.AddCmd(&ishell.Cmd{
+		Name: "repair-mailbox-state",
+		Help: "Fix the messages whose local mailbox state differs from the proton server state",
+		Func: fe.debugRepairMailboxState,
+	})
+
+	dbgCmd.AddCmd(&ishell.Cmd{
+		Name: "export-diagnostics",
+		Help: "Export logs, settings and sync diagnostics to a local zip file, without uploading anything",
+		Func: fe.exportDiagnostics,
+	})
+
 	fe.AddCmd(dbgCmd)
 
 	go fe.watchEvents(eventCh)
@@ -426,6 +611,12 @@ func (f *frontendCLI) watchEvents(eventCh <-chan events.Event) { // nolint:gocyc
 		case events.IMAPLoginFailed:
 			f.Printf("An IMAP login attempt failed for user %v\n", event.Username)
 
+		case events.OAuthAuthorizationRequested:
+			f.Printf("Email client %v requests access to %v with scope %v, use `oauth allow` or `oauth deny` to answer\n", event.ClientID, event.Email, event.Scope)
+
+		case events.ConnectionRejected:
+			f.Printf("An %v connection from %v was rejected (%v)\n", event.Protocol, event.RemoteAddr, event.Reason)
+
 		case events.UserAddressEnabled:
 			user, err := f.bridge.GetUserInfo(event.UserID)
 			if err != nil {
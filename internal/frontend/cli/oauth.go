@@ -0,0 +1,123 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package cli
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/oauthserver"
+	"github.com/abiosoft/ishell"
+)
+
+func (f *frontendCLI) enableOAuth(_ *ishell.Context) {
+	if err := f.bridge.SetOAuth(context.Background(), true); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("OAuth enabled. Configure your email client with:")
+	f.Printf("  Authorization endpoint: %v\n", f.bridge.GetOAuthAuthorizeURL())
+	f.Printf("  Token endpoint:         %v\n", f.bridge.GetOAuthTokenURL())
+	f.Println("Every authorization request must then be allowed with the `oauth allow` command.")
+}
+
+func (f *frontendCLI) disableOAuth(_ *ishell.Context) {
+	if err := f.bridge.SetOAuth(context.Background(), false); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("OAuth disabled, the issued tokens are no longer valid.")
+}
+
+func (f *frontendCLI) listOAuthRequests(_ *ishell.Context) {
+	requests := f.bridge.GetOAuthRequests()
+	if len(requests) == 0 {
+		f.Println("No pending authorization request.")
+		return
+	}
+
+	spacing := "%-2d: %-30s %-30s %-14s %-10s\n"
+	f.Printf(bold(strings.ReplaceAll(spacing, "d", "s")), "#", "email client", "account", "scope", "requested")
+
+	for idx, request := range requests {
+		f.Printf(spacing, idx, request.ClientID, request.Email, request.Scope, request.CreatedAt.Format("15:04:05"))
+	}
+}
+
+func (f *frontendCLI) allowOAuthRequest(c *ishell.Context) {
+	f.resolveOAuthRequest(c, true)
+}
+
+func (f *frontendCLI) denyOAuthRequest(c *ishell.Context) {
+	f.resolveOAuthRequest(c, false)
+}
+
+func (f *frontendCLI) resolveOAuthRequest(c *ishell.Context, allow bool) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	request, ok := f.askOAuthRequest(c)
+	if !ok {
+		return
+	}
+
+	if allow && !f.yesNoQuestion("Allow "+bold(request.ClientID)+" to access "+bold(request.Email)+" with scope "+bold(string(request.Scope))) {
+		return
+	}
+
+	if err := f.bridge.ResolveOAuthRequest(request.ID, allow); err != nil {
+		f.printAndLogError("Cannot answer the authorization request: ", err)
+		return
+	}
+
+	if allow {
+		f.Printf("Authorization request of %s allowed.\n", bold(request.ClientID))
+	} else {
+		f.Printf("Authorization request of %s denied.\n", bold(request.ClientID))
+	}
+}
+
+// askOAuthRequest returns the pending request with the index given as argument, or the only pending request.
+func (f *frontendCLI) askOAuthRequest(c *ishell.Context) (oauthserver.Request, bool) {
+	requests := f.bridge.GetOAuthRequests()
+	if len(requests) == 0 {
+		f.Println("No pending authorization request.")
+		return oauthserver.Request{}, false
+	}
+
+	if len(c.Args) == 0 {
+		if len(requests) == 1 {
+			return requests[0], true
+		}
+
+		f.Println("Several authorization requests are pending, use the index shown by the list command.")
+
+		return oauthserver.Request{}, false
+	}
+
+	idx, err := strconv.Atoi(c.Args[0])
+	if err != nil || idx < 0 || idx >= len(requests) {
+		f.Printf("Wrong input '%s'. Use the index shown by the list command.\n", bold(c.Args[0]))
+		return oauthserver.Request{}, false
+	}
+
+	return requests[idx], true
+}
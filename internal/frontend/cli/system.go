@@ -23,11 +23,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
 	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/ports"
 	"github.com/abiosoft/ishell"
 )
@@ -40,6 +42,26 @@ func (f *frontendCLI) printLogDir(_ *ishell.Context) {
 	}
 }
 
+func (f *frontendCLI) changeLogLevel(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	f.Println("The log level can be set per subsystem, e.g. " + bold("info,sync=debug,smtp=trace"))
+
+	level := f.readStringInAttempts(fmt.Sprintf("Set log level (current %v)", logging.GetLevels()), c.ReadLine, isNotEmpty)
+	if level == "" {
+		f.printAndLogError(errors.New("failed to get new log level"))
+		return
+	}
+
+	if err := logging.SetLevels(level); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("Log level changed to", logging.GetLevels())
+}
+
 func (f *frontendCLI) printManual(_ *ishell.Context) {
 	f.Println("More instructions about the Bridge can be found at\n\n  https://proton.me/mail/bridge")
 }
@@ -110,6 +132,82 @@ func (f *frontendCLI) changeIMAPPort(c *ishell.Context) {
 	}
 }
 
+func (f *frontendCLI) changeBindAddress(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	f.Println("Use " + bold("0.0.0.0") + " or " + bold("::") + " to accept clients of the allowed networks on all interfaces.")
+	f.Println("Other hosts must then use SSL for IMAP and SSL or STARTTLS for SMTP.")
+
+	address := f.readStringInAttempts(fmt.Sprintf("Set bind address (current %v)", f.bridge.GetBindAddress()), c.ReadLine, isIPAddress)
+	if address == "" {
+		f.printAndLogError(errors.New("failed to get new bind address"))
+		return
+	}
+
+	if err := f.bridge.SetBindAddress(context.Background(), address); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("Bind address changed to", f.bridge.GetBindAddress())
+}
+
+func (f *frontendCLI) changeAllowedNetworks(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	f.Println("Other hosts are only accepted from the allowed networks, e.g. " + bold("192.168.1.0/24,fd00::/8"))
+	f.Println("Leave empty to allow the private networks.")
+
+	f.Printf("Set allowed networks (current %v): ", strings.Join(f.bridge.GetAllowedNetworks(), ","))
+
+	var networks []string
+
+	for _, network := range strings.Split(c.ReadLine(), ",") {
+		if network = strings.TrimSpace(network); network != "" {
+			networks = append(networks, network)
+		}
+	}
+
+	if err := f.bridge.SetAllowedNetworks(networks); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("Allowed networks changed to", strings.Join(f.bridge.GetAllowedNetworks(), ","))
+}
+
+func (f *frontendCLI) enableUnixSockets(_ *ishell.Context) {
+	if err := f.bridge.SetUnixSockets(context.Background(), true); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	imapPath, err := f.bridge.GetIMAPSocketPath()
+	if err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	smtpPath, err := f.bridge.GetSMTPSocketPath()
+	if err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Printf("IMAP socket: %v\nSMTP socket: %v\n", imapPath, smtpPath)
+}
+
+func (f *frontendCLI) disableUnixSockets(_ *ishell.Context) {
+	if err := f.bridge.SetUnixSockets(context.Background(), false); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("Unix sockets disabled.")
+}
+
 func (f *frontendCLI) changeSMTPPort(c *ishell.Context) {
 	f.ShowPrompt(false)
 	defer f.ShowPrompt(true)
@@ -228,6 +326,66 @@ func (f *frontendCLI) disableTelemetry(_ *ishell.Context) {
 	}
 }
 
+func (f *frontendCLI) enableDesktopNotifications(_ *ishell.Context) {
+	if f.bridge.GetDesktopNotifications() {
+		f.Println("Desktop notifications are enabled.")
+		return
+	}
+
+	if err := f.bridge.SetDesktopNotifications(true); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("Desktop notifications enabled for:", strings.Join(f.bridge.GetDesktopNotificationEvents(), ", "))
+}
+
+func (f *frontendCLI) disableDesktopNotifications(_ *ishell.Context) {
+	if !f.bridge.GetDesktopNotifications() {
+		f.Println("Desktop notifications are disabled.")
+		return
+	}
+
+	if err := f.bridge.SetDesktopNotifications(false); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("Desktop notifications disabled.")
+}
+
+func (f *frontendCLI) changeDesktopNotificationEvents(c *ishell.Context) {
+	names := bridge.GetDesktopNotificationEventNames()
+
+	selected := make([]int, 0, len(names))
+
+	for idx, name := range names {
+		if slices.Contains(f.bridge.GetDesktopNotificationEvents(), name) {
+			selected = append(selected, idx)
+		}
+	}
+
+	choices := c.Checklist(names, "Select the events raising a desktop notification", selected)
+
+	events := make([]string, 0, len(choices))
+
+	for _, idx := range choices {
+		events = append(events, names[idx])
+	}
+
+	if err := f.bridge.SetDesktopNotificationEvents(events); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	if len(events) == 0 {
+		f.Println("No event will raise a desktop notification.")
+		return
+	}
+
+	f.Println("Desktop notifications selected for:", strings.Join(events, ", "))
+}
+
 func (f *frontendCLI) setGluonLocation(c *ishell.Context) {
 	if gluonDir := f.bridge.GetGluonCacheDir(); gluonDir != "" {
 		f.Println("The current message cache location is:", gluonDir)
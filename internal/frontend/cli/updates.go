@@ -18,6 +18,7 @@
 package cli
 
 import (
+	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
 	"github.com/abiosoft/ishell"
@@ -38,6 +39,92 @@ func (f *frontendCLI) checkUpdates(_ *ishell.Context) {
 	}
 }
 
+func (f *frontendCLI) installUpdateFromFile(c *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	var pkgPath string
+
+	if len(c.Args) > 0 {
+		pkgPath = c.Args[0]
+	} else {
+		pkgPath = f.readStringInAttempts("Path of the update package", c.ReadLine, isNotEmpty)
+	}
+
+	if pkgPath == "" {
+		return
+	}
+
+	if err := f.bridge.InstallUpdateFromFile(pkgPath, pkgPath+".sig", nil); err != nil {
+		f.printAndLogError("Cannot install update: ", err)
+		return
+	}
+
+	if f.yesNoQuestion("Do you want to restart bridge now") {
+		f.restarter.Set(true, false)
+		f.Stop()
+	}
+}
+
+func (f *frontendCLI) rollbackUpdate(_ *ishell.Context) {
+	f.ShowPrompt(false)
+	defer f.ShowPrompt(true)
+
+	f.Println("The current version is marked as bad and the previous version is started instead.")
+
+	if !f.yesNoQuestion("Are you sure you want to roll back") {
+		return
+	}
+
+	if err := f.bridge.Rollback(); err != nil {
+		f.printAndLogError("Cannot roll back: ", err)
+		return
+	}
+
+	f.restarter.Set(true, false)
+	f.Stop()
+}
+
+func (f *frontendCLI) pinVersion(c *ishell.Context) {
+	if len(c.Args) == 0 {
+		version, err := f.bridge.GetPinnedVersion()
+		if err != nil {
+			f.printAndLogError(err)
+			return
+		}
+
+		if version == nil {
+			f.Println("Bridge is not pinned to a version.")
+		} else {
+			f.Println("Bridge is pinned to version", version)
+		}
+
+		return
+	}
+
+	version, err := semver.StrictNewVersion(c.Args[0])
+	if err != nil {
+		f.printAndLogError("Invalid version: ", err)
+		return
+	}
+
+	if err := f.bridge.SetPinnedVersion(version); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("Bridge is pinned to version", version)
+}
+
+func (f *frontendCLI) unpinVersion(_ *ishell.Context) {
+	if err := f.bridge.SetPinnedVersion(nil); err != nil {
+		f.printAndLogError(err)
+		return
+	}
+
+	f.Println("Bridge is not pinned to a version anymore.")
+}
+
 func (f *frontendCLI) enableAutoUpdates(_ *ishell.Context) {
 	if f.bridge.GetAutoUpdate() {
 		f.Println("Bridge is already set to automatically install updates.")
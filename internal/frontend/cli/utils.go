@@ -18,6 +18,7 @@
 package cli
 
 import (
+	"net"
 	"strings"
 
 	"github.com/fatih/color"
@@ -33,6 +34,10 @@ func isNotEmpty(val string) bool {
 	return val != ""
 }
 
+func isIPAddress(val string) bool {
+	return net.ParseIP(val) != nil
+}
+
 func (f *frontendCLI) yesNoQuestion(question string) bool {
 	f.Print(question, "? yes/"+bold("no")+": ")
 	yes := "yes"
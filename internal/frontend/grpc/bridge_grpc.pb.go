@@ -40,6 +40,8 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Bridge_CheckTokens_FullMethodName                     = "/grpc.Bridge/CheckTokens"
 	Bridge_AddLogEntry_FullMethodName                     = "/grpc.Bridge/AddLogEntry"
+	Bridge_LogLevels_FullMethodName                       = "/grpc.Bridge/LogLevels"
+	Bridge_SetLogLevels_FullMethodName                    = "/grpc.Bridge/SetLogLevels"
 	Bridge_GuiReady_FullMethodName                        = "/grpc.Bridge/GuiReady"
 	Bridge_Quit_FullMethodName                            = "/grpc.Bridge/Quit"
 	Bridge_Restart_FullMethodName                         = "/grpc.Bridge/Restart"
@@ -64,6 +66,8 @@ const (
 	Bridge_ColorSchemeName_FullMethodName                 = "/grpc.Bridge/ColorSchemeName"
 	Bridge_CurrentEmailClient_FullMethodName              = "/grpc.Bridge/CurrentEmailClient"
 	Bridge_ReportBug_FullMethodName                       = "/grpc.Bridge/ReportBug"
+	Bridge_ExportDiagnostics_FullMethodName               = "/grpc.Bridge/ExportDiagnostics"
+	Bridge_RepairClientState_FullMethodName               = "/grpc.Bridge/RepairClientState"
 	Bridge_ForceLauncher_FullMethodName                   = "/grpc.Bridge/ForceLauncher"
 	Bridge_SetMainExecutable_FullMethodName               = "/grpc.Bridge/SetMainExecutable"
 	Bridge_RequestKnowledgeBaseSuggestions_FullMethodName = "/grpc.Bridge/RequestKnowledgeBaseSuggestions"
@@ -73,6 +77,8 @@ const (
 	Bridge_LoginAbort_FullMethodName                      = "/grpc.Bridge/LoginAbort"
 	Bridge_CheckUpdate_FullMethodName                     = "/grpc.Bridge/CheckUpdate"
 	Bridge_InstallUpdate_FullMethodName                   = "/grpc.Bridge/InstallUpdate"
+	Bridge_InstallUpdateFromFile_FullMethodName           = "/grpc.Bridge/InstallUpdateFromFile"
+	Bridge_Rollback_FullMethodName                        = "/grpc.Bridge/Rollback"
 	Bridge_SetIsAutomaticUpdateOn_FullMethodName          = "/grpc.Bridge/SetIsAutomaticUpdateOn"
 	Bridge_IsAutomaticUpdateOn_FullMethodName             = "/grpc.Bridge/IsAutomaticUpdateOn"
 	Bridge_DiskCachePath_FullMethodName                   = "/grpc.Bridge/DiskCachePath"
@@ -99,6 +105,22 @@ const (
 	Bridge_RunEventStream_FullMethodName                  = "/grpc.Bridge/RunEventStream"
 	Bridge_StopEventStream_FullMethodName                 = "/grpc.Bridge/StopEventStream"
 	Bridge_TriggerRepair_FullMethodName                   = "/grpc.Bridge/TriggerRepair"
+	Bridge_Settings_FullMethodName                        = "/grpc.Bridge/Settings"
+	Bridge_GetSetting_FullMethodName                      = "/grpc.Bridge/GetSetting"
+	Bridge_SetSetting_FullMethodName                      = "/grpc.Bridge/SetSetting"
+	Bridge_SetUserAddressMode_FullMethodName              = "/grpc.Bridge/SetUserAddressMode"
+	Bridge_SetUserReadOnly_FullMethodName                 = "/grpc.Bridge/SetUserReadOnly"
+	Bridge_SetUserStrictSplitMode_FullMethodName          = "/grpc.Bridge/SetUserStrictSplitMode"
+	Bridge_ListAppPasswords_FullMethodName                = "/grpc.Bridge/ListAppPasswords"
+	Bridge_CreateAppPassword_FullMethodName               = "/grpc.Bridge/CreateAppPassword"
+	Bridge_RevokeAppPassword_FullMethodName               = "/grpc.Bridge/RevokeAppPassword"
+	Bridge_ListAddressGroups_FullMethodName               = "/grpc.Bridge/ListAddressGroups"
+	Bridge_SetAddressGroup_FullMethodName                 = "/grpc.Bridge/SetAddressGroup"
+	Bridge_ResolveOAuthRequest_FullMethodName             = "/grpc.Bridge/ResolveOAuthRequest"
+	Bridge_ImportMessages_FullMethodName                  = "/grpc.Bridge/ImportMessages"
+	Bridge_ExportMessages_FullMethodName                  = "/grpc.Bridge/ExportMessages"
+	Bridge_GetExportSchedule_FullMethodName               = "/grpc.Bridge/GetExportSchedule"
+	Bridge_SetExportSchedule_FullMethodName               = "/grpc.Bridge/SetExportSchedule"
 )
 
 // BridgeClient is the client API for Bridge service.
@@ -108,6 +130,8 @@ type BridgeClient interface {
 	// App related calls
 	CheckTokens(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	AddLogEntry(ctx context.Context, in *AddLogEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LogLevels(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
+	SetLogLevels(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GuiReady(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GuiReadyResponse, error)
 	Quit(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Restart(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -132,6 +156,8 @@ type BridgeClient interface {
 	ColorSchemeName(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	CurrentEmailClient(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	ReportBug(ctx context.Context, in *ReportBugRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ExportDiagnostics(ctx context.Context, in *ExportDiagnosticsRequest, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
+	RepairClientState(ctx context.Context, in *RepairClientStateRequest, opts ...grpc.CallOption) (*RepairClientStateResponse, error)
 	ForceLauncher(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetMainExecutable(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RequestKnowledgeBaseSuggestions(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -143,6 +169,8 @@ type BridgeClient interface {
 	// update
 	CheckUpdate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	InstallUpdate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	InstallUpdateFromFile(ctx context.Context, in *InstallUpdateFromFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Rollback(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetIsAutomaticUpdateOn(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	IsAutomaticUpdateOn(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 	// cache
@@ -176,6 +204,23 @@ type BridgeClient interface {
 	StopEventStream(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Repair
 	TriggerRepair(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Scripting. Unlike the calls above, these are synchronous and report errors directly to the caller.
+	Settings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SettingsResponse, error)
+	GetSetting(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
+	SetSetting(ctx context.Context, in *SetSettingRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	SetUserAddressMode(ctx context.Context, in *UserSplitModeRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	SetUserReadOnly(ctx context.Context, in *UserReadOnlyRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	SetUserStrictSplitMode(ctx context.Context, in *UserStrictSplitModeRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	ListAppPasswords(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*AppPasswordListResponse, error)
+	CreateAppPassword(ctx context.Context, in *CreateAppPasswordRequest, opts ...grpc.CallOption) (*CreateAppPasswordResponse, error)
+	RevokeAppPassword(ctx context.Context, in *RevokeAppPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListAddressGroups(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*AddressGroupListResponse, error)
+	SetAddressGroup(ctx context.Context, in *AddressGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResolveOAuthRequest(ctx context.Context, in *ResolveOAuthRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ImportMessages(ctx context.Context, in *ImportMessagesRequest, opts ...grpc.CallOption) (Bridge_ImportMessagesClient, error)
+	ExportMessages(ctx context.Context, in *ExportMessagesRequest, opts ...grpc.CallOption) (Bridge_ExportMessagesClient, error)
+	GetExportSchedule(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*ExportSchedule, error)
+	SetExportSchedule(ctx context.Context, in *ExportScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type bridgeClient struct {
@@ -204,6 +249,24 @@ func (c *bridgeClient) AddLogEntry(ctx context.Context, in *AddLogEntryRequest,
 	return out, nil
 }
 
+func (c *bridgeClient) LogLevels(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, Bridge_LogLevels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) SetLogLevels(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetLogLevels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) GuiReady(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GuiReadyResponse, error) {
 	out := new(GuiReadyResponse)
 	err := c.cc.Invoke(ctx, Bridge_GuiReady_FullMethodName, in, out, opts...)
@@ -420,6 +483,24 @@ func (c *bridgeClient) ReportBug(ctx context.Context, in *ReportBugRequest, opts
 	return out, nil
 }
 
+func (c *bridgeClient) ExportDiagnostics(ctx context.Context, in *ExportDiagnosticsRequest, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, Bridge_ExportDiagnostics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) RepairClientState(ctx context.Context, in *RepairClientStateRequest, opts ...grpc.CallOption) (*RepairClientStateResponse, error) {
+	out := new(RepairClientStateResponse)
+	err := c.cc.Invoke(ctx, Bridge_RepairClientState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) ForceLauncher(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Bridge_ForceLauncher_FullMethodName, in, out, opts...)
@@ -501,6 +582,24 @@ func (c *bridgeClient) InstallUpdate(ctx context.Context, in *emptypb.Empty, opt
 	return out, nil
 }
 
+func (c *bridgeClient) InstallUpdateFromFile(ctx context.Context, in *InstallUpdateFromFileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_InstallUpdateFromFile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) Rollback(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_Rollback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeClient) SetIsAutomaticUpdateOn(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Bridge_SetIsAutomaticUpdateOn_FullMethodName, in, out, opts...)
@@ -758,6 +857,196 @@ func (c *bridgeClient) TriggerRepair(ctx context.Context, in *emptypb.Empty, opt
 	return out, nil
 }
 
+func (c *bridgeClient) Settings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SettingsResponse, error) {
+	out := new(SettingsResponse)
+	err := c.cc.Invoke(ctx, Bridge_Settings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) GetSetting(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, Bridge_GetSetting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) SetSetting(ctx context.Context, in *SetSettingRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Bridge_SetSetting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) SetUserAddressMode(ctx context.Context, in *UserSplitModeRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Bridge_SetUserAddressMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) SetUserReadOnly(ctx context.Context, in *UserReadOnlyRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Bridge_SetUserReadOnly_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) SetUserStrictSplitMode(ctx context.Context, in *UserStrictSplitModeRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Bridge_SetUserStrictSplitMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) ListAppPasswords(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*AppPasswordListResponse, error) {
+	out := new(AppPasswordListResponse)
+	err := c.cc.Invoke(ctx, Bridge_ListAppPasswords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) CreateAppPassword(ctx context.Context, in *CreateAppPasswordRequest, opts ...grpc.CallOption) (*CreateAppPasswordResponse, error) {
+	out := new(CreateAppPasswordResponse)
+	err := c.cc.Invoke(ctx, Bridge_CreateAppPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) RevokeAppPassword(ctx context.Context, in *RevokeAppPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_RevokeAppPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) ListAddressGroups(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*AddressGroupListResponse, error) {
+	out := new(AddressGroupListResponse)
+	err := c.cc.Invoke(ctx, Bridge_ListAddressGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) SetAddressGroup(ctx context.Context, in *AddressGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetAddressGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) ResolveOAuthRequest(ctx context.Context, in *ResolveOAuthRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_ResolveOAuthRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) ImportMessages(ctx context.Context, in *ImportMessagesRequest, opts ...grpc.CallOption) (Bridge_ImportMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bridge_ServiceDesc.Streams[1], Bridge_ImportMessages_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bridgeImportMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bridge_ImportMessagesClient interface {
+	Recv() (*ImportMessagesProgress, error)
+	grpc.ClientStream
+}
+
+type bridgeImportMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *bridgeImportMessagesClient) Recv() (*ImportMessagesProgress, error) {
+	m := new(ImportMessagesProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bridgeClient) ExportMessages(ctx context.Context, in *ExportMessagesRequest, opts ...grpc.CallOption) (Bridge_ExportMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bridge_ServiceDesc.Streams[2], Bridge_ExportMessages_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bridgeExportMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bridge_ExportMessagesClient interface {
+	Recv() (*ExportMessagesProgress, error)
+	grpc.ClientStream
+}
+
+type bridgeExportMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *bridgeExportMessagesClient) Recv() (*ExportMessagesProgress, error) {
+	m := new(ExportMessagesProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bridgeClient) GetExportSchedule(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*ExportSchedule, error) {
+	out := new(ExportSchedule)
+	err := c.cc.Invoke(ctx, Bridge_GetExportSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeClient) SetExportSchedule(ctx context.Context, in *ExportScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Bridge_SetExportSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BridgeServer is the server API for Bridge service.
 // All implementations must embed UnimplementedBridgeServer
 // for forward compatibility
@@ -765,6 +1054,8 @@ type BridgeServer interface {
 	// App related calls
 	CheckTokens(context.Context, *wrapperspb.StringValue) (*wrapperspb.StringValue, error)
 	AddLogEntry(context.Context, *AddLogEntryRequest) (*emptypb.Empty, error)
+	LogLevels(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	SetLogLevels(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
 	GuiReady(context.Context, *emptypb.Empty) (*GuiReadyResponse, error)
 	Quit(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	Restart(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
@@ -789,6 +1080,8 @@ type BridgeServer interface {
 	ColorSchemeName(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
 	CurrentEmailClient(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
 	ReportBug(context.Context, *ReportBugRequest) (*emptypb.Empty, error)
+	ExportDiagnostics(context.Context, *ExportDiagnosticsRequest) (*wrapperspb.StringValue, error)
+	RepairClientState(context.Context, *RepairClientStateRequest) (*RepairClientStateResponse, error)
 	ForceLauncher(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
 	SetMainExecutable(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
 	RequestKnowledgeBaseSuggestions(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
@@ -800,6 +1093,8 @@ type BridgeServer interface {
 	// update
 	CheckUpdate(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	InstallUpdate(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	InstallUpdateFromFile(context.Context, *InstallUpdateFromFileRequest) (*emptypb.Empty, error)
+	Rollback(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	SetIsAutomaticUpdateOn(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error)
 	IsAutomaticUpdateOn(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
 	// cache
@@ -833,6 +1128,23 @@ type BridgeServer interface {
 	StopEventStream(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// Repair
 	TriggerRepair(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Scripting. Unlike the calls above, these are synchronous and report errors directly to the caller.
+	Settings(context.Context, *emptypb.Empty) (*SettingsResponse, error)
+	GetSetting(context.Context, *wrapperspb.StringValue) (*wrapperspb.StringValue, error)
+	SetSetting(context.Context, *SetSettingRequest) (*wrapperspb.BoolValue, error)
+	SetUserAddressMode(context.Context, *UserSplitModeRequest) (*wrapperspb.BoolValue, error)
+	SetUserReadOnly(context.Context, *UserReadOnlyRequest) (*wrapperspb.BoolValue, error)
+	SetUserStrictSplitMode(context.Context, *UserStrictSplitModeRequest) (*wrapperspb.BoolValue, error)
+	ListAppPasswords(context.Context, *wrapperspb.StringValue) (*AppPasswordListResponse, error)
+	CreateAppPassword(context.Context, *CreateAppPasswordRequest) (*CreateAppPasswordResponse, error)
+	RevokeAppPassword(context.Context, *RevokeAppPasswordRequest) (*emptypb.Empty, error)
+	ListAddressGroups(context.Context, *wrapperspb.StringValue) (*AddressGroupListResponse, error)
+	SetAddressGroup(context.Context, *AddressGroupRequest) (*emptypb.Empty, error)
+	ResolveOAuthRequest(context.Context, *ResolveOAuthRequestRequest) (*emptypb.Empty, error)
+	ImportMessages(*ImportMessagesRequest, Bridge_ImportMessagesServer) error
+	ExportMessages(*ExportMessagesRequest, Bridge_ExportMessagesServer) error
+	GetExportSchedule(context.Context, *wrapperspb.StringValue) (*ExportSchedule, error)
+	SetExportSchedule(context.Context, *ExportScheduleRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedBridgeServer()
 }
 
@@ -846,6 +1158,12 @@ func (UnimplementedBridgeServer) CheckTokens(context.Context, *wrapperspb.String
 func (UnimplementedBridgeServer) AddLogEntry(context.Context, *AddLogEntryRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddLogEntry not implemented")
 }
+func (UnimplementedBridgeServer) LogLevels(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogLevels not implemented")
+}
+func (UnimplementedBridgeServer) SetLogLevels(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevels not implemented")
+}
 func (UnimplementedBridgeServer) GuiReady(context.Context, *emptypb.Empty) (*GuiReadyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GuiReady not implemented")
 }
@@ -918,6 +1236,12 @@ func (UnimplementedBridgeServer) CurrentEmailClient(context.Context, *emptypb.Em
 func (UnimplementedBridgeServer) ReportBug(context.Context, *ReportBugRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportBug not implemented")
 }
+func (UnimplementedBridgeServer) ExportDiagnostics(context.Context, *ExportDiagnosticsRequest) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportDiagnostics not implemented")
+}
+func (UnimplementedBridgeServer) RepairClientState(context.Context, *RepairClientStateRequest) (*RepairClientStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairClientState not implemented")
+}
 func (UnimplementedBridgeServer) ForceLauncher(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceLauncher not implemented")
 }
@@ -945,6 +1269,12 @@ func (UnimplementedBridgeServer) CheckUpdate(context.Context, *emptypb.Empty) (*
 func (UnimplementedBridgeServer) InstallUpdate(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InstallUpdate not implemented")
 }
+func (UnimplementedBridgeServer) InstallUpdateFromFile(context.Context, *InstallUpdateFromFileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallUpdateFromFile not implemented")
+}
+func (UnimplementedBridgeServer) Rollback(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
 func (UnimplementedBridgeServer) SetIsAutomaticUpdateOn(context.Context, *wrapperspb.BoolValue) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetIsAutomaticUpdateOn not implemented")
 }
@@ -1023,6 +1353,54 @@ func (UnimplementedBridgeServer) StopEventStream(context.Context, *emptypb.Empty
 func (UnimplementedBridgeServer) TriggerRepair(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TriggerRepair not implemented")
 }
+func (UnimplementedBridgeServer) Settings(context.Context, *emptypb.Empty) (*SettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Settings not implemented")
+}
+func (UnimplementedBridgeServer) GetSetting(context.Context, *wrapperspb.StringValue) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSetting not implemented")
+}
+func (UnimplementedBridgeServer) SetSetting(context.Context, *SetSettingRequest) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSetting not implemented")
+}
+func (UnimplementedBridgeServer) SetUserAddressMode(context.Context, *UserSplitModeRequest) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserAddressMode not implemented")
+}
+func (UnimplementedBridgeServer) SetUserReadOnly(context.Context, *UserReadOnlyRequest) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserReadOnly not implemented")
+}
+func (UnimplementedBridgeServer) SetUserStrictSplitMode(context.Context, *UserStrictSplitModeRequest) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserStrictSplitMode not implemented")
+}
+func (UnimplementedBridgeServer) ListAppPasswords(context.Context, *wrapperspb.StringValue) (*AppPasswordListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppPasswords not implemented")
+}
+func (UnimplementedBridgeServer) CreateAppPassword(context.Context, *CreateAppPasswordRequest) (*CreateAppPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppPassword not implemented")
+}
+func (UnimplementedBridgeServer) RevokeAppPassword(context.Context, *RevokeAppPasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAppPassword not implemented")
+}
+func (UnimplementedBridgeServer) ListAddressGroups(context.Context, *wrapperspb.StringValue) (*AddressGroupListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAddressGroups not implemented")
+}
+func (UnimplementedBridgeServer) SetAddressGroup(context.Context, *AddressGroupRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAddressGroup not implemented")
+}
+func (UnimplementedBridgeServer) ResolveOAuthRequest(context.Context, *ResolveOAuthRequestRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveOAuthRequest not implemented")
+}
+func (UnimplementedBridgeServer) ImportMessages(*ImportMessagesRequest, Bridge_ImportMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportMessages not implemented")
+}
+func (UnimplementedBridgeServer) ExportMessages(*ExportMessagesRequest, Bridge_ExportMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMessages not implemented")
+}
+func (UnimplementedBridgeServer) GetExportSchedule(context.Context, *wrapperspb.StringValue) (*ExportSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportSchedule not implemented")
+}
+func (UnimplementedBridgeServer) SetExportSchedule(context.Context, *ExportScheduleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExportSchedule not implemented")
+}
 func (UnimplementedBridgeServer) mustEmbedUnimplementedBridgeServer() {}
 
 // UnsafeBridgeServer may be embedded to opt out of forward compatibility for this service.
@@ -1072,6 +1450,42 @@ func _Bridge_AddLogEntry_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bridge_LogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).LogLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_LogLevels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).LogLevels(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetLogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetLogLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetLogLevels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetLogLevels(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bridge_GuiReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1504,67 +1918,103 @@ func _Bridge_ReportBug_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_ForceLauncher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.StringValue)
+func _Bridge_ExportDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDiagnosticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).ForceLauncher(ctx, in)
+		return srv.(BridgeServer).ExportDiagnostics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_ForceLauncher_FullMethodName,
+		FullMethod: Bridge_ExportDiagnostics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).ForceLauncher(ctx, req.(*wrapperspb.StringValue))
+		return srv.(BridgeServer).ExportDiagnostics(ctx, req.(*ExportDiagnosticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_SetMainExecutable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.StringValue)
+func _Bridge_RepairClientState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairClientStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).SetMainExecutable(ctx, in)
+		return srv.(BridgeServer).RepairClientState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_SetMainExecutable_FullMethodName,
+		FullMethod: Bridge_RepairClientState_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).SetMainExecutable(ctx, req.(*wrapperspb.StringValue))
+		return srv.(BridgeServer).RepairClientState(ctx, req.(*RepairClientStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_RequestKnowledgeBaseSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bridge_ForceLauncher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(wrapperspb.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).RequestKnowledgeBaseSuggestions(ctx, in)
+		return srv.(BridgeServer).ForceLauncher(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Bridge_RequestKnowledgeBaseSuggestions_FullMethodName,
+		FullMethod: Bridge_ForceLauncher_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BridgeServer).RequestKnowledgeBaseSuggestions(ctx, req.(*wrapperspb.StringValue))
+		return srv.(BridgeServer).ForceLauncher(ctx, req.(*wrapperspb.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bridge_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _Bridge_SetMainExecutable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BridgeServer).Login(ctx, in)
+		return srv.(BridgeServer).SetMainExecutable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetMainExecutable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetMainExecutable(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_RequestKnowledgeBaseSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).RequestKnowledgeBaseSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_RequestKnowledgeBaseSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).RequestKnowledgeBaseSuggestions(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).Login(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -1666,6 +2116,42 @@ func _Bridge_InstallUpdate_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bridge_InstallUpdateFromFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstallUpdateFromFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).InstallUpdateFromFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_InstallUpdateFromFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).InstallUpdateFromFile(ctx, req.(*InstallUpdateFromFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_Rollback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).Rollback(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Bridge_SetIsAutomaticUpdateOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(wrapperspb.BoolValue)
 	if err := dec(in); err != nil {
@@ -2137,6 +2623,300 @@ func _Bridge_TriggerRepair_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bridge_Settings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).Settings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_Settings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).Settings(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_GetSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).GetSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_GetSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).GetSetting(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetSetting(ctx, req.(*SetSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetUserAddressMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSplitModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetUserAddressMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetUserAddressMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetUserAddressMode(ctx, req.(*UserSplitModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetUserReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserReadOnlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetUserReadOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetUserReadOnly_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetUserReadOnly(ctx, req.(*UserReadOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetUserStrictSplitMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserStrictSplitModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetUserStrictSplitMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetUserStrictSplitMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetUserStrictSplitMode(ctx, req.(*UserStrictSplitModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_ListAppPasswords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).ListAppPasswords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_ListAppPasswords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).ListAppPasswords(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_CreateAppPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).CreateAppPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_CreateAppPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).CreateAppPassword(ctx, req.(*CreateAppPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_RevokeAppPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAppPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).RevokeAppPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_RevokeAppPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).RevokeAppPassword(ctx, req.(*RevokeAppPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_ListAddressGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).ListAddressGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_ListAddressGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).ListAddressGroups(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetAddressGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetAddressGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetAddressGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetAddressGroup(ctx, req.(*AddressGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_ResolveOAuthRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveOAuthRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).ResolveOAuthRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_ResolveOAuthRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).ResolveOAuthRequest(ctx, req.(*ResolveOAuthRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_ImportMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImportMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BridgeServer).ImportMessages(m, &bridgeImportMessagesServer{stream})
+}
+
+type Bridge_ImportMessagesServer interface {
+	Send(*ImportMessagesProgress) error
+	grpc.ServerStream
+}
+
+type bridgeImportMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *bridgeImportMessagesServer) Send(m *ImportMessagesProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bridge_ExportMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BridgeServer).ExportMessages(m, &bridgeExportMessagesServer{stream})
+}
+
+type Bridge_ExportMessagesServer interface {
+	Send(*ExportMessagesProgress) error
+	grpc.ServerStream
+}
+
+type bridgeExportMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *bridgeExportMessagesServer) Send(m *ExportMessagesProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bridge_GetExportSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).GetExportSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_GetExportSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).GetExportSchedule(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bridge_SetExportSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServer).SetExportSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bridge_SetExportSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServer).SetExportSchedule(ctx, req.(*ExportScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Bridge_ServiceDesc is the grpc.ServiceDesc for Bridge service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2152,6 +2932,14 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddLogEntry",
 			Handler:    _Bridge_AddLogEntry_Handler,
 		},
+		{
+			MethodName: "LogLevels",
+			Handler:    _Bridge_LogLevels_Handler,
+		},
+		{
+			MethodName: "SetLogLevels",
+			Handler:    _Bridge_SetLogLevels_Handler,
+		},
 		{
 			MethodName: "GuiReady",
 			Handler:    _Bridge_GuiReady_Handler,
@@ -2248,6 +3036,14 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportBug",
 			Handler:    _Bridge_ReportBug_Handler,
 		},
+		{
+			MethodName: "ExportDiagnostics",
+			Handler:    _Bridge_ExportDiagnostics_Handler,
+		},
+		{
+			MethodName: "RepairClientState",
+			Handler:    _Bridge_RepairClientState_Handler,
+		},
 		{
 			MethodName: "ForceLauncher",
 			Handler:    _Bridge_ForceLauncher_Handler,
@@ -2284,6 +3080,14 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InstallUpdate",
 			Handler:    _Bridge_InstallUpdate_Handler,
 		},
+		{
+			MethodName: "InstallUpdateFromFile",
+			Handler:    _Bridge_InstallUpdateFromFile_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _Bridge_Rollback_Handler,
+		},
 		{
 			MethodName: "SetIsAutomaticUpdateOn",
 			Handler:    _Bridge_SetIsAutomaticUpdateOn_Handler,
@@ -2384,6 +3188,62 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TriggerRepair",
 			Handler:    _Bridge_TriggerRepair_Handler,
 		},
+		{
+			MethodName: "Settings",
+			Handler:    _Bridge_Settings_Handler,
+		},
+		{
+			MethodName: "GetSetting",
+			Handler:    _Bridge_GetSetting_Handler,
+		},
+		{
+			MethodName: "SetSetting",
+			Handler:    _Bridge_SetSetting_Handler,
+		},
+		{
+			MethodName: "SetUserAddressMode",
+			Handler:    _Bridge_SetUserAddressMode_Handler,
+		},
+		{
+			MethodName: "SetUserReadOnly",
+			Handler:    _Bridge_SetUserReadOnly_Handler,
+		},
+		{
+			MethodName: "SetUserStrictSplitMode",
+			Handler:    _Bridge_SetUserStrictSplitMode_Handler,
+		},
+		{
+			MethodName: "ListAppPasswords",
+			Handler:    _Bridge_ListAppPasswords_Handler,
+		},
+		{
+			MethodName: "CreateAppPassword",
+			Handler:    _Bridge_CreateAppPassword_Handler,
+		},
+		{
+			MethodName: "RevokeAppPassword",
+			Handler:    _Bridge_RevokeAppPassword_Handler,
+		},
+		{
+			MethodName: "ListAddressGroups",
+			Handler:    _Bridge_ListAddressGroups_Handler,
+		},
+		{
+			MethodName: "SetAddressGroup",
+			Handler:    _Bridge_SetAddressGroup_Handler,
+		},
+		{
+			MethodName: "ResolveOAuthRequest",
+			Handler:    _Bridge_ResolveOAuthRequest_Handler,
+		},
+		{
+			MethodName: "GetExportSchedule",
+			Handler:    _Bridge_GetExportSchedule_Handler,
+		},
+		{
+			MethodName: "SetExportSchedule",
+			Handler:    _Bridge_SetExportSchedule_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2391,6 +3251,16 @@ var Bridge_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Bridge_RunEventStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ImportMessages",
+			Handler:       _Bridge_ImportMessages_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportMessages",
+			Handler:       _Bridge_ExportMessages_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "bridge.proto",
 }
@@ -0,0 +1,85 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// ErrServiceNotRunning is returned when no running bridge instance exposes the gRPC service.
+var ErrServiceNotRunning = errors.New("no running bridge instance with the gRPC service was found")
+
+const clientDialTimeout = 5 * time.Second
+
+// NewClient connects to the gRPC service of the bridge instance currently running for the given locations.
+// The connection must be closed by the caller.
+func NewClient(ctx context.Context, locations service.Locator) (BridgeClient, *grpc.ClientConn, error) {
+	settingsPath, err := locations.ProvideSettingsPath()
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get settings path: %w", err)
+	}
+
+	var config service.Config
+
+	if err := config.Load(filepath.Join(settingsPath, serverConfigFileName)); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrServiceNotRunning, err)
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM([]byte(config.Cert)) {
+		return nil, nil, fmt.Errorf("could not load the gRPC service certificate")
+	}
+
+	var target string
+	if len(config.FileSocketPath) != 0 {
+		target = "unix://" + config.FileSocketPath
+	} else {
+		target = fmt.Sprintf("%v:%d", constants.Host, config.Port)
+	}
+
+	dialCtx, cancel := context.WithTimeout(ctx, clientDialTimeout)
+	defer cancel()
+
+	// The config file is left behind when bridge exits, so a failure to connect means no instance is running.
+	conn, err := grpc.DialContext( //nolint:staticcheck
+		dialCtx,
+		target,
+		grpc.WithBlock(), //nolint:staticcheck
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{RootCAs: certPool, ServerName: constants.Host, MinVersion: tls.VersionTLS12})),
+		grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(metadata.AppendToOutgoingContext(ctx, serverTokenMetadataKey, config.Token), method, req, reply, cc, opts...)
+		}),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrServiceNotRunning, err)
+	}
+
+	return NewBridgeClient(conn), conn, nil
+}
@@ -241,6 +241,15 @@ func NewSyncProgressEvent(userID string, progress float64, elapsedMs, remainingM
 	}}})
 }
 
+func NewOAuthAuthorizationRequestedEvent(requestID, clientID, userID, email string) *StreamEvent {
+	return userEvent(&UserEvent{Event: &UserEvent_OauthAuthorizationRequested{OauthAuthorizationRequested: &OAuthAuthorizationRequestedEvent{
+		RequestID: requestID,
+		ClientID:  clientID,
+		UserID:    userID,
+		Email:     email,
+	}}})
+}
+
 func NewGenericErrorEvent(errorCode ErrorCode) *StreamEvent {
 	return genericErrorEvent(&GenericErrorEvent{Code: errorCode})
 }
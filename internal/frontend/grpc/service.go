@@ -324,12 +324,24 @@ func (s *Service) watchEvents() {
 		case events.AddressModeChanged:
 			_ = s.SendEvent(NewUserChangedEvent(event.UserID))
 
+		case events.UserReadOnlyChanged:
+			_ = s.SendEvent(NewUserChangedEvent(event.UserID))
+
+		case events.UserStrictSplitModeChanged:
+			_ = s.SendEvent(NewUserChangedEvent(event.UserID))
+
+		case events.AddressGroupsChanged:
+			_ = s.SendEvent(NewUserChangedEvent(event.UserID))
+
 		case events.UsedSpaceChanged:
 			_ = s.SendEvent(NewUsedBytesChangedEvent(event.UserID, event.UsedSpace))
 
 		case events.IMAPLoginFailed:
 			_ = s.SendEvent(newIMAPLoginFailedEvent(event.Username))
 
+		case events.OAuthAuthorizationRequested:
+			_ = s.SendEvent(NewOAuthAuthorizationRequestedEvent(event.RequestID, event.ClientID, event.UserID, event.Email))
+
 		case events.UserDeauth:
 			// This is the event the GUI cares about.
 			_ = s.SendEvent(NewUserChangedEvent(event.UserID))
@@ -0,0 +1,131 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/user"
+	"github.com/bradenaw/juniper/xslices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ExportMessages exports the messages of an account into a local folder, streaming the progress until the export is
+// finished or the caller goes away, the next export resuming where it stopped.
+func (s *Service) ExportMessages(req *ExportMessagesRequest, server Bridge_ExportMessagesServer) error {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", req.UserID).WithField("Dest", req.Dest).Info("ExportMessages")
+
+	report, err := s.bridge.ExportMessages(server.Context(), req.UserID, user.ExportOptions{
+		Format: user.ExportFormat(req.Format),
+		Dest:   req.Dest,
+	}, func(progress user.ExportProgress) {
+		if err := server.Send(&ExportMessagesProgress{
+			Total:    int64(progress.Total),
+			Exported: int64(progress.Exported),
+			Skipped:  int64(progress.Skipped),
+			Failed:   int64(progress.Failed),
+		}); err != nil {
+			s.log.WithError(err).Warn("Failed to send export progress")
+		}
+	})
+	if err != nil {
+		return exportStatusError(err)
+	}
+
+	return server.Send(&ExportMessagesProgress{
+		Total:    int64(report.Exported + report.Skipped + len(report.Failures)),
+		Exported: int64(report.Exported),
+		Skipped:  int64(report.Skipped),
+		Failed:   int64(len(report.Failures)),
+		Done:     true,
+		Failures: xslices.Map(report.Failures, func(failure user.ExportFailure) *ExportFailure {
+			return &ExportFailure{MessageID: failure.MessageID, Subject: failure.Subject, Error: failure.Error}
+		}),
+	})
+}
+
+// GetExportSchedule returns the periodic export of the messages of an account.
+func (s *Service) GetExportSchedule(_ context.Context, userID *wrapperspb.StringValue) (*ExportSchedule, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", userID.Value).Debug("GetExportSchedule")
+
+	schedule, err := s.bridge.GetExportSchedule(userID.Value)
+	if err != nil {
+		return nil, exportStatusError(err)
+	}
+
+	res := &ExportSchedule{
+		Format:          schedule.Format,
+		Dest:            schedule.Dest,
+		IntervalSeconds: int64(schedule.Interval / time.Second),
+		LastError:       schedule.LastError,
+	}
+
+	if schedule.IsEnabled() {
+		res.LastRun = schedule.LastRun.Unix()
+	}
+
+	return res, nil
+}
+
+// SetExportSchedule sets or removes the periodic export of the messages of an account.
+func (s *Service) SetExportSchedule(_ context.Context, req *ExportScheduleRequest) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", req.UserID).WithField("Dest", req.Dest).WithField("IntervalSeconds", req.IntervalSeconds).Info("SetExportSchedule")
+
+	if err := s.bridge.SetExportSchedule(
+		req.UserID,
+		user.ExportFormat(req.Format),
+		req.Dest,
+		time.Duration(req.IntervalSeconds)*time.Second,
+	); err != nil {
+		return nil, exportStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func exportStatusError(err error) error {
+	switch {
+	case errors.Is(err, bridge.ErrNoSuchUser):
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, bridge.ErrInvalidExportFormat),
+		errors.Is(err, bridge.ErrInvalidExportDest),
+		errors.Is(err, bridge.ErrInvalidExportInterval),
+		errors.Is(err, bridge.ErrExportFormatMismatch):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, bridge.ErrExportInProgress):
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/user"
+	"github.com/bradenaw/juniper/xslices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ImportMessages imports local messages into an account, streaming the progress until the import is finished or the
+// caller goes away, the import being resumed by calling it again.
+func (s *Service) ImportMessages(req *ImportMessagesRequest, server Bridge_ImportMessagesServer) error {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", req.UserID).WithField("Source", req.Source).Info("ImportMessages")
+
+	report, err := s.bridge.ImportMessages(server.Context(), req.UserID, req.Source, bridge.ImportOptions{
+		Mapping:  req.Mapping,
+		AsLabels: req.AsLabels,
+		Address:  req.Address,
+		Restart:  req.Restart,
+	}, func(progress user.ImportProgress) {
+		if err := server.Send(&ImportMessagesProgress{
+			Imported: int64(progress.Imported),
+			Skipped:  int64(progress.Skipped),
+			Failed:   int64(progress.Failed),
+		}); err != nil {
+			s.log.WithError(err).Warn("Failed to send import progress")
+		}
+	})
+	if err != nil {
+		return importStatusError(err)
+	}
+
+	return server.Send(&ImportMessagesProgress{
+		Imported: int64(report.Imported),
+		Skipped:  int64(report.Skipped),
+		Failed:   int64(len(report.Failures)),
+		Done:     true,
+		Failures: xslices.Map(report.Failures, func(failure user.ImportFailure) *ImportFailure {
+			return &ImportFailure{Id: failure.ID, Folder: failure.Folder, Error: failure.Error}
+		}),
+	})
+}
+
+func importStatusError(err error) error {
+	switch {
+	case errors.Is(err, bridge.ErrNoSuchUser), errors.Is(err, fs.ErrNotExist):
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, bridge.ErrInvalidImportMailbox), errors.Is(err, bridge.ErrNoSuchAddress):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -21,21 +21,23 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"io/fs"
 	"runtime"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/gluon/async"
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
-	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
 	"github.com/ProtonMail/proton-bridge/v3/internal/events"
 	"github.com/ProtonMail/proton-bridge/v3/internal/frontend/theme"
 	"github.com/ProtonMail/proton-bridge/v3/internal/hv"
 	"github.com/ProtonMail/proton-bridge/v3/internal/kb"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
 	"github.com/ProtonMail/proton-bridge/v3/internal/safe"
 	"github.com/ProtonMail/proton-bridge/v3/internal/service"
 	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/ports"
+	"github.com/bradenaw/juniper/xslices"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -92,6 +94,25 @@ func (s *Service) AddLogEntry(_ context.Context, request *AddLogEntryRequest) (*
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) LogLevels(_ context.Context, _ *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.Debug("LogLevels")
+
+	return wrapperspb.String(logging.GetLevels()), nil
+}
+
+func (s *Service) SetLogLevels(_ context.Context, levels *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("levels", levels.Value).Debug("SetLogLevels")
+
+	if err := logging.SetLevels(levels.Value); err != nil {
+		s.log.WithError(err).Error("Failed to set log levels")
+		return nil, status.Errorf(codes.InvalidArgument, "failed to set log levels: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 // GuiReady implement the GuiReady gRPC service call.
 func (s *Service) GuiReady(_ context.Context, _ *emptypb.Empty) (*GuiReadyResponse, error) {
 	defer async.HandlePanic(s.panicHandler)
@@ -191,7 +212,7 @@ func (s *Service) SetIsBetaEnabled(_ context.Context, isEnabled *wrapperspb.Bool
 
 	if err := s.bridge.SetUpdateChannel(channel); err != nil {
 		s.log.WithError(err).Error("Failed to set update channel")
-		return nil, status.Errorf(codes.Internal, "failed to set update channel: %v", err)
+		return nil, policyStatusError(err, "failed to set update channel")
 	}
 
 	return &emptypb.Empty{}, nil
@@ -210,7 +231,7 @@ func (s *Service) SetIsAllMailVisible(_ context.Context, isVisible *wrapperspb.B
 
 	if err := s.bridge.SetShowAllMail(isVisible.Value); err != nil {
 		s.log.WithError(err).Error("Failed to set show all mail")
-		return nil, status.Errorf(codes.Internal, "failed to set show all mail: %v", err)
+		return nil, policyStatusError(err, "failed to set show all mail")
 	}
 
 	return &emptypb.Empty{}, nil
@@ -229,7 +250,7 @@ func (s *Service) SetIsTelemetryDisabled(_ context.Context, isDisabled *wrappers
 
 	if err := s.bridge.SetTelemetryDisabled(isDisabled.Value); err != nil {
 		s.log.WithError(err).Error("Failed to set telemetry status")
-		return nil, status.Errorf(codes.Internal, "failed to set telemetry status: %v", err)
+		return nil, policyStatusError(err, "failed to set telemetry status")
 	}
 
 	return &emptypb.Empty{}, nil
@@ -405,6 +426,53 @@ func (s *Service) ReportBug(_ context.Context, report *ReportBugRequest) (*empty
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) ExportDiagnostics(ctx context.Context, req *ExportDiagnosticsRequest) (*wrapperspb.StringValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithFields(logrus.Fields{
+		"folderPath":            req.FolderPath,
+		"includeClientState":    req.IncludeClientState,
+		"includeFailedMessages": req.IncludeFailedMessages,
+	}).Info("ExportDiagnostics")
+
+	path, err := s.bridge.ExportDiagnostics(ctx, req.FolderPath, bridge.DiagnosticsReq{
+		IncludeClientState:    req.IncludeClientState,
+		IncludeFailedMessages: req.IncludeFailedMessages,
+	}, nil)
+	if err != nil {
+		s.log.WithError(err).Error("Failed to export diagnostics")
+		return nil, status.Errorf(codes.Internal, "failed to export diagnostics: %v", err)
+	}
+
+	return wrapperspb.String(path), nil
+}
+
+func (s *Service) RepairClientState(ctx context.Context, req *RepairClientStateRequest) (*RepairClientStateResponse, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("dryRun", req.DryRun).Info("RepairClientState")
+
+	report, err := s.bridge.RepairClientState(ctx, req.DryRun, nil)
+	if err != nil {
+		s.log.WithError(err).Error("Failed to repair client state")
+		return nil, status.Errorf(codes.Internal, "failed to repair client state: %v", err)
+	}
+
+	return &RepairClientStateResponse{
+		DryRun: report.DryRun,
+		Divergences: xslices.Map(report.Divergences, func(d bridge.ClientStateDivergence) *ClientStateDivergence {
+			return &ClientStateDivergence{
+				UserID:    d.UserID,
+				Account:   d.Account,
+				MessageID: d.MessageID,
+				Kind:      string(d.Kind),
+				Expected:  d.Expected,
+				Actual:    d.Actual,
+			}
+		}),
+		RepairedMessageIDs: report.Repaired,
+		FailedMessages:     report.Failed,
+	}, nil
+}
+
 func (s *Service) ForceLauncher(_ context.Context, launcher *wrapperspb.StringValue) (*emptypb.Empty, error) {
 	defer async.HandlePanic(s.panicHandler)
 
@@ -642,6 +710,69 @@ func (s *Service) InstallUpdate(_ context.Context, _ *emptypb.Empty) (*emptypb.E
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) InstallUpdateFromFile(ctx context.Context, req *InstallUpdateFromFileRequest) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("package", req.PackagePath).WithField("version", req.Version).Info("InstallUpdateFromFile")
+
+	var expected *semver.Version
+
+	if req.Version != "" {
+		version, err := semver.StrictNewVersion(req.Version)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid version: %v", err)
+		}
+
+		expected = version
+	}
+
+	sigPath := req.SignaturePath
+	if sigPath == "" {
+		sigPath = req.PackagePath + ".sig"
+	}
+
+	if err := s.bridge.InstallUpdateFromFile(req.PackagePath, sigPath, expected); err != nil {
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			return nil, status.Error(codes.NotFound, err.Error())
+
+		case errors.Is(err, bridge.ErrUpdateNotNewer), errors.Is(err, bridge.ErrUpdateVersionMismatch):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+
+		case errors.Is(err, bridge.ErrUpdateNotAllowed):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+
+		case errors.Is(err, updater.ErrPackageVerify):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if req.Restart {
+		s.restarter.Set(true, false)
+		return s.Quit(ctx, &emptypb.Empty{})
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) Rollback(ctx context.Context, empty *emptypb.Empty) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.Warn("Rollback")
+
+	if err := s.bridge.Rollback(); err != nil {
+		if errors.Is(err, updater.ErrNoRollbackVersion) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.restarter.Set(true, false)
+	return s.Quit(ctx, empty)
+}
+
 func (s *Service) SetIsAutomaticUpdateOn(_ context.Context, isOn *wrapperspb.BoolValue) (*emptypb.Empty, error) {
 	defer async.HandlePanic(s.panicHandler)
 
@@ -653,7 +784,7 @@ func (s *Service) SetIsAutomaticUpdateOn(_ context.Context, isOn *wrapperspb.Boo
 
 	if err := s.bridge.SetAutoUpdate(isOn.Value); err != nil {
 		s.log.WithError(err).Error("Failed to set auto update")
-		return nil, status.Errorf(codes.Internal, "failed to set auto update: %v", err)
+		return nil, policyStatusError(err, "failed to set auto update")
 	}
 
 	return &emptypb.Empty{}, nil
@@ -713,7 +844,7 @@ func (s *Service) SetIsDoHEnabled(_ context.Context, isEnabled *wrapperspb.BoolV
 
 	if err := s.bridge.SetProxyAllowed(isEnabled.Value); err != nil {
 		s.log.WithError(err).Error("Failed to set DoH")
-		return nil, status.Errorf(codes.Internal, "failed to set DoH: %v", err)
+		return nil, policyStatusError(err, "failed to set DoH")
 	}
 
 	return &emptypb.Empty{}, nil
@@ -797,7 +928,7 @@ func (s *Service) Hostname(_ context.Context, _ *emptypb.Empty) (*wrapperspb.Str
 
 	s.log.Debug("Hostname")
 
-	return wrapperspb.String(constants.Host), nil
+	return wrapperspb.String(s.bridge.GetHost()), nil
 }
 
 func (s *Service) IsPortFree(_ context.Context, port *wrapperspb.Int32Value) (*wrapperspb.BoolValue, error) {
@@ -870,6 +1001,82 @@ func (s *Service) TriggerRepair(_ context.Context, _ *emptypb.Empty) (*emptypb.E
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) Settings(_ context.Context, _ *emptypb.Empty) (*SettingsResponse, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.Debug("Settings")
+
+	settings := make(map[string]string)
+
+	for _, name := range bridge.GetSettingNames() {
+		value, err := s.bridge.GetSetting(name)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get setting %v: %v", name, err)
+		}
+
+		settings[name] = value
+	}
+
+	return &SettingsResponse{Settings: settings, LockedSettings: s.bridge.GetLockedSettings()}, nil
+}
+
+func (s *Service) GetSetting(_ context.Context, name *wrapperspb.StringValue) (*wrapperspb.StringValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("name", name.Value).Debug("GetSetting")
+
+	value, err := s.bridge.GetSetting(name.Value)
+	if err != nil {
+		return nil, settingStatusError(err)
+	}
+
+	return wrapperspb.String(value), nil
+}
+
+func (s *Service) SetSetting(ctx context.Context, req *SetSettingRequest) (*wrapperspb.BoolValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("name", req.Name).WithField("value", req.Value).Info("SetSetting")
+
+	changed, err := s.bridge.SetSetting(ctx, req.Name, req.Value)
+	if err != nil {
+		s.log.WithError(err).WithField("name", req.Name).Error("Failed to set setting")
+		return nil, settingStatusError(err)
+	}
+
+	// Let the GUI, if any, refresh the mail server settings it displays.
+	if changed {
+		switch req.Name {
+		case "imap-port", "smtp-port", "imap-ssl", "smtp-ssl":
+			_ = s.SendEvent(NewMailServerSettingsChangedEvent(s.getMailServerSettings()))
+		}
+	}
+
+	return wrapperspb.Bool(changed), nil
+}
+
+func settingStatusError(err error) error {
+	switch {
+	case errors.Is(err, bridge.ErrManagedByPolicy):
+		return status.Error(codes.PermissionDenied, err.Error())
+
+	case errors.Is(err, bridge.ErrUnknownSetting):
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, bridge.ErrInvalidSettingValue):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// policyStatusError maps the failure to change a setting to a status, denying the settings locked by the policy.
+func policyStatusError(err error, msg string) error {
+	if errors.Is(err, bridge.ErrManagedByPolicy) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return status.Errorf(codes.Internal, "%v: %v", msg, err)
+}
+
 func base64Decode(in []byte) ([]byte, error) {
 	out := make([]byte, base64.StdEncoding.DecodedLen(len(in)))
 
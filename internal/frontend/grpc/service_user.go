@@ -19,8 +19,10 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -99,6 +101,86 @@ func (s *Service) SetUserSplitMode(_ context.Context, splitMode *UserSplitModeRe
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) SetUserAddressMode(ctx context.Context, req *UserSplitModeRequest) (*wrapperspb.BoolValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", req.UserID).WithField("Active", req.Active).Info("SetUserAddressMode")
+
+	user, err := s.bridge.GetUserInfo(req.UserID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found %v", req.UserID)
+	}
+
+	targetMode := vault.CombinedMode
+	if req.Active {
+		targetMode = vault.SplitMode
+	}
+
+	if user.AddressMode == targetMode {
+		return wrapperspb.Bool(false), nil
+	}
+
+	if err := s.bridge.SetAddressMode(ctx, user.UserID, targetMode); err != nil {
+		s.log.WithError(err).Error("Failed to set address mode")
+		return nil, status.Errorf(codes.Internal, "failed to set address mode: %v", err)
+	}
+
+	_ = s.SendEvent(NewUserToggleSplitModeFinishedEvent(req.UserID))
+
+	return wrapperspb.Bool(true), nil
+}
+
+func (s *Service) SetUserReadOnly(_ context.Context, req *UserReadOnlyRequest) (*wrapperspb.BoolValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", req.UserID).WithField("ReadOnly", req.ReadOnly).Info("SetUserReadOnly")
+
+	user, err := s.bridge.GetUserInfo(req.UserID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found %v", req.UserID)
+	}
+
+	if user.ReadOnly == req.ReadOnly {
+		return wrapperspb.Bool(false), nil
+	}
+
+	if err := s.bridge.SetUserReadOnly(user.UserID, req.ReadOnly); err != nil {
+		s.log.WithError(err).Error("Failed to set read-only mode")
+
+		if errors.Is(err, bridge.ErrNoSuchUser) {
+			return nil, status.Errorf(codes.FailedPrecondition, "user is not connected %v", req.UserID)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to set read-only mode: %v", err)
+	}
+
+	return wrapperspb.Bool(true), nil
+}
+
+func (s *Service) SetUserStrictSplitMode(_ context.Context, req *UserStrictSplitModeRequest) (*wrapperspb.BoolValue, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", req.UserID).WithField("StrictSplitMode", req.StrictSplitMode).Info("SetUserStrictSplitMode")
+
+	user, err := s.bridge.GetUserInfo(req.UserID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found %v", req.UserID)
+	}
+
+	if user.StrictSplitMode == req.StrictSplitMode {
+		return wrapperspb.Bool(false), nil
+	}
+
+	if err := s.bridge.SetUserStrictSplitMode(user.UserID, req.StrictSplitMode); err != nil {
+		s.log.WithError(err).Error("Failed to set strict split mode")
+
+		if errors.Is(err, bridge.ErrNoSuchUser) {
+			return nil, status.Errorf(codes.FailedPrecondition, "user is not connected %v", req.UserID)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to set strict split mode: %v", err)
+	}
+
+	return wrapperspb.Bool(true), nil
+}
+
 func (s *Service) SendBadEventUserFeedback(_ context.Context, feedback *UserBadEventFeedbackRequest) (*emptypb.Empty, error) {
 	defer async.HandlePanic(s.panicHandler)
 	l := s.log.WithField("UserID", feedback.UserID).WithField("doResync", feedback.DoResync)
@@ -171,3 +253,126 @@ func (s *Service) ConfigureUserAppleMail(ctx context.Context, request *Configure
 
 	return &emptypb.Empty{}, nil
 }
+
+func (s *Service) ListAppPasswords(_ context.Context, userID *wrapperspb.StringValue) (*AppPasswordListResponse, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", userID.Value).Debug("ListAppPasswords")
+
+	appPasswords, err := s.bridge.GetAppPasswords(userID.Value)
+	if err != nil {
+		return nil, appPasswordStatusError(err)
+	}
+
+	res := &AppPasswordListResponse{AppPasswords: make([]*AppPassword, 0, len(appPasswords))}
+
+	for _, appPassword := range appPasswords {
+		res.AppPasswords = append(res.AppPasswords, grpcAppPasswordFromInfo(appPassword))
+	}
+
+	return res, nil
+}
+
+func (s *Service) CreateAppPassword(_ context.Context, req *CreateAppPasswordRequest) (*CreateAppPasswordResponse, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", req.UserID).WithField("Scope", req.Scope).Info("CreateAppPassword")
+
+	appPassword, password, err := s.bridge.CreateAppPassword(req.UserID, req.Name, vault.AppPasswordScope(req.Scope))
+	if err != nil {
+		return nil, appPasswordStatusError(err)
+	}
+
+	return &CreateAppPasswordResponse{AppPassword: grpcAppPasswordFromInfo(appPassword), Password: password}, nil
+}
+
+func (s *Service) RevokeAppPassword(_ context.Context, req *RevokeAppPasswordRequest) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", req.UserID).WithField("AppPasswordID", req.AppPasswordID).Info("RevokeAppPassword")
+
+	if err := s.bridge.RevokeAppPassword(req.UserID, req.AppPasswordID); err != nil {
+		return nil, appPasswordStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) ListAddressGroups(_ context.Context, userID *wrapperspb.StringValue) (*AddressGroupListResponse, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", userID.Value).Debug("ListAddressGroups")
+
+	groups, err := s.bridge.GetAddressGroups(userID.Value)
+	if err != nil {
+		return nil, addressGroupStatusError(err)
+	}
+
+	res := &AddressGroupListResponse{AddressGroups: make([]*AddressGroup, 0, len(groups))}
+
+	for _, group := range groups {
+		res.AddressGroups = append(res.AddressGroups, &AddressGroup{Name: group.Name, Addresses: group.Addresses})
+	}
+
+	return res, nil
+}
+
+func (s *Service) SetAddressGroup(ctx context.Context, req *AddressGroupRequest) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("UserID", req.UserID).WithField("Name", req.Name).Info("SetAddressGroup")
+
+	var err error
+
+	if len(req.Addresses) == 0 {
+		err = s.bridge.RemoveAddressGroup(ctx, req.UserID, req.Name)
+	} else {
+		err = s.bridge.SetAddressGroup(ctx, req.UserID, req.Name, req.Addresses)
+	}
+
+	if err != nil {
+		return nil, addressGroupStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) ResolveOAuthRequest(_ context.Context, req *ResolveOAuthRequestRequest) (*emptypb.Empty, error) {
+	defer async.HandlePanic(s.panicHandler)
+	s.log.WithField("RequestID", req.RequestID).WithField("Allow", req.Allow).Info("ResolveOAuthRequest")
+
+	if err := s.bridge.ResolveOAuthRequest(req.RequestID, req.Allow); err != nil {
+		if errors.Is(err, bridge.ErrNoSuchOAuthRequest) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func appPasswordStatusError(err error) error {
+	switch {
+	case errors.Is(err, bridge.ErrNoSuchUser), errors.Is(err, bridge.ErrNoSuchAppPassword):
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, bridge.ErrInvalidAppPasswordName),
+		errors.Is(err, bridge.ErrInvalidAppPasswordScope),
+		errors.Is(err, bridge.ErrAppPasswordNameTaken):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func addressGroupStatusError(err error) error {
+	switch {
+	case errors.Is(err, bridge.ErrNoSuchUser), errors.Is(err, bridge.ErrNoSuchAddressGroup):
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, bridge.ErrInvalidAddressGroupName),
+		errors.Is(err, bridge.ErrNoSuchAddress),
+		errors.Is(err, bridge.ErrAddressAlreadyGrouped):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -66,15 +66,34 @@ func getInitials(fullName string) string {
 // grpcUserFromInfo converts a bridge user to a gRPC user.
 func grpcUserFromInfo(user bridge.UserInfo) *User {
 	return &User{
-		Id:         user.UserID,
-		Username:   user.Username,
-		AvatarText: getInitials(user.Username),
-		State:      userStateToGrpc(user.State),
-		SplitMode:  user.AddressMode == vault.SplitMode,
-		UsedBytes:  int64(user.UsedSpace), //nolint:gosec // disable G115
-		TotalBytes: int64(user.MaxSpace),  //nolint:gosec // disable G115
-		Password:   user.BridgePass,
-		Addresses:  user.Addresses,
+		Id:              user.UserID,
+		Username:        user.Username,
+		AvatarText:      getInitials(user.Username),
+		State:           userStateToGrpc(user.State),
+		SplitMode:       user.AddressMode == vault.SplitMode,
+		UsedBytes:       int64(user.UsedSpace), //nolint:gosec // disable G115
+		TotalBytes:      int64(user.MaxSpace),  //nolint:gosec // disable G115
+		Password:        user.BridgePass,
+		Addresses:       user.Addresses,
+		ReadOnly:        user.ReadOnly,
+		StrictSplitMode: user.StrictSplitMode,
+	}
+}
+
+// grpcAppPasswordFromInfo converts a bridge app password to a gRPC app password.
+func grpcAppPasswordFromInfo(appPassword bridge.AppPasswordInfo) *AppPassword {
+	var lastUsedAt int64
+
+	if !appPassword.LastUsedAt.IsZero() {
+		lastUsedAt = appPassword.LastUsedAt.Unix()
+	}
+
+	return &AppPassword{
+		Id:         appPassword.ID,
+		Name:       appPassword.Name,
+		Scope:      string(appPassword.Scope),
+		CreatedAt:  appPassword.CreatedAt.Unix(),
+		LastUsedAt: lastUsedAt,
 	}
 }
 
@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package notifier
+
+import (
+	"fmt"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/godbus/dbus"
+)
+
+const (
+	dbusNotificationsName        = "org.freedesktop.Notifications"
+	dbusNotificationsPath        = "/org/freedesktop/Notifications"
+	dbusNotifyMethod             = dbusNotificationsName + ".Notify"
+	dbusActionInvokedSignal      = dbusNotificationsName + ".ActionInvoked"
+	dbusNotificationClosedSignal = dbusNotificationsName + ".NotificationClosed"
+
+	// dbusDefaultTimeout lets the notification server decide when notifications expire.
+	dbusDefaultTimeout = int32(-1)
+)
+
+// dbusPoster posts notifications to the org.freedesktop.Notifications service of the session bus.
+type dbusPoster struct {
+	conn  *dbus.Conn
+	resCh chan Response
+}
+
+func newDBusPoster() (Poster, error) {
+	// A private connection is used so that closing it does not affect other users of the session bus.
+	conn, err := dbus.SessionBusPrivate()
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to the session bus: %w", err)
+	}
+
+	if err := conn.Auth(nil); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("could not authenticate to the session bus: %w", err)
+	}
+
+	if err := conn.Hello(); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("could not register to the session bus: %w", err)
+	}
+
+	rule := fmt.Sprintf("type='signal',path='%v',interface='%v'", dbusNotificationsPath, dbusNotificationsName)
+
+	if call := conn.BusObject().Call("org.freedesktop.DBus.AddMatch", 0, rule); call.Err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("could not subscribe to notification signals: %w", call.Err)
+	}
+
+	signalCh := make(chan *dbus.Signal, 16)
+	conn.Signal(signalCh)
+
+	poster := &dbusPoster{
+		conn:  conn,
+		resCh: make(chan Response),
+	}
+
+	go poster.forward(signalCh)
+
+	return poster, nil
+}
+
+func (p *dbusPoster) Post(notification Notification) (uint32, error) {
+	actions := make([]string, 0, 2*len(notification.Actions))
+
+	for _, action := range notification.Actions {
+		actions = append(actions, action.Key, action.Label)
+	}
+
+	var id uint32
+
+	if err := p.conn.Object(dbusNotificationsName, dbusNotificationsPath).Call(
+		dbusNotifyMethod,
+		0,
+		constants.FullAppName,
+		uint32(0),
+		"",
+		notification.Summary,
+		notification.Body,
+		actions,
+		map[string]dbus.Variant{},
+		dbusDefaultTimeout,
+	).Store(&id); err != nil {
+		return 0, fmt.Errorf("could not post notification: %w", err)
+	}
+
+	return id, nil
+}
+
+func (p *dbusPoster) Responses() <-chan Response {
+	return p.resCh
+}
+
+func (p *dbusPoster) Close() error {
+	return p.conn.Close()
+}
+
+// forward converts the notification signals to responses until the connection is closed.
+func (p *dbusPoster) forward(signalCh <-chan *dbus.Signal) {
+	defer close(p.resCh)
+
+	for signal := range signalCh {
+		var res Response
+
+		switch signal.Name {
+		case dbusActionInvokedSignal:
+			if err := dbus.Store(signal.Body, &res.ID, &res.Action); err != nil {
+				continue
+			}
+
+		case dbusNotificationClosedSignal:
+			var reason uint32
+
+			if err := dbus.Store(signal.Body, &res.ID, &reason); err != nil {
+				continue
+			}
+
+		default:
+			continue
+		}
+
+		p.resCh <- res
+	}
+}
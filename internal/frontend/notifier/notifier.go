@@ -0,0 +1,353 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package notifier raises desktop notifications for the bridge events requiring the attention of the user when no
+// GUI is running, i.e. in CLI and non-interactive mode.
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// minInterval is the minimum time between two notifications of the same event for the same user.
+	minInterval = 10 * time.Minute
+
+	// burstWindow and burstLimit cap the number of notifications raised over a short period of time.
+	burstWindow = time.Minute
+	burstLimit  = 3
+)
+
+const (
+	actionResync  = "resync"
+	actionLogout  = "logout"
+	actionRepair  = "repair"
+	actionInstall = "install"
+)
+
+var log = logrus.WithField("pkg", "frontend/notifier") //nolint:gochecknoglobals
+
+// Bridge is the part of the bridge used by the notifier.
+type Bridge interface {
+	IsDesktopNotificationEnabled(name string) bool
+	GetUserInfo(userID string) (bridge.UserInfo, error)
+	SendBadEventUserFeedback(ctx context.Context, userID string, doResync bool) error
+	Repair()
+	InstallUpdate(release updater.Release)
+	InstallUpdateLegacy(version updater.VersionInfoLegacy)
+}
+
+// Action is a button of a notification.
+type Action struct {
+	Key   string
+	Label string
+}
+
+// Notification is a desktop notification.
+type Notification struct {
+	Summary string
+	Body    string
+	Actions []Action
+}
+
+// Response is sent by a poster when the user clicks an action of a notification, or when it is closed,
+// in which case Action is empty.
+type Response struct {
+	ID     uint32
+	Action string
+}
+
+// Poster displays desktop notifications.
+type Poster interface {
+	Post(notification Notification) (uint32, error)
+	Responses() <-chan Response
+	Close() error
+}
+
+// Events returns the events the notifier handles, to be given to bridge.GetEvents.
+func Events() []events.Event {
+	return []events.Event{
+		events.UserDeauth{},
+		events.UserBadEvent{},
+		events.IMAPLoginFailed{},
+		events.TLSIssue{},
+		events.UpdateAvailable{},
+		events.SyncFailed{},
+	}
+}
+
+type Notifier struct {
+	bridge       Bridge
+	panicHandler async.PanicHandler
+
+	newPoster func() (Poster, error)
+	poster    Poster
+
+	// callbacks holds the actions of the notifications currently displayed, by notification ID.
+	callbacks map[uint32]map[string]func()
+
+	now    func() time.Time
+	last   map[string]time.Time
+	recent []time.Time
+}
+
+func New(bridge Bridge, panicHandler async.PanicHandler) *Notifier {
+	return &Notifier{
+		bridge:       bridge,
+		panicHandler: panicHandler,
+
+		newPoster: newDBusPoster,
+
+		callbacks: make(map[uint32]map[string]func()),
+
+		now:  time.Now,
+		last: make(map[string]time.Time),
+	}
+}
+
+// Run raises notifications for the events of eventCh until it is closed.
+func (n *Notifier) Run(eventCh <-chan events.Event) {
+	defer async.HandlePanic(n.panicHandler)
+
+	defer func() {
+		if n.poster == nil {
+			return
+		}
+
+		if err := n.poster.Close(); err != nil {
+			log.WithError(err).Warn("Failed to close the notification poster")
+		}
+	}()
+
+	for {
+		select {
+		case event, ok := <-eventCh:
+			if !ok {
+				return
+			}
+
+			n.handleEvent(event)
+
+		case res, ok := <-n.responses():
+			if !ok {
+				// The poster lost its connection, a new one is created for the next notification.
+				n.poster = nil
+				n.callbacks = make(map[uint32]map[string]func())
+
+				continue
+			}
+
+			n.handleResponse(res)
+		}
+	}
+}
+
+// responses returns the responses of the poster, if any. Receiving from the nil channel blocks forever.
+func (n *Notifier) responses() <-chan Response {
+	if n.poster == nil {
+		return nil
+	}
+
+	return n.poster.Responses()
+}
+
+func (n *Notifier) handleEvent(event events.Event) {
+	name, key, notification, callbacks := n.describe(event)
+	if name == "" {
+		return
+	}
+
+	if !n.bridge.IsDesktopNotificationEnabled(name) {
+		return
+	}
+
+	if !n.allow(name + "/" + key) {
+		log.WithField("event", name).Debug("Notification rate limited")
+		return
+	}
+
+	if n.poster == nil {
+		poster, err := n.newPoster()
+		if err != nil {
+			log.WithError(err).Warn("Desktop notifications are not available")
+			return
+		}
+
+		n.poster = poster
+	}
+
+	id, err := n.poster.Post(notification)
+	if err != nil {
+		log.WithError(err).WithField("event", name).Warn("Failed to post notification")
+		return
+	}
+
+	if len(callbacks) > 0 {
+		n.callbacks[id] = callbacks
+	}
+}
+
+func (n *Notifier) handleResponse(res Response) {
+	callbacks, ok := n.callbacks[res.ID]
+	if !ok {
+		return
+	}
+
+	delete(n.callbacks, res.ID)
+
+	if callback, ok := callbacks[res.Action]; ok {
+		log.WithField("action", res.Action).Info("Running notification action")
+
+		go func() {
+			defer async.HandlePanic(n.panicHandler)
+
+			callback()
+		}()
+	}
+}
+
+// allow returns whether a notification with the given key can be raised now, and records it if so.
+func (n *Notifier) allow(key string) bool {
+	now := n.now()
+
+	if last, ok := n.last[key]; ok && now.Sub(last) < minInterval {
+		return false
+	}
+
+	recent := n.recent[:0]
+
+	for _, t := range n.recent {
+		if now.Sub(t) < burstWindow {
+			recent = append(recent, t)
+		}
+	}
+
+	n.recent = recent
+
+	if len(n.recent) >= burstLimit {
+		return false
+	}
+
+	n.last[key] = now
+	n.recent = append(n.recent, now)
+
+	return true
+}
+
+// describe returns the name of the event, the key identifying its subject for rate limiting, the notification
+// to raise and the callbacks of its actions. The name is empty for events which are not notified.
+func (n *Notifier) describe(event events.Event) (string, string, Notification, map[string]func()) {
+	switch event := event.(type) {
+	case events.UserDeauth:
+		return bridge.DesktopNotificationUserDeauth, event.UserID, Notification{
+			Summary: "Account signed out",
+			Body:    fmt.Sprintf("%v was signed out, log in again to resume syncing its mail.", n.username(event.UserID)),
+		}, nil
+
+	case events.UserBadEvent:
+		return bridge.DesktopNotificationUserBadEvent, event.UserID, Notification{
+			Summary: "Internal error",
+			Body: fmt.Sprintf(
+				"%v could not process an update from the server. Resync the account, or log out and log in again.",
+				n.username(event.UserID),
+			),
+			Actions: []Action{{Key: actionResync, Label: "Resync"}, {Key: actionLogout, Label: "Log out"}},
+		}, map[string]func(){
+			actionResync: func() { n.sendBadEventFeedback(event.UserID, true) },
+			actionLogout: func() { n.sendBadEventFeedback(event.UserID, false) },
+		}
+
+	case events.IMAPLoginFailed:
+		return bridge.DesktopNotificationIMAPLoginFailed, event.Username, Notification{
+			Summary: "Email client login failed",
+			Body: fmt.Sprintf(
+				"An email client failed to log in as %v. Make sure it uses the bridge password, not the account password.",
+				event.Username,
+			),
+		}, nil
+
+	case events.TLSIssue:
+		return bridge.DesktopNotificationTLSIssue, "", Notification{
+			Summary: "Unable to establish a secure connection",
+			Body:    fmt.Sprintf("%v cannot verify the identity of the server. Your network may be monitored.", constants.FullAppName),
+		}, nil
+
+	case events.UpdateAvailable:
+		if event.Silent {
+			return "", "", Notification{}, nil
+		}
+
+		notification := Notification{
+			Summary: "Update available",
+			Body:    fmt.Sprintf("%v %v is available.", constants.FullAppName, event.GetLatestVersion()),
+		}
+
+		if !event.Compatible {
+			notification.Body += " It must be installed manually."
+
+			return bridge.DesktopNotificationUpdateAvailable, event.GetLatestVersion(), notification, nil
+		}
+
+		notification.Actions = []Action{{Key: actionInstall, Label: "Install"}}
+
+		return bridge.DesktopNotificationUpdateAvailable, event.GetLatestVersion(), notification, map[string]func(){
+			actionInstall: func() {
+				if !event.Release.IsEmpty() {
+					n.bridge.InstallUpdate(event.Release)
+				} else {
+					n.bridge.InstallUpdateLegacy(event.VersionLegacy)
+				}
+			},
+		}
+
+	case events.SyncFailed:
+		return bridge.DesktopNotificationSyncFailed, event.UserID, Notification{
+			Summary: "Synchronization failed",
+			Body:    fmt.Sprintf("%v could not be synchronized. Repairing bridge will resynchronize all accounts.", n.username(event.UserID)),
+			Actions: []Action{{Key: actionRepair, Label: "Repair"}},
+		}, map[string]func(){
+			actionRepair: n.bridge.Repair,
+		}
+
+	default:
+		return "", "", Notification{}, nil
+	}
+}
+
+func (n *Notifier) username(userID string) string {
+	info, err := n.bridge.GetUserInfo(userID)
+	if err != nil {
+		return "Your account"
+	}
+
+	return info.Username
+}
+
+func (n *Notifier) sendBadEventFeedback(userID string, doResync bool) {
+	if err := n.bridge.SendBadEventUserFeedback(context.Background(), userID, doResync); err != nil {
+		log.WithError(err).Error("Failed to send bad event feedback")
+	}
+}
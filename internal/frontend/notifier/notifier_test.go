@@ -0,0 +1,181 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/bridge"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBridge struct {
+	enabled []string
+
+	repairCh   chan struct{}
+	feedbackCh chan bool
+}
+
+func (b *fakeBridge) IsDesktopNotificationEnabled(name string) bool {
+	for _, enabled := range b.enabled {
+		if enabled == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *fakeBridge) GetUserInfo(userID string) (bridge.UserInfo, error) {
+	if userID != "userID" {
+		return bridge.UserInfo{}, bridge.ErrNoSuchUser
+	}
+
+	return bridge.UserInfo{UserID: userID, Username: "username"}, nil
+}
+
+func (b *fakeBridge) SendBadEventUserFeedback(_ context.Context, _ string, doResync bool) error {
+	b.feedbackCh <- doResync
+	return nil
+}
+
+func (b *fakeBridge) Repair() {
+	b.repairCh <- struct{}{}
+}
+
+func (b *fakeBridge) InstallUpdate(updater.Release) {}
+
+func (b *fakeBridge) InstallUpdateLegacy(updater.VersionInfoLegacy) {}
+
+type fakePoster struct {
+	posted []Notification
+	resCh  chan Response
+}
+
+func (p *fakePoster) Post(notification Notification) (uint32, error) {
+	p.posted = append(p.posted, notification)
+	return uint32(len(p.posted)), nil
+}
+
+func (p *fakePoster) Responses() <-chan Response {
+	return p.resCh
+}
+
+func (p *fakePoster) Close() error {
+	return nil
+}
+
+func newTestNotifier(b Bridge, poster *fakePoster, now *time.Time) *Notifier {
+	n := New(b, async.NoopPanicHandler{})
+	n.newPoster = func() (Poster, error) { return poster, nil }
+	n.now = func() time.Time { return *now }
+
+	return n
+}
+
+func TestNotifier_EnabledEvents(t *testing.T) {
+	now := time.Now()
+	poster := &fakePoster{resCh: make(chan Response)}
+	n := newTestNotifier(&fakeBridge{enabled: []string{bridge.DesktopNotificationUserDeauth}}, poster, &now)
+
+	n.handleEvent(events.SyncFailed{UserID: "userID"})
+	require.Empty(t, poster.posted)
+
+	n.handleEvent(events.UserDeauth{UserID: "userID"})
+	require.Len(t, poster.posted, 1)
+	require.Contains(t, poster.posted[0].Body, "username")
+
+	// Unknown users do not prevent the notification.
+	now = now.Add(minInterval)
+	n.handleEvent(events.UserDeauth{UserID: "otherID"})
+	require.Len(t, poster.posted, 2)
+}
+
+func TestNotifier_RateLimit(t *testing.T) {
+	now := time.Now()
+	poster := &fakePoster{resCh: make(chan Response)}
+	n := newTestNotifier(&fakeBridge{enabled: bridge.GetDesktopNotificationEventNames()}, poster, &now)
+
+	// The same event for the same user is notified only once per interval.
+	n.handleEvent(events.IMAPLoginFailed{Username: "a"})
+	n.handleEvent(events.IMAPLoginFailed{Username: "a"})
+	require.Len(t, poster.posted, 1)
+
+	now = now.Add(minInterval)
+	n.handleEvent(events.IMAPLoginFailed{Username: "a"})
+	require.Len(t, poster.posted, 2)
+
+	// Bursts of different events are capped.
+	for _, username := range []string{"b", "c", "d", "e"} {
+		n.handleEvent(events.IMAPLoginFailed{Username: username})
+	}
+
+	require.Len(t, poster.posted, 1+burstLimit)
+
+	now = now.Add(burstWindow)
+	n.handleEvent(events.IMAPLoginFailed{Username: "e"})
+	require.Len(t, poster.posted, 2+burstLimit)
+}
+
+func TestNotifier_Actions(t *testing.T) {
+	now := time.Now()
+	poster := &fakePoster{resCh: make(chan Response)}
+	b := &fakeBridge{
+		enabled:    bridge.GetDesktopNotificationEventNames(),
+		repairCh:   make(chan struct{}),
+		feedbackCh: make(chan bool),
+	}
+	n := newTestNotifier(b, poster, &now)
+
+	eventCh := make(chan events.Event)
+	doneCh := make(chan struct{})
+
+	go func() {
+		defer close(doneCh)
+		n.Run(eventCh)
+	}()
+
+	eventCh <- events.SyncFailed{UserID: "userID", Error: errors.New("failed")}
+	eventCh <- events.UserBadEvent{UserID: "userID"}
+
+	// Closing a notification drops its actions.
+	poster.resCh <- Response{ID: 1}
+	poster.resCh <- Response{ID: 1, Action: actionRepair}
+
+	poster.resCh <- Response{ID: 2, Action: actionLogout}
+	require.False(t, <-b.feedbackCh)
+
+	select {
+	case <-b.repairCh:
+		t.Fatal("the action of a closed notification was run")
+
+	default:
+	}
+
+	close(eventCh)
+	<-doneCh
+
+	require.Len(t, poster.posted, 2)
+	require.Equal(t, []Action{{Key: actionRepair, Label: "Repair"}}, poster.posted[0].Actions)
+}
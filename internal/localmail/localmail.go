@@ -0,0 +1,394 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+// Package localmail reads and writes the messages stored locally in mbox files, Maildir directories and folders of
+// .eml files.
+package localmail
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/gluon/rfc822"
+)
+
+// Message is a message read from a local source.
+type Message struct {
+	// ID identifies the message within its source. It does not change as long as messages are only added to the source.
+	ID string
+
+	// Folder is the path of the folder holding the message, e.g. `["Work", "Projects"]`.
+	Folder []string
+
+	Seen     bool
+	Answered bool
+	Flagged  bool
+	Draft    bool
+
+	// Date is when the message was delivered, zero if unknown.
+	Date time.Time
+
+	Literal []byte
+}
+
+// Walk calls fn for each message of the source at the given path, which is an mbox file, a Maildir directory or a
+// directory holding mbox files, Maildir directories and .eml files, possibly in subdirectories. The folder of a
+// message is the path of the file or directory holding it, relative to the source:
+//   - an mbox file is a folder named after the file, without its `.mbox` extension;
+//   - a Maildir directory is a folder named after the directory, the source itself being the `Inbox`, and its
+//     Maildir++ subfolders, e.g. `.Work.Projects`, are `Work/Projects`;
+//   - .eml files are in the folder of their directory, which is named after the source for the source itself.
+//
+// The messages are read in a stable order. Walk stops at the first error returned by fn.
+func Walk(root string, fn func(Message) error) error {
+	info, err := os.Stat(root)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		return walkMbox(root, filepath.Base(root), []string{trimMboxExt(filepath.Base(root))}, fn)
+	}
+
+	return (&walker{root: root, fn: fn}).walkDir(root, nil)
+}
+
+// ReadMbox calls fn for each message of the mbox content read from r. The IDs of the messages are their index in it.
+// The lines starting with `From ` quoted in the messages, as `>From `, are unquoted.
+func ReadMbox(r io.Reader, fn func(Message) error) error {
+	var (
+		br    = bufio.NewReader(r)
+		index int
+		buf   *bytes.Buffer
+		date  time.Time
+	)
+
+	flush := func() error {
+		if buf == nil {
+			return nil
+		}
+
+		msg := newMboxMessage(trimSeparator(buf.Bytes()))
+		msg.ID, msg.Date = strconv.Itoa(index), date
+		index++
+
+		return fn(msg)
+	}
+
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			switch {
+			case bytes.HasPrefix(line, []byte("From ")):
+				if err := flush(); err != nil {
+					return err
+				}
+
+				buf, date = new(bytes.Buffer), parseFromLineDate(line)
+
+			case buf != nil:
+				if unquoted := bytes.TrimLeft(line, ">"); len(unquoted) < len(line) && bytes.HasPrefix(unquoted, []byte("From ")) {
+					line = line[1:]
+				}
+
+				buf.Write(line)
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return flush()
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+type walker struct {
+	root string
+	fn   func(Message) error
+}
+
+// walkDir reads the messages of the given directory, whose folder is the given one, nil for the source itself.
+func (w *walker) walkDir(dir string, folder []string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	if isMaildir(entries) {
+		return w.walkMaildir(dir, folder, entries)
+	}
+
+	emlFolder := folder
+	if emlFolder == nil {
+		emlFolder = []string{filepath.Base(w.root)}
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") {
+			continue
+		}
+
+		entryPath := filepath.Join(dir, name)
+
+		switch {
+		case entry.IsDir():
+			// Thunderbird keeps the subfolders of the mbox file `name` in the directory `name.sbd`.
+			if err := w.walkDir(entryPath, appendFolder(folder, strings.TrimSuffix(name, ".sbd"))); err != nil {
+				return err
+			}
+
+		case strings.EqualFold(filepath.Ext(name), ".eml"):
+			if err := w.readFile(entryPath, emlFolder, true); err != nil {
+				return err
+			}
+
+		case isMbox(entryPath):
+			if err := walkMbox(entryPath, w.rel(entryPath), appendFolder(folder, trimMboxExt(name)), w.fn); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// walkMaildir reads the messages of a Maildir directory, those of its `new` subdirectory being unseen, then its
+// subfolders.
+func (w *walker) walkMaildir(dir string, folder []string, entries []fs.DirEntry) error {
+	msgFolder := folder
+	if msgFolder == nil {
+		msgFolder = []string{"Inbox"}
+	}
+
+	for _, sub := range []string{"new", "cur"} {
+		files, err := os.ReadDir(filepath.Join(dir, sub))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		for _, file := range files {
+			if file.IsDir() || strings.HasPrefix(file.Name(), ".") {
+				continue
+			}
+
+			if err := w.readFile(filepath.Join(dir, sub, file.Name()), msgFolder, sub == "cur"); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		switch {
+		case !entry.IsDir(), name == "new", name == "cur", name == "tmp":
+			continue
+
+		case strings.HasPrefix(name, "."):
+			// Maildir++ subfolder, e.g. `.Work.Projects`.
+			if err := w.walkDir(filepath.Join(dir, name), appendFolder(folder, strings.Split(name[1:], ".")...)); err != nil {
+				return err
+			}
+
+		default:
+			if err := w.walkDir(filepath.Join(dir, name), appendFolder(folder, name)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// readFile reads a message stored alone in a file. The flags of the messages stored in a Maildir `cur` directory are
+// given by their file name, the other messages are seen unless in a Maildir `new` directory.
+func (w *walker) readFile(filePath string, folder []string, seen bool) error {
+	literal, err := os.ReadFile(filePath) //nolint:gosec
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return err
+	}
+
+	msg := Message{
+		ID:      w.rel(filePath),
+		Folder:  folder,
+		Seen:    seen,
+		Date:    info.ModTime(),
+		Literal: literal,
+	}
+
+	if sub := filepath.Base(filepath.Dir(filePath)); sub == "cur" || sub == "new" {
+		name := filepath.Base(filePath)
+
+		// The unique name of a Maildir message is followed by its flags, e.g. `unique:2,FS`, which change over time
+		// as does the directory holding it.
+		unique, flags, _ := strings.Cut(name, maildirInfoSep)
+		msg.ID = path.Join(path.Dir(path.Dir(msg.ID)), unique)
+
+		if sub == "cur" {
+			flags = strings.TrimPrefix(flags, "2,")
+			msg.Seen = strings.Contains(flags, "S")
+			msg.Answered = strings.Contains(flags, "R")
+			msg.Flagged = strings.Contains(flags, "F")
+			msg.Draft = strings.Contains(flags, "D")
+		}
+	}
+
+	return w.fn(msg)
+}
+
+// rel returns the path of the given file relative to the source, with forward slashes.
+func (w *walker) rel(filePath string) string {
+	rel, err := filepath.Rel(w.root, filePath)
+	if err != nil {
+		return filePath
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+func walkMbox(filePath, id string, folder []string, fn func(Message) error) error {
+	file, err := os.Open(filePath) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	defer file.Close() //nolint:errcheck
+
+	return ReadMbox(file, func(msg Message) error {
+		msg.ID, msg.Folder = id+":"+msg.ID, folder
+
+		return fn(msg)
+	})
+}
+
+// newMboxMessage returns the message with the given literal, flagged according to its status headers.
+// The messages without them, which were not exported with their flags, are seen.
+func newMboxMessage(literal []byte) Message {
+	msg := Message{Literal: literal, Seen: true}
+
+	header, err := rfc822.Parse(literal).ParseHeader()
+	if err != nil {
+		return msg
+	}
+
+	if status, ok := header.GetChecked("Status"); ok {
+		msg.Seen = strings.Contains(status, "R")
+	}
+
+	if status, ok := header.GetChecked("X-Status"); ok {
+		msg.Answered = strings.Contains(status, "A")
+		msg.Flagged = strings.Contains(status, "F")
+		msg.Draft = strings.Contains(status, "D")
+	}
+
+	// Thunderbird keeps the flags in a hexadecimal bit field.
+	if status, ok := header.GetChecked("X-Mozilla-Status"); ok {
+		if flags, err := strconv.ParseUint(strings.TrimSpace(status), 16, 32); err == nil {
+			msg.Seen = flags&0x1 != 0
+			msg.Answered = flags&0x2 != 0
+			msg.Flagged = flags&0x4 != 0
+		}
+	}
+
+	return msg
+}
+
+// parseFromLineDate returns the date of the `From <sender> <date>` line starting an mbox message, zero if invalid.
+func parseFromLineDate(line []byte) time.Time {
+	fields := strings.Fields(string(line))
+	if len(fields) < 3 {
+		return time.Time{}
+	}
+
+	value := strings.Join(fields[2:], " ")
+
+	for _, layout := range []string{time.ANSIC, time.UnixDate, "Mon Jan _2 15:04:05 -0700 2006"} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date
+		}
+	}
+
+	return time.Time{}
+}
+
+// trimSeparator removes the blank line separating the message from the next one.
+func trimSeparator(literal []byte) []byte {
+	newline := []byte("\n")
+	if bytes.Contains(literal, []byte("\r\n")) {
+		newline = []byte("\r\n")
+	}
+
+	return append(bytes.TrimRight(literal, "\r\n"), newline...)
+}
+
+// isMaildir returns whether the directory with the given entries is a Maildir directory, holding `cur` or `new`.
+func isMaildir(entries []fs.DirEntry) bool {
+	var hasCur, hasNew bool
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			hasCur = hasCur || entry.Name() == "cur"
+			hasNew = hasNew || entry.Name() == "new"
+		}
+	}
+
+	return hasCur || hasNew
+}
+
+// isMbox returns whether the given file is an mbox file, which starts with a `From ` line.
+func isMbox(filePath string) bool {
+	file, err := os.Open(filePath) //nolint:gosec
+	if err != nil {
+		return false
+	}
+	defer file.Close() //nolint:errcheck
+
+	prefix := make([]byte, len("From "))
+
+	if _, err := io.ReadFull(file, prefix); err != nil {
+		return false
+	}
+
+	return string(prefix) == "From "
+}
+
+func trimMboxExt(name string) string {
+	if ext := filepath.Ext(name); strings.EqualFold(ext, ".mbox") || strings.EqualFold(ext, ".mbx") {
+		return strings.TrimSuffix(name, ext)
+	}
+
+	return name
+}
+
+func appendFolder(folder []string, names ...string) []string {
+	return append(append([]string{}, folder...), names...)
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package localmail
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadMbox(t *testing.T) {
+	mbox := strings.Join([]string{
+		"From alice@example.com Mon Jan  2 10:00:00 2023",
+		"Subject: one",
+		"Status: RO",
+		"X-Status: AF",
+		"",
+		"body",
+		">From here",
+		"",
+		"From bob@example.com Tue Jan  3 11:00:00 2023",
+		"Subject: two",
+		"Status: O",
+		"",
+		">>From there",
+		"",
+		"From carol@example.com",
+		"Subject: three",
+		"",
+		"body",
+		"",
+	}, "\n")
+
+	var messages []Message
+
+	require.NoError(t, ReadMbox(strings.NewReader(mbox), func(msg Message) error {
+		messages = append(messages, msg)
+		return nil
+	}))
+
+	require.Len(t, messages, 3)
+
+	require.Equal(t, "0", messages[0].ID)
+	require.Equal(t, "Subject: one\nStatus: RO\nX-Status: AF\n\nbody\nFrom here\n", string(messages[0].Literal))
+	require.Equal(t, time.Date(2023, time.January, 2, 10, 0, 0, 0, time.UTC), messages[0].Date)
+	require.True(t, messages[0].Seen)
+	require.True(t, messages[0].Answered)
+	require.True(t, messages[0].Flagged)
+
+	require.Equal(t, "Subject: two\nStatus: O\n\n>From there\n", string(messages[1].Literal))
+	require.False(t, messages[1].Seen)
+	require.False(t, messages[1].Flagged)
+
+	// Without status headers, the messages are seen.
+	require.True(t, messages[2].Seen)
+	require.True(t, messages[2].Date.IsZero())
+}
+
+func TestWalk(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "Backup")
+
+	writeFile(t, filepath.Join(root, "Inbox.mbox"), "From a Mon Jan  2 10:00:00 2023\nSubject: inbox\n\nbody\n")
+	writeFile(t, filepath.Join(root, "Inbox.sbd", "Sub"), "From a Mon Jan  2 10:00:00 2023\nSubject: sub\n\nbody\n")
+	writeFile(t, filepath.Join(root, "Inbox.msf"), "// <!-- <mdb:mork:z v=\"1.4\"/> -->\n")
+	writeFile(t, filepath.Join(root, "note.eml"), "Subject: note\n\nbody\n")
+	writeFile(t, filepath.Join(root, "Old", "a.eml"), "Subject: old\n\nbody\n")
+	writeFile(t, filepath.Join(root, "Mail", "new", "1.host"), "Subject: new\n\nbody\n")
+	writeFile(t, filepath.Join(root, "Mail", "cur", "2.host:2,FRS"), "Subject: cur\n\nbody\n")
+	writeFile(t, filepath.Join(root, "Mail", ".Work.Projects", "cur", "3.host:2,"), "Subject: projects\n\nbody\n")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "Mail", "tmp"), 0o700))
+
+	messages := make(map[string]Message)
+
+	require.NoError(t, Walk(root, func(msg Message) error {
+		messages[msg.ID] = msg
+		return nil
+	}))
+
+	require.Len(t, messages, 7)
+
+	require.Equal(t, []string{"Inbox"}, messages["Inbox.mbox:0"].Folder)
+	require.Equal(t, []string{"Inbox", "Sub"}, messages["Inbox.sbd/Sub:0"].Folder)
+	require.Equal(t, []string{"Backup"}, messages["note.eml"].Folder)
+	require.True(t, messages["note.eml"].Seen)
+	require.Equal(t, []string{"Old"}, messages["Old/a.eml"].Folder)
+
+	require.Equal(t, []string{"Mail"}, messages["Mail/1.host"].Folder)
+	require.False(t, messages["Mail/1.host"].Seen)
+
+	require.Equal(t, []string{"Mail"}, messages["Mail/2.host"].Folder)
+	require.True(t, messages["Mail/2.host"].Seen)
+	require.True(t, messages["Mail/2.host"].Answered)
+	require.True(t, messages["Mail/2.host"].Flagged)
+
+	require.Equal(t, []string{"Mail", "Work", "Projects"}, messages["Mail/.Work.Projects/3.host"].Folder)
+	require.False(t, messages["Mail/.Work.Projects/3.host"].Seen)
+}
+
+func TestWalk_Maildir(t *testing.T) {
+	root := t.TempDir()
+
+	writeFile(t, filepath.Join(root, "cur", "1.host:2,S"), "Subject: inbox\n\nbody\n")
+	writeFile(t, filepath.Join(root, ".Sent", "cur", "2.host:2,S"), "Subject: sent\n\nbody\n")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "new"), 0o700))
+
+	var folders [][]string
+
+	require.NoError(t, Walk(root, func(msg Message) error {
+		folders = append(folders, msg.Folder)
+		return nil
+	}))
+
+	require.Equal(t, [][]string{{"Inbox"}, {"Sent"}}, folders)
+}
+
+func TestWalk_MboxFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "Archive.mbox")
+
+	writeFile(t, file, "From a Mon Jan  2 10:00:00 2023\nSubject: one\n\nbody\n\nFrom b Mon Jan  2 10:00:00 2023\nSubject: two\n\nbody\n")
+
+	var ids []string
+
+	require.NoError(t, Walk(file, func(msg Message) error {
+		require.Equal(t, []string{"Archive"}, msg.Folder)
+		ids = append(ids, msg.ID)
+		return nil
+	}))
+
+	require.Equal(t, []string{"Archive.mbox:0", "Archive.mbox:1"}, ids)
+}
+
+func writeFile(t *testing.T, path, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
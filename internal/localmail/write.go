@@ -0,0 +1,221 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package localmail
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/ProtonMail/gluon/rfc822"
+)
+
+// maildirInfoSep separates the unique name of a Maildir message from its flags. The colon is not allowed in file
+// names on Windows, where Maildir tools use a semicolon instead.
+var maildirInfoSep = func() string { //nolint:gochecknoglobals
+	if runtime.GOOS == "windows" {
+		return ";"
+	}
+
+	return ":"
+}()
+
+// MaildirName returns the name of the file holding the message in the `cur` subdirectory of a Maildir directory: the
+// ID of the message, which must be a valid file name, followed by its flags, e.g. `unique:2,FS`.
+func MaildirName(msg Message) string {
+	var flags []byte
+
+	// The flags are sorted alphabetically.
+	for _, flag := range []struct {
+		set  bool
+		char byte
+	}{
+		{msg.Draft, 'D'},
+		{msg.Flagged, 'F'},
+		{msg.Answered, 'R'},
+		{msg.Seen, 'S'},
+	} {
+		if flag.set {
+			flags = append(flags, flag.char)
+		}
+	}
+
+	return msg.ID + maildirInfoSep + "2," + string(flags)
+}
+
+// WriteMaildir writes the message into the given Maildir directory, which is created if needed, and returns the name
+// of its file (see MaildirName). The message is written into `tmp` then moved to `cur`, so that the mail clients never
+// see it partially written, replacing the file of the same message with other flags if there is one.
+func WriteMaildir(dir string, msg Message) (string, error) {
+	for _, sub := range []string{"cur", "new", "tmp"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o700); err != nil {
+			return "", err
+		}
+	}
+
+	name := MaildirName(msg)
+	tmpPath := filepath.Join(dir, "tmp", name)
+
+	if err := writeFileSync(tmpPath, msg.Literal); err != nil {
+		_ = os.Remove(tmpPath)
+		return "", err
+	}
+
+	stale, err := filepath.Glob(filepath.Join(dir, "cur", msg.ID+maildirInfoSep+"*"))
+	if err != nil {
+		return "", err
+	}
+
+	for _, stalePath := range stale {
+		if filepath.Base(stalePath) != name {
+			if err := os.Remove(stalePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return "", err
+			}
+		}
+	}
+
+	if err := os.Rename(tmpPath, filepath.Join(dir, "cur", name)); err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
+// SetMaildirFlags renames the file with the given name, in the `cur` subdirectory of the given Maildir directory, to
+// give it the flags of the message, and returns its new name.
+func SetMaildirFlags(dir, name string, msg Message) (string, error) {
+	newName := MaildirName(msg)
+
+	if newName == name {
+		return name, nil
+	}
+
+	if err := os.Rename(filepath.Join(dir, "cur", name), filepath.Join(dir, "cur", newName)); err != nil {
+		return "", err
+	}
+
+	return newName, nil
+}
+
+// WriteMbox writes the message to w in the mbox format: a `From ` line giving its date, followed by the message, whose
+// lines end with LF and whose lines starting with `From `, possibly quoted, are quoted with `>`, and a blank line. Its
+// flags are kept in its `Status` and `X-Status` headers, which replace those it has.
+func WriteMbox(w io.Writer, msg Message) error {
+	date := msg.Date
+	if date.IsZero() {
+		date = time.Unix(0, 0)
+	}
+
+	header, body := rfc822.Split(msg.Literal)
+
+	parsed, err := rfc822.NewHeader(header)
+	if err != nil {
+		return err
+	}
+
+	parsed.Del("Status")
+	parsed.Del("X-Status")
+
+	var status []byte
+
+	for _, flag := range []struct {
+		set  bool
+		char byte
+	}{
+		{msg.Answered, 'A'},
+		{msg.Flagged, 'F'},
+		{msg.Draft, 'D'},
+	} {
+		if flag.set {
+			status = append(status, flag.char)
+		}
+	}
+
+	// The headers are added at the top of the header, in reverse order.
+	if len(status) > 0 {
+		parsed.Set("X-Status", string(status))
+	}
+
+	if msg.Seen {
+		parsed.Set("Status", "RO")
+	} else {
+		parsed.Set("Status", "O")
+	}
+
+	bw := bufio.NewWriter(w)
+
+	if _, err := bw.WriteString("From MAILER-DAEMON " + date.UTC().Format(time.ANSIC) + "\n"); err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(io.MultiReader(bytes.NewReader(parsed.Raw()), bytes.NewReader(body)))
+	scanner.Buffer(nil, len(msg.Literal)+len(parsed.Raw())+1)
+
+	for scanner.Scan() {
+		line := bytes.TrimSuffix(scanner.Bytes(), []byte("\r"))
+
+		if bytes.HasPrefix(bytes.TrimLeft(line, ">"), []byte("From ")) {
+			if err := bw.WriteByte('>'); err != nil {
+				return err
+			}
+		}
+
+		if _, err := bw.Write(line); err != nil {
+			return err
+		}
+
+		if err := bw.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	if err := bw.WriteByte('\n'); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+func writeFileSync(filePath string, data []byte) error {
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600) //nolint:gosec
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package localmail
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteMbox(t *testing.T) {
+	date := time.Date(2023, time.January, 2, 10, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+
+	require.NoError(t, WriteMbox(&buf, Message{
+		Seen:     true,
+		Answered: true,
+		Flagged:  true,
+		Date:     date,
+		Literal:  []byte("Subject: one\r\nStatus: O\r\n\r\nbody\r\nFrom here\r\n>From there"),
+	}))
+
+	require.NoError(t, WriteMbox(&buf, Message{
+		Date:    date.Add(time.Hour),
+		Literal: []byte("Subject: two\r\n\r\nbody\r\n"),
+	}))
+
+	require.Equal(t, "From MAILER-DAEMON Mon Jan  2 10:00:00 2023\n"+
+		"Status: RO\nX-Status: AF\nSubject: one\n\nbody\n>From here\n>>From there\n\n"+
+		"From MAILER-DAEMON Mon Jan  2 11:00:00 2023\n"+
+		"Status: O\nSubject: two\n\nbody\n\n", buf.String())
+
+	var messages []Message
+
+	require.NoError(t, ReadMbox(&buf, func(msg Message) error {
+		messages = append(messages, msg)
+		return nil
+	}))
+
+	require.Len(t, messages, 2)
+	require.Equal(t, "Status: RO\nX-Status: AF\nSubject: one\n\nbody\nFrom here\n>From there\n", string(messages[0].Literal))
+	require.Equal(t, date, messages[0].Date)
+	require.True(t, messages[0].Seen)
+	require.True(t, messages[0].Answered)
+	require.True(t, messages[0].Flagged)
+	require.False(t, messages[1].Seen)
+	require.False(t, messages[1].Flagged)
+}
+
+func TestWriteMaildir(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "Work")
+
+	name, err := WriteMaildir(dir, Message{ID: "1.abc", Flagged: true, Literal: []byte("Subject: one\r\n\r\nbody\r\n")})
+	require.NoError(t, err)
+	require.Equal(t, "1.abc"+maildirInfoSep+"2,F", name)
+
+	// Writing it again with other flags replaces it.
+	name, err = WriteMaildir(dir, Message{ID: "1.abc", Seen: true, Literal: []byte("Subject: one\r\n\r\nbody\r\n")})
+	require.NoError(t, err)
+	require.Equal(t, "1.abc"+maildirInfoSep+"2,S", name)
+
+	name, err = SetMaildirFlags(dir, name, Message{ID: "1.abc", Seen: true, Answered: true, Draft: true})
+	require.NoError(t, err)
+	require.Equal(t, "1.abc"+maildirInfoSep+"2,DRS", name)
+
+	entries, err := os.ReadDir(filepath.Join(dir, "cur"))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, name, entries[0].Name())
+
+	var messages []Message
+
+	require.NoError(t, Walk(root, func(msg Message) error {
+		messages = append(messages, msg)
+		return nil
+	}))
+
+	require.Len(t, messages, 1)
+	require.Equal(t, "Work/1.abc", messages[0].ID)
+	require.Equal(t, []string{"Work"}, messages[0].Folder)
+	require.True(t, messages[0].Seen)
+	require.True(t, messages[0].Answered)
+	require.True(t, messages[0].Draft)
+	require.False(t, messages[0].Flagged)
+}
@@ -206,6 +206,26 @@ func (l *Locations) ProvideUnleashCachePath() (string, error) {
 	return l.getUnleashCachePath(), nil
 }
 
+// ProvideSocketsPath returns a location for the IMAP and SMTP Unix sockets (e.g. ~/.local/share/<company>/<app>/sockets).
+// It creates it if it doesn't already exist.
+func (l *Locations) ProvideSocketsPath() (string, error) {
+	if err := os.MkdirAll(l.getSocketsPath(), 0o700); err != nil {
+		return "", err
+	}
+
+	return l.getSocketsPath(), nil
+}
+
+// ProvideImportsPath returns a location for the journals of the imports (e.g. ~/.local/share/<company>/<app>/imports).
+// It creates it if it doesn't already exist.
+func (l *Locations) ProvideImportsPath() (string, error) {
+	if err := os.MkdirAll(l.getImportsPath(), 0o700); err != nil {
+		return "", err
+	}
+
+	return l.getImportsPath(), nil
+}
+
 func (l *Locations) getGluonCachePath() string {
 	return filepath.Join(l.userData, "gluon")
 }
@@ -238,6 +258,14 @@ func (l *Locations) getUpdatesPath() string {
 	return filepath.Join(l.userData, "updates")
 }
 
+func (l *Locations) getSocketsPath() string {
+	return filepath.Join(l.userData, "sockets")
+}
+
+func (l *Locations) getImportsPath() string {
+	return filepath.Join(l.userData, "imports")
+}
+
 func (l *Locations) getNotificationsCachePath() string {
 	return filepath.Join(l.userCache, "notifications")
 }
@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Format is the format of the log entries written to the log files.
+type Format string
+
+const (
+	TextFormat Format = "text"
+	JSONFormat Format = "json"
+)
+
+var ErrInvalidFormat = errors.New("invalid log format")
+
+// sensitiveFields are the fields whose values are hashed in structured log entries.
+var sensitiveFields = []string{"user", "userID", "username", "email"} //nolint:gochecknoglobals
+
+func newFormatter(format Format, sessionID SessionID) (logrus.Formatter, error) {
+	switch format {
+	case TextFormat, "":
+		return &logrus.TextFormatter{
+			DisableColors:    true,
+			ForceQuote:       true,
+			FullTimestamp:    true,
+			QuoteEmptyFields: true,
+			TimestampFormat:  "2006-01-02 15:04:05.000",
+		}, nil
+
+	case JSONFormat:
+		return newJSONFormatter(sessionID), nil
+
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrInvalidFormat, format)
+	}
+}
+
+// jsonFormatter writes one JSON object per line. Each entry carries the stable 'service' and 'session' fields and
+// user-identifying fields are hashed.
+type jsonFormatter struct {
+	sessionID SessionID
+	formatter *logrus.JSONFormatter
+}
+
+func newJSONFormatter(sessionID SessionID) *jsonFormatter {
+	return &jsonFormatter{
+		sessionID: sessionID,
+		formatter: &logrus.JSONFormatter{TimestampFormat: time.RFC3339Nano},
+	}
+}
+
+func (f *jsonFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	data := make(logrus.Fields, len(entry.Data)+2)
+	for key, value := range entry.Data {
+		data[key] = value
+	}
+
+	data["session"] = string(f.sessionID)
+
+	if subsystem := subsystemOf(entry); subsystem != "" {
+		data["service"] = subsystem
+	}
+
+	for _, key := range sensitiveFields {
+		if value, ok := data[key]; ok {
+			data[key] = Sensitive(fmt.Sprint(value))
+		}
+	}
+
+	structured := *entry
+	structured.Data = data
+
+	return f.formatter.Format(&structured)
+}
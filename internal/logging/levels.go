@@ -0,0 +1,210 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+var ErrInvalidLevelSpec = errors.New("invalid log level specification")
+
+// levels is the process wide level configuration. It is consulted by the filtering formatter installed by Init.
+var levels = newLevelFilter() //nolint:gochecknoglobals
+
+// levelFilter holds a global log level and optional per-subsystem overrides. The subsystem of a log entry is given by
+// its 'service' field or, if absent, by its 'pkg' field.
+type levelFilter struct {
+	lock       sync.RWMutex
+	global     logrus.Level
+	subsystems map[string]logrus.Level
+}
+
+func newLevelFilter() *levelFilter {
+	return &levelFilter{
+		global:     logrus.InfoLevel,
+		subsystems: make(map[string]logrus.Level),
+	}
+}
+
+// SetLevels changes the log levels at runtime. The spec is a comma separated list of levels, e.g. "info,sync=debug,smtp=trace".
+// An entry without a subsystem sets the global level; if there is none, the current global level is kept.
+// Subsystem overrides not present in the spec are removed.
+func SetLevels(spec string) error {
+	global, hasGlobal, subsystems, err := parseLevels(spec)
+	if err != nil {
+		return err
+	}
+
+	levels.set(global, hasGlobal, subsystems)
+
+	return nil
+}
+
+// GetLevels returns the current log level configuration in the format accepted by SetLevels.
+func GetLevels() string {
+	return levels.String()
+}
+
+func (f *levelFilter) set(global logrus.Level, hasGlobal bool, subsystems map[string]logrus.Level) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if hasGlobal {
+		f.global = global
+	}
+
+	f.subsystems = subsystems
+
+	// The logger must let through the most verbose of all configured levels, the filtering is done when formatting.
+	loggerLevel := f.global
+	for _, level := range f.subsystems {
+		if level > loggerLevel {
+			loggerLevel = level
+		}
+	}
+
+	logrus.SetLevel(loggerLevel)
+}
+
+func (f *levelFilter) globalLevel() logrus.Level {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return f.global
+}
+
+func (f *levelFilter) isEnabled(entry *logrus.Entry) bool {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	level := f.global
+
+	if subsystem := subsystemOf(entry); subsystem != "" {
+		if subsystemLevel, ok := f.lookup(subsystem); ok {
+			level = subsystemLevel
+		}
+	}
+
+	return entry.Level <= level
+}
+
+// lookup returns the level for the given subsystem. A subsystem such as "bridge/user" falls back to the level of "bridge".
+func (f *levelFilter) lookup(subsystem string) (logrus.Level, bool) {
+	subsystem = strings.ToLower(subsystem)
+
+	for {
+		if level, ok := f.subsystems[subsystem]; ok {
+			return level, true
+		}
+
+		index := strings.LastIndex(subsystem, "/")
+		if index < 0 {
+			return 0, false
+		}
+
+		subsystem = subsystem[:index]
+	}
+}
+
+func (f *levelFilter) String() string {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	items := make([]string, 0, len(f.subsystems))
+	for subsystem, level := range f.subsystems {
+		items = append(items, fmt.Sprintf("%v=%v", subsystem, level))
+	}
+
+	sort.Strings(items)
+
+	return strings.Join(append([]string{f.global.String()}, items...), ",")
+}
+
+func parseLevels(spec string) (logrus.Level, bool, map[string]logrus.Level, error) {
+	var (
+		global     logrus.Level
+		hasGlobal  bool
+		subsystems = make(map[string]logrus.Level)
+	)
+
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		subsystem, levelStr, found := strings.Cut(item, "=")
+		if !found {
+			level, err := logrus.ParseLevel(item)
+			if err != nil {
+				return 0, false, nil, fmt.Errorf("%w: %v", ErrInvalidLevelSpec, err)
+			}
+
+			global, hasGlobal = level, true
+
+			continue
+		}
+
+		subsystem = strings.ToLower(strings.TrimSpace(subsystem))
+		if subsystem == "" {
+			return 0, false, nil, fmt.Errorf("%w: missing subsystem in %q", ErrInvalidLevelSpec, item)
+		}
+
+		level, err := logrus.ParseLevel(strings.TrimSpace(levelStr))
+		if err != nil {
+			return 0, false, nil, fmt.Errorf("%w: %v", ErrInvalidLevelSpec, err)
+		}
+
+		subsystems[subsystem] = level
+	}
+
+	return global, hasGlobal, subsystems, nil
+}
+
+func subsystemOf(entry *logrus.Entry) string {
+	for _, key := range []string{"service", "pkg"} {
+		if value, ok := entry.Data[key].(string); ok && value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// filteringFormatter drops the entries that are not enabled by the level configuration.
+type filteringFormatter struct {
+	formatter logrus.Formatter
+}
+
+func newFilteringFormatter(formatter logrus.Formatter) *filteringFormatter {
+	return &filteringFormatter{formatter: formatter}
+}
+
+func (f *filteringFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	if !levels.isEnabled(entry) {
+		return nil, nil
+	}
+
+	return f.formatter.Format(entry)
+}
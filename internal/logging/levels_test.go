@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevels_Parse(t *testing.T) {
+	global, hasGlobal, subsystems, err := parseLevels("info, sync=debug,SMTP=trace")
+	require.NoError(t, err)
+	require.True(t, hasGlobal)
+	require.Equal(t, logrus.InfoLevel, global)
+	require.Equal(t, map[string]logrus.Level{"sync": logrus.DebugLevel, "smtp": logrus.TraceLevel}, subsystems)
+
+	_, hasGlobal, subsystems, err = parseLevels("user-events=trace")
+	require.NoError(t, err)
+	require.False(t, hasGlobal)
+	require.Equal(t, map[string]logrus.Level{"user-events": logrus.TraceLevel}, subsystems)
+
+	_, _, _, err = parseLevels("info,sync=verbose")
+	require.ErrorIs(t, err, ErrInvalidLevelSpec)
+
+	_, _, _, err = parseLevels("=debug")
+	require.ErrorIs(t, err, ErrInvalidLevelSpec)
+}
+
+func TestLevels_Filter(t *testing.T) {
+	defer logrus.SetLevel(logrus.GetLevel())
+
+	filter := newLevelFilter()
+	filter.set(logrus.InfoLevel, true, map[string]logrus.Level{"sync": logrus.DebugLevel, "bridge": logrus.WarnLevel})
+
+	require.Equal(t, logrus.DebugLevel, logrus.GetLevel())
+	require.Equal(t, "info,bridge=warning,sync=debug", filter.String())
+
+	newEntry := func(level logrus.Level, fields logrus.Fields) *logrus.Entry {
+		entry := logrus.WithFields(fields)
+		entry.Level = level
+
+		return entry
+	}
+
+	require.True(t, filter.isEnabled(newEntry(logrus.InfoLevel, nil)))
+	require.False(t, filter.isEnabled(newEntry(logrus.DebugLevel, nil)))
+	require.True(t, filter.isEnabled(newEntry(logrus.DebugLevel, logrus.Fields{"service": "sync"})))
+	require.False(t, filter.isEnabled(newEntry(logrus.TraceLevel, logrus.Fields{"service": "sync"})))
+	require.False(t, filter.isEnabled(newEntry(logrus.InfoLevel, logrus.Fields{"pkg": "bridge/user"})))
+	require.True(t, filter.isEnabled(newEntry(logrus.WarnLevel, logrus.Fields{"pkg": "bridge/user"})))
+	require.False(t, filter.isEnabled(newEntry(logrus.DebugLevel, logrus.Fields{"pkg": "bridgeui"})))
+}
+
+func TestFormat_JSON(t *testing.T) {
+	formatter, err := newFormatter(JSONFormat, "20230602_094633102")
+	require.NoError(t, err)
+
+	entry := logrus.WithFields(logrus.Fields{"pkg": "smtp", "user": "user@proton.me"})
+	entry.Level = logrus.InfoLevel
+	entry.Message = "Hello"
+
+	bytes, err := formatter.Format(entry)
+	require.NoError(t, err)
+
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(bytes, &fields))
+	require.Equal(t, "Hello", fields["msg"])
+	require.Equal(t, "info", fields["level"])
+	require.Equal(t, "smtp", fields["service"])
+	require.Equal(t, "20230602_094633102", fields["session"])
+	require.Equal(t, Sensitive("user@proton.me"), fields["user"])
+
+	// The original entry is left untouched.
+	require.Equal(t, "user@proton.me", entry.Data["user"])
+
+	_, err = newFormatter("xml", "")
+	require.ErrorIs(t, err, ErrInvalidFormat)
+}
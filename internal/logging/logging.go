@@ -88,15 +88,14 @@ func (cs *coloredStdOutHook) Fire(entry *logrus.Entry) error {
 }
 
 // Init Initialize logging. Log files are rotated when their size exceeds rotationSize. if pruningSize >= 0, pruning occurs using
-// the default pruning algorithm.
-func Init(logsPath string, sessionID SessionID, appName AppName, rotationSize, pruningSize int64, level string) (io.Closer, error) {
-	logrus.SetFormatter(&logrus.TextFormatter{
-		DisableColors:    true,
-		ForceQuote:       true,
-		FullTimestamp:    true,
-		QuoteEmptyFields: true,
-		TimestampFormat:  "2006-01-02 15:04:05.000",
-	})
+// the default pruning algorithm. The level may contain per-subsystem levels, see SetLevels.
+func Init(logsPath string, sessionID SessionID, appName AppName, rotationSize, pruningSize int64, level string, format Format) (io.Closer, error) {
+	formatter, err := newFormatter(format, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.SetFormatter(newFilteringFormatter(formatter))
 
 	logrus.AddHook(newColoredStdOutHook())
 
@@ -107,7 +106,7 @@ func Init(logsPath string, sessionID SessionID, appName AppName, rotationSize, p
 
 	logrus.SetOutput(rotator)
 
-	return rotator, setLevel(level)
+	return rotator, setLevel(level, format)
 }
 
 // Close closes the log file. if closer is nil, no error is reported.
@@ -180,27 +179,30 @@ func getOrderedLogFileListForBugReport(logsPath string, maxSessionCount int) ([]
 // level it will also prevent from writing to file. Setting level to Info or
 // higher will not set writing to file again if it was previously cancelled by
 // Debug or Trace.
-func setLevel(level string) error {
-	if level == "" {
-		level = "debug"
-	}
-
-	logLevel, err := logrus.ParseLevel(level)
+func setLevel(level string, format Format) error {
+	global, hasGlobal, subsystems, err := parseLevels(level)
 	if err != nil {
 		return err
 	}
 
-	logrus.SetLevel(logLevel)
+	if !hasGlobal {
+		global = logrus.DebugLevel
+	}
+
+	levels.set(global, true, subsystems)
 
 	// The hook to print panic, fatal and error to stderr is always
 	// added. We want to avoid log duplicates by replacing all hooks.
-	if logrus.GetLevel() == logrus.TraceLevel {
+	if levels.globalLevel() == logrus.TraceLevel {
 		_ = logrus.StandardLogger().ReplaceHooks(logrus.LevelHooks{})
 		logrus.SetOutput(os.Stderr)
-		logrus.SetFormatter(&logrus.TextFormatter{
-			FullTimestamp:   true,
-			TimestampFormat: time.StampMilli,
-		})
+
+		if format != JSONFormat {
+			logrus.SetFormatter(newFilteringFormatter(&logrus.TextFormatter{
+				FullTimestamp:   true,
+				TimestampFormat: time.StampMilli,
+			}))
+		}
 	}
 
 	return nil
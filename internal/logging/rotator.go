@@ -71,6 +71,11 @@ func NewDefaultRotator(logsPath string, sessionID SessionID, appName AppName, ma
 }
 
 func (r *Rotator) Write(p []byte) (int, error) {
+	// Entries filtered out by the level configuration are formatted as empty writes.
+	if len(p) == 0 {
+		return 0, nil
+	}
+
 	if r.size+int64(len(p)) > r.maxFileSize {
 		if err := r.rotate(); err != nil {
 			return 0, err
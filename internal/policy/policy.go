@@ -0,0 +1,168 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+// Package policy implements the policy file through which an IT department enforces settings on a machine.
+package policy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
+)
+
+var ErrInvalidPolicy = errors.New("invalid policy file")
+
+// The names of the settings which can be locked by the policy, as used by the named settings of bridge.
+const (
+	SettingIMAPPort          = "imap-port"
+	SettingSMTPPort          = "smtp-port"
+	SettingIMAPSSL           = "imap-ssl"
+	SettingSMTPSSL           = "smtp-ssl"
+	SettingTelemetryDisabled = "telemetry-disabled"
+	SettingAutoUpdate        = "auto-update"
+	SettingUpdateChannel     = "update-channel"
+	SettingProxyAllowed      = "doh"
+	SettingShowAllMail       = "show-all-mail"
+	SettingDiskCachePath     = "disk-cache-path"
+)
+
+// Policy holds the settings locked to a given value, those left unset can be changed by the user.
+type Policy struct {
+	IMAPPort          *int             `json:"imap-port,omitempty"`
+	SMTPPort          *int             `json:"smtp-port,omitempty"`
+	IMAPSSL           *bool            `json:"imap-ssl,omitempty"`
+	SMTPSSL           *bool            `json:"smtp-ssl,omitempty"`
+	TelemetryDisabled *bool            `json:"telemetry-disabled,omitempty"`
+	AutoUpdate        *bool            `json:"auto-update,omitempty"`
+	UpdateChannel     *updater.Channel `json:"update-channel,omitempty"`
+	ProxyAllowed      *bool            `json:"doh,omitempty"`
+	ShowAllMail       *bool            `json:"show-all-mail,omitempty"`
+	DiskCachePath     *string          `json:"disk-cache-path,omitempty"`
+
+	// AllowedAccounts restricts the accounts which may log in to those whose username or email address matches one
+	// of the patterns, such as `*@example.com`. Any account may log in if it is empty.
+	AllowedAccounts []string `json:"allowed-accounts,omitempty"`
+}
+
+// Load reads the policy file at the given path. An empty policy is returned if there is no such file.
+func Load(path string) (*Policy, error) {
+	file, err := os.Open(path) //nolint:gosec
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Policy{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer file.Close() //nolint:errcheck
+
+	var policy Policy
+
+	dec := json.NewDecoder(file)
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(&policy); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPolicy, err)
+	}
+
+	if err := policy.validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPolicy, err)
+	}
+
+	return &policy, nil
+}
+
+// LockedSettings returns the sorted names of the settings locked by the policy.
+func (policy *Policy) LockedSettings() []string {
+	locked := map[string]bool{
+		SettingIMAPPort:          policy.IMAPPort != nil,
+		SettingSMTPPort:          policy.SMTPPort != nil,
+		SettingIMAPSSL:           policy.IMAPSSL != nil,
+		SettingSMTPSSL:           policy.SMTPSSL != nil,
+		SettingTelemetryDisabled: policy.TelemetryDisabled != nil,
+		SettingAutoUpdate:        policy.AutoUpdate != nil,
+		SettingUpdateChannel:     policy.UpdateChannel != nil,
+		SettingProxyAllowed:      policy.ProxyAllowed != nil,
+		SettingShowAllMail:       policy.ShowAllMail != nil,
+		SettingDiskCachePath:     policy.DiskCachePath != nil,
+	}
+
+	var names []string
+
+	for name, isLocked := range locked {
+		if isLocked {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// IsLocked returns whether the setting with the given name is locked by the policy.
+func (policy *Policy) IsLocked(name string) bool {
+	return slices.Contains(policy.LockedSettings(), name)
+}
+
+// IsAccountAllowed returns whether an account known under the given usernames and addresses may log in.
+func (policy *Policy) IsAccountAllowed(names ...string) bool {
+	if len(policy.AllowedAccounts) == 0 {
+		return true
+	}
+
+	for _, pattern := range policy.AllowedAccounts {
+		for _, name := range names {
+			if ok, err := path.Match(strings.ToLower(pattern), strings.ToLower(name)); err == nil && ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (policy *Policy) validate() error {
+	for _, port := range []*int{policy.IMAPPort, policy.SMTPPort} {
+		if port != nil && (*port < 1 || *port > 65535) {
+			return fmt.Errorf("the port %v is not between 1 and 65535", *port)
+		}
+	}
+
+	if policy.UpdateChannel != nil && *policy.UpdateChannel != updater.StableChannel && *policy.UpdateChannel != updater.EarlyChannel {
+		return fmt.Errorf("the update channel must be %v or %v", updater.StableChannel, updater.EarlyChannel)
+	}
+
+	if policy.DiskCachePath != nil && !filepath.IsAbs(*policy.DiskCachePath) {
+		return fmt.Errorf("the disk cache path %q is not absolute", *policy.DiskCachePath)
+	}
+
+	for _, pattern := range policy.AllowedAccounts {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("the account pattern %q is malformed", pattern)
+		}
+	}
+
+	return nil
+}
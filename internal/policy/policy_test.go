@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/updater"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy_Load(t *testing.T) {
+	dir := t.TempDir()
+
+	// There is no policy by default.
+	policy, err := Load(filepath.Join(dir, "policy.json"))
+	require.NoError(t, err)
+	require.Empty(t, policy.LockedSettings())
+	require.True(t, policy.IsAccountAllowed("alice@example.com"))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "policy.json"), []byte(`{
+		"imap-port": 1143,
+		"smtp-ssl": true,
+		"doh": false,
+		"update-channel": "stable",
+		"allowed-accounts": ["*@example.com", "bob"]
+	}`), 0o600))
+
+	policy, err = Load(filepath.Join(dir, "policy.json"))
+	require.NoError(t, err)
+	require.Equal(t, []string{SettingProxyAllowed, SettingIMAPPort, SettingSMTPSSL, SettingUpdateChannel}, policy.LockedSettings())
+	require.Equal(t, 1143, *policy.IMAPPort)
+	require.Equal(t, updater.StableChannel, *policy.UpdateChannel)
+	require.True(t, policy.IsLocked(SettingIMAPPort))
+	require.False(t, policy.IsLocked(SettingSMTPPort))
+
+	// The accounts are matched by username or address, ignoring the case.
+	require.True(t, policy.IsAccountAllowed("alice", "Alice@Example.com"))
+	require.True(t, policy.IsAccountAllowed("bob", "bob@proton.me"))
+	require.False(t, policy.IsAccountAllowed("carol", "carol@proton.me"))
+}
+
+func TestPolicy_LoadInvalid(t *testing.T) {
+	for _, content := range []string{
+		`{"imap-port": 0}`,
+		`{"update-channel": "nightly"}`,
+		`{"disk-cache-path": "relative/path"}`,
+		`{"allowed-accounts": ["[a-"]}`,
+		`{"unknown-setting": true}`,
+		`not json`,
+	} {
+		path := filepath.Join(t.TempDir(), "policy.json")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+		_, err := Load(path)
+		require.ErrorIs(t, err, ErrInvalidPolicy, content)
+	}
+}
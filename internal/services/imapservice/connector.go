@@ -23,6 +23,8 @@ import (
 	"errors"
 	"fmt"
 	"net/mail"
+	"runtime/pprof"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -49,20 +51,36 @@ type mailboxCountProvider interface {
 	GetUserMailboxCountByInternalID(ctx context.Context, addrID string, internalID imap.InternalMailboxID) (int, error)
 }
 
+type sessionBinder interface {
+	BindIMAPSession(sessionID int, appPasswordID string, readOnly bool)
+	IsIMAPSessionReadOnly(sessionID int) bool
+	IsIMAPSessionLockedOut(sessionID int) bool
+	BindIMAPSessionQuota(sessionID int, quota func() (uint64, uint64))
+	BindIMAPSessionMetadata(sessionID int, metadata MailboxMetadata)
+	SetIMAPSessionResponseCode(sessionID int, code ResponseCode)
+}
+
 // Connector contains all IMAP state required to satisfy sync and or imap queries.
 type Connector struct {
 	addrID      string
 	showAllMail uint32
 
+	// systemViews are the IDs of the shown system views, e.g. Starred or Snoozed.
+	systemViews atomic.Pointer[[]string]
+
+	// groupAddrIDs are the addresses sharing the mailbox in split mode, addrID being the first of them.
+	groupAddrIDs []string
+
 	flags     imap.FlagSet
 	permFlags imap.FlagSet
 	attrs     imap.FlagSet
 
-	identityState sharedIdentity
-	client        APIClient
-	reporter      reporter.Reporter
-	panicHandler  async.PanicHandler
-	sendRecorder  *sendrecorder.SendRecorder
+	identityState   sharedIdentity
+	client          APIClient
+	conversationIDs *ConversationIDs
+	reporter        reporter.Reporter
+	panicHandler    async.PanicHandler
+	sendRecorder    *sendrecorder.SendRecorder
 
 	addressMode usertypes.AddressMode
 	labels      sharedLabels
@@ -73,13 +91,31 @@ type Connector struct {
 	syncState   *SyncState
 
 	mailboxCountProvider mailboxCountProvider
+	sessionBinder        sessionBinder
 }
 
 var errNoSenderAddressMatch = errors.New("no matching sender found in address list")
 
+// ErrReadOnlySession refuses the changes of read-only sessions, with the NOPERM response code.
+var ErrReadOnlySession = fmt.Errorf("the session is read-only: %w", connector.ErrOperationNotAllowed)
+
+// errOverQuota refuses the messages which don't fit in the space left to the account, with the OVERQUOTA response
+// code. It is not put in the recovery mailbox by gluon.
+var errOverQuota = fmt.Errorf("the account is over quota: %w", connector.ErrMessageSizeExceedsLimits)
+
+// ResponseCode is a response code the connector refuses a command with, see RFC 3501 section 7.1.
+type ResponseCode string
+
+const (
+	ResponseCodeNoPerm    ResponseCode = "NOPERM"
+	ResponseCodeOverQuota ResponseCode = "OVERQUOTA"
+)
+
 func NewConnector(
 	addrID string,
+	groupAddrIDs []string,
 	apiClient APIClient,
+	conversationIDs *ConversationIDs,
 	labels sharedLabels,
 	identityState sharedIdentity,
 	addressMode usertypes.AddressMode,
@@ -87,23 +123,27 @@ func NewConnector(
 	panicHandler async.PanicHandler,
 	reporter reporter.Reporter,
 	showAllMail bool,
+	systemViews []string,
 	syncState *SyncState,
 	mailboxCountProvider mailboxCountProvider,
+	sessionBinder sessionBinder,
 ) *Connector {
 	userID := identityState.UserID()
 
-	return &Connector{
+	c := &Connector{
 		identityState: identityState,
 		addrID:        addrID,
+		groupAddrIDs:  groupAddrIDs,
 		showAllMail:   b32(showAllMail),
 		flags:         defaultMailboxFlags(),
 		permFlags:     defaultMailboxPermanentFlags(),
 		attrs:         defaultMailboxAttributes(),
 
-		client:       apiClient,
-		reporter:     reporter,
-		panicHandler: panicHandler,
-		sendRecorder: sendRecorder,
+		client:          apiClient,
+		conversationIDs: conversationIDs,
+		reporter:        reporter,
+		panicHandler:    panicHandler,
+		sendRecorder:    sendRecorder,
 
 		updateCh: async.NewQueuedChannel[imap.Update](
 			0,
@@ -124,7 +164,12 @@ func NewConnector(
 		syncState:   syncState,
 
 		mailboxCountProvider: mailboxCountProvider,
+		sessionBinder:        sessionBinder,
 	}
+
+	c.SetSystemViews(systemViews)
+
+	return c
 }
 
 func (s *Connector) StateClose() {
@@ -175,20 +220,86 @@ func (s *Connector) Init(ctx context.Context, cache connector.IMAPState) error {
 	})
 }
 
-func (s *Connector) Authorize(_ context.Context, username string, password []byte) bool {
-	addrID, err := s.identityState.CheckAuth(username, password)
+func (s *Connector) Authorize(ctx context.Context, username string, password []byte) bool {
+	// A host locked out after too many failed authentications can't keep trying over a connection it already has.
+	if sessionID, ok := imapSessionID(ctx); ok && s.sessionBinder.IsIMAPSessionLockedOut(sessionID) {
+		return false
+	}
+
+	addrID, appPasswordID, readOnly, err := s.identityState.CheckAuth(username, password)
 	if err != nil {
 		return false
 	}
 
-	if s.addressMode == usertypes.AddressModeSplit && addrID != s.addrID {
+	if s.addressMode == usertypes.AddressModeSplit && !slices.Contains(s.groupAddrIDs, addrID) {
 		return false
 	}
 
+	// Sessions using an app password are bound to it so that they can be closed when it is revoked,
+	// and read-only sessions are recorded so that their changes are refused.
+	// Such logins fail if the session is unknown, e.g. when gluon is built without its pprof labels.
+	if appPasswordID != "" || readOnly {
+		sessionID, ok := imapSessionID(ctx)
+		if !ok {
+			s.log.Error("Refusing IMAP login which can't be bound to its session, session ID unknown")
+			return false
+		}
+
+		s.sessionBinder.BindIMAPSession(sessionID, appPasswordID, readOnly)
+	}
+
+	// The QUOTA commands of the session are answered from the space used by the account,
+	// and its METADATA commands from the labels.
+	if sessionID, ok := imapSessionID(ctx); ok {
+		s.sessionBinder.BindIMAPSessionQuota(sessionID, s.identityState.Quota)
+		s.sessionBinder.BindIMAPSessionMetadata(sessionID, &sessionMetadata{connector: s, sessionID: sessionID})
+	}
+
 	return true
 }
 
+// refuse returns the error refusing the command of the session, which the IMAP server sends with the response code.
+func (s *Connector) refuse(ctx context.Context, code ResponseCode, err error) error {
+	if sessionID, ok := imapSessionID(ctx); ok {
+		s.sessionBinder.SetIMAPSessionResponseCode(sessionID, code)
+	}
+
+	return err
+}
+
+// isReadOnly returns whether the context belongs to a session which can only read the mailbox,
+// either because of its credentials or because the user was made read-only since.
+func (s *Connector) isReadOnly(ctx context.Context) bool {
+	if s.identityState.ReadOnly() {
+		return true
+	}
+
+	sessionID, ok := imapSessionID(ctx)
+
+	return ok && s.sessionBinder.IsIMAPSessionReadOnly(sessionID)
+}
+
+// imapSessionID returns the ID of the gluon session on whose behalf the context was created.
+// Gluon labels the context of each session with its ID.
+func imapSessionID(ctx context.Context) (int, bool) {
+	label, ok := pprof.Label(ctx, "SessionID")
+	if !ok {
+		return 0, false
+	}
+
+	sessionID, err := strconv.Atoi(label)
+	if err != nil {
+		return 0, false
+	}
+
+	return sessionID, true
+}
+
 func (s *Connector) CreateMailbox(ctx context.Context, _ connector.IMAPStateWrite, name []string) (imap.Mailbox, error) {
+	if s.isReadOnly(ctx) {
+		return imap.Mailbox{}, s.refuse(ctx, ResponseCodeNoPerm, ErrReadOnlySession)
+	}
+
 	if len(name) < 2 {
 		return imap.Mailbox{}, fmt.Errorf("invalid mailbox name %q: %w", name, connector.ErrOperationNotAllowed)
 	}
@@ -213,7 +324,7 @@ func (s *Connector) GetMessageLiteral(ctx context.Context, id imap.MessageID) ([
 
 	var literal []byte
 	err = s.identityState.WithAddrKR(msg.AddressID, func(_, addrKR *crypto.KeyRing) error {
-		l, buildErr := message.DecryptAndBuildRFC822(addrKR, msg.Message, msg.AttData, defaultMessageJobOpts())
+		l, buildErr := message.DecryptAndBuildRFC822(addrKR, msg.Message, msg.AttData, s.conversationIDs.messageJobOpts(msg.ID))
 		if buildErr != nil {
 			return buildErr
 		}
@@ -234,7 +345,16 @@ func (s *Connector) GetMailboxVisibility(_ context.Context, mboxID imap.MailboxI
 		}
 		return imap.Hidden
 
+	case proton.StarredLabel, SnoozedLabel, AlmostAllMailLabel:
+		if !slices.Contains(*s.systemViews.Load(), string(mboxID)) {
+			return imap.Hidden
+		}
+		return imap.Visible
+
 	case proton.AllScheduledLabel:
+		if !slices.Contains(*s.systemViews.Load(), string(mboxID)) {
+			return imap.Hidden
+		}
 		return imap.HiddenIfEmpty
 	default:
 		return imap.Visible
@@ -242,6 +362,10 @@ func (s *Connector) GetMailboxVisibility(_ context.Context, mboxID imap.MailboxI
 }
 
 func (s *Connector) UpdateMailboxName(ctx context.Context, _ connector.IMAPStateWrite, mboxID imap.MailboxID, name []string) error {
+	if s.isReadOnly(ctx) {
+		return s.refuse(ctx, ResponseCodeNoPerm, ErrReadOnlySession)
+	}
+
 	if len(name) < 2 {
 		return fmt.Errorf("invalid mailbox name %q: %w", name, connector.ErrOperationNotAllowed)
 	}
@@ -259,6 +383,10 @@ func (s *Connector) UpdateMailboxName(ctx context.Context, _ connector.IMAPState
 }
 
 func (s *Connector) DeleteMailbox(ctx context.Context, _ connector.IMAPStateWrite, mboxID imap.MailboxID) error {
+	if s.isReadOnly(ctx) {
+		return s.refuse(ctx, ResponseCodeNoPerm, ErrReadOnlySession)
+	}
+
 	if err := s.client.DeleteLabel(ctx, string(mboxID)); err != nil {
 		return err
 	}
@@ -272,10 +400,19 @@ func (s *Connector) DeleteMailbox(ctx context.Context, _ connector.IMAPStateWrit
 }
 
 func (s *Connector) CreateMessage(ctx context.Context, _ connector.IMAPStateWrite, mailboxID imap.MailboxID, literal []byte, flags imap.FlagSet, _ time.Time) (imap.Message, []byte, error) {
+	if s.isReadOnly(ctx) {
+		return imap.Message{}, nil, s.refuse(ctx, ResponseCodeNoPerm, ErrReadOnlySession)
+	}
+
 	if mailboxID == proton.AllMailLabel {
 		return imap.Message{}, nil, connector.ErrOperationNotAllowed
 	}
 
+	// An account without space left is refused early rather than with an error of the API.
+	if used, maxSpace := s.identityState.Quota(); isOverQuota(used, maxSpace, len(literal)) {
+		return imap.Message{}, nil, s.refuse(ctx, ResponseCodeOverQuota, errOverQuota)
+	}
+
 	toList, err := getLiteralToList(literal)
 	if err != nil {
 		return imap.Message{}, nil, fmt.Errorf("failed to retrieve addresses from literal:%w", err)
@@ -303,7 +440,7 @@ func (s *Connector) CreateMessage(ctx context.Context, _ connector.IMAPStateWrit
 		if err := s.identityState.WithAddrKR(full.AddressID, func(_, addrKR *crypto.KeyRing) error {
 			var err error
 
-			if literal, err = message.DecryptAndBuildRFC822(addrKR, full.Message, full.AttData, defaultMessageJobOpts()); err != nil {
+			if literal, err = message.DecryptAndBuildRFC822(addrKR, full.Message, full.AttData, s.conversationIDs.messageJobOpts(full.ID)); err != nil {
 				return err
 			}
 
@@ -370,7 +507,12 @@ func (s *Connector) CreateMessage(ctx context.Context, _ connector.IMAPStateWrit
 }
 
 func (s *Connector) AddMessagesToMailbox(ctx context.Context, _ connector.IMAPStateWrite, messageIDs []imap.MessageID, mboxID imap.MailboxID) error {
-	if isAllMailOrScheduled(mboxID) {
+	if s.isReadOnly(ctx) {
+		return s.refuse(ctx, ResponseCodeNoPerm, ErrReadOnlySession)
+	}
+
+	// Snoozing a message requires a time, which cannot be given over IMAP.
+	if isVirtualMailbox(mboxID) || mboxID == SnoozedLabel {
 		return connector.ErrOperationNotAllowed
 	}
 
@@ -378,7 +520,11 @@ func (s *Connector) AddMessagesToMailbox(ctx context.Context, _ connector.IMAPSt
 }
 
 func (s *Connector) RemoveMessagesFromMailbox(ctx context.Context, _ connector.IMAPStateWrite, messageIDs []imap.MessageID, mboxID imap.MailboxID) error {
-	if isAllMailOrScheduled(mboxID) {
+	if s.isReadOnly(ctx) {
+		return s.refuse(ctx, ResponseCodeNoPerm, ErrReadOnlySession)
+	}
+
+	if isVirtualMailbox(mboxID) || mboxID == SnoozedLabel {
 		return connector.ErrOperationNotAllowed
 	}
 
@@ -432,7 +578,8 @@ func (s *Connector) RemoveMessagesFromMailbox(ctx context.Context, _ connector.I
 					if label.Type == proton.LabelTypeSystem && (id == proton.AllDraftsLabel ||
 						id == proton.AllMailLabel ||
 						id == proton.AllSentLabel ||
-						id == proton.AllScheduledLabel) {
+						id == proton.AllScheduledLabel ||
+						id == AlmostAllMailLabel) {
 						continue
 					}
 
@@ -458,13 +605,30 @@ func (s *Connector) RemoveMessagesFromMailbox(ctx context.Context, _ connector.I
 }
 
 func (s *Connector) MoveMessages(ctx context.Context, _ connector.IMAPStateWrite, messageIDs []imap.MessageID, mboxFromID, mboxToID imap.MailboxID) (bool, error) {
+	if s.isReadOnly(ctx) {
+		return false, s.refuse(ctx, ResponseCodeNoPerm, ErrReadOnlySession)
+	}
+
+	// The Snoozed label is maintained by the API, which the client has no snooze endpoint of: messages can't be moved
+	// into it.
 	if (mboxFromID == proton.InboxLabel && mboxToID == proton.SentLabel) ||
 		(mboxFromID == proton.SentLabel && mboxToID == proton.InboxLabel) ||
-		isAllMailOrScheduled(mboxFromID) ||
-		isAllMailOrScheduled(mboxToID) {
+		isVirtualMailbox(mboxFromID) ||
+		isVirtualMailbox(mboxToID) ||
+		mboxToID == SnoozedLabel {
 		return false, connector.ErrOperationNotAllowed
 	}
 
+	// Nor has the client an unsnooze endpoint: moving a message out of Snoozed only moves it into the destination,
+	// and the message leaves Snoozed when the API events say so.
+	if mboxFromID == SnoozedLabel {
+		if err := s.client.LabelMessages(ctx, usertypes.MapTo[imap.MessageID, string](messageIDs), string(mboxToID)); err != nil {
+			return false, fmt.Errorf("labeling messages: %w", err)
+		}
+
+		return false, nil
+	}
+
 	shouldExpungeOldLocation := func() bool {
 		rdLabels := s.labels.Read()
 		defer rdLabels.Close()
@@ -496,6 +660,10 @@ func (s *Connector) MoveMessages(ctx context.Context, _ connector.IMAPStateWrite
 }
 
 func (s *Connector) MarkMessagesSeen(ctx context.Context, _ connector.IMAPStateWrite, messageIDs []imap.MessageID, seen bool) error {
+	if s.isReadOnly(ctx) {
+		return s.refuse(ctx, ResponseCodeNoPerm, ErrReadOnlySession)
+	}
+
 	if seen {
 		return s.client.MarkMessagesRead(ctx, usertypes.MapTo[imap.MessageID, string](messageIDs)...)
 	}
@@ -504,6 +672,10 @@ func (s *Connector) MarkMessagesSeen(ctx context.Context, _ connector.IMAPStateW
 }
 
 func (s *Connector) MarkMessagesFlagged(ctx context.Context, _ connector.IMAPStateWrite, messageIDs []imap.MessageID, flagged bool) error {
+	if s.isReadOnly(ctx) {
+		return s.refuse(ctx, ResponseCodeNoPerm, ErrReadOnlySession)
+	}
+
 	if flagged {
 		return s.client.LabelMessages(ctx, usertypes.MapTo[imap.MessageID, string](messageIDs), proton.StarredLabel)
 	}
@@ -512,6 +684,10 @@ func (s *Connector) MarkMessagesFlagged(ctx context.Context, _ connector.IMAPSta
 }
 
 func (s *Connector) MarkMessagesForwarded(ctx context.Context, _ connector.IMAPStateWrite, messageIDs []imap.MessageID, flagged bool) error {
+	if s.isReadOnly(ctx) {
+		return s.refuse(ctx, ResponseCodeNoPerm, ErrReadOnlySession)
+	}
+
 	if flagged {
 		return s.client.MarkMessagesForwarded(ctx, usertypes.MapTo[imap.MessageID, string](messageIDs)...)
 	}
@@ -533,6 +709,12 @@ func (s *Connector) ShowAllMail(v bool) {
 	atomic.StoreUint32(&s.showAllMail, b32(v))
 }
 
+// SetSystemViews sets the IDs of the system views which are shown.
+func (s *Connector) SetSystemViews(labelIDs []string) {
+	labelIDs = slices.Clone(labelIDs)
+	s.systemViews.Store(&labelIDs)
+}
+
 const (
 	folderPrefix = "Folders"
 	labelPrefix  = "Labels"
@@ -689,7 +871,7 @@ func (s *Connector) importMessage(
 	}
 
 	isDraft := slices.Contains(labelIDs, proton.DraftsLabel)
-	addr, err := getImportAddress(p, isDraft, s.addrID, s)
+	addr, err := getImportAddress(p, isDraft, s.getImportAddrID(), s)
 	if err != nil {
 		return imap.Message{}, nil, err
 	}
@@ -746,7 +928,7 @@ func (s *Connector) importMessage(
 			return fmt.Errorf("failed to fetch message: %w", err)
 		}
 
-		if literal, err = message.DecryptAndBuildRFC822(primaryKey, full.Message, full.AttData, defaultMessageJobOpts()); err != nil {
+		if literal, err = message.DecryptAndBuildRFC822(primaryKey, full.Message, full.AttData, s.conversationIDs.messageJobOpts(full.ID)); err != nil {
 			return fmt.Errorf("failed to build message: %w", err)
 		}
 
@@ -19,8 +19,10 @@ package imapservice
 
 import (
 	"context"
+	"runtime/pprof"
 	"testing"
 
+	"github.com/ProtonMail/gluon/connector"
 	"github.com/ProtonMail/gluon/imap"
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapservice/mocks"
@@ -235,3 +237,184 @@ func TestEqualAddresse(t *testing.T) {
 		require.Equal(t, c.want, equalAddresses(c.a, c.b), "input was %q and %q", c.a, c.b)
 	}
 }
+
+func TestConnector_GetMailboxVisibility(t *testing.T) {
+	c := &Connector{}
+	c.SetSystemViews([]string{SnoozedLabel, proton.AllScheduledLabel})
+
+	require.Equal(t, imap.Visible, c.GetMailboxVisibility(context.Background(), proton.InboxLabel))
+	require.Equal(t, imap.Hidden, c.GetMailboxVisibility(context.Background(), proton.AllMailLabel))
+	require.Equal(t, imap.Hidden, c.GetMailboxVisibility(context.Background(), proton.StarredLabel))
+	require.Equal(t, imap.Hidden, c.GetMailboxVisibility(context.Background(), AlmostAllMailLabel))
+	require.Equal(t, imap.Visible, c.GetMailboxVisibility(context.Background(), SnoozedLabel))
+	require.Equal(t, imap.HiddenIfEmpty, c.GetMailboxVisibility(context.Background(), proton.AllScheduledLabel))
+
+	c.ShowAllMail(true)
+	c.SetSystemViews([]string{proton.StarredLabel})
+
+	require.Equal(t, imap.Visible, c.GetMailboxVisibility(context.Background(), proton.AllMailLabel))
+	require.Equal(t, imap.Visible, c.GetMailboxVisibility(context.Background(), proton.StarredLabel))
+	require.Equal(t, imap.Hidden, c.GetMailboxVisibility(context.Background(), SnoozedLabel))
+	require.Equal(t, imap.Hidden, c.GetMailboxVisibility(context.Background(), proton.AllScheduledLabel))
+}
+
+func TestToIMAPMailbox_SpecialUse(t *testing.T) {
+	attrs := defaultMailboxAttributes()
+
+	for labelID, attr := range map[string]string{
+		proton.TrashLabel:   imap.AttrTrash,
+		proton.SpamLabel:    imap.AttrJunk,
+		proton.AllMailLabel: imap.AttrAll,
+		proton.ArchiveLabel: imap.AttrArchive,
+		proton.SentLabel:    imap.AttrSent,
+		proton.DraftsLabel:  imap.AttrDrafts,
+		proton.StarredLabel: imap.AttrFlagged,
+	} {
+		mbox := toIMAPMailbox(proton.Label{ID: labelID, Type: proton.LabelTypeSystem}, nil, nil, attrs)
+		require.True(t, mbox.Attributes.Contains(attr), labelID)
+
+		// The special-use attributes match those of the mailboxes created by the sync.
+		update := newSystemMailboxCreatedUpdate(imap.MailboxID(labelID), "")
+		require.True(t, update.Mailbox.Attributes.Contains(attr), labelID)
+	}
+
+	mbox := toIMAPMailbox(proton.Label{ID: SnoozedLabel, Type: proton.LabelTypeSystem}, nil, nil, attrs)
+	require.Equal(t, attrs, mbox.Attributes)
+
+	mbox = toIMAPMailbox(proton.Label{ID: "folder", Path: []string{"Work"}, Type: proton.LabelTypeFolder}, nil, nil, attrs)
+	require.Equal(t, []string{folderPrefix, "Work"}, mbox.Name)
+	require.Equal(t, attrs, mbox.Attributes)
+}
+
+func TestIsOverQuota(t *testing.T) {
+	require.False(t, isOverQuota(100, 0, 1000))
+	require.False(t, isOverQuota(100, 1000, 900))
+	require.True(t, isOverQuota(100, 1000, 901))
+	require.True(t, isOverQuota(1000, 1000, 1))
+}
+
+func TestSessionMetadata_GetMailboxMetadata(t *testing.T) {
+	labels := newRWLabels()
+
+	wLabels := labels.Write()
+	wLabels.SetLabel(proton.InboxLabel, proton.Label{ID: proton.InboxLabel, Name: "Inbox", Type: proton.LabelTypeSystem}, "test")
+	wLabels.SetLabel("folder", proton.Label{ID: "folder", Name: "Old", Path: []string{"Work", "Old"}, Color: "#ff0000", Type: proton.LabelTypeFolder}, "test")
+	wLabels.Close()
+
+	metadata := &sessionMetadata{connector: &Connector{labels: labels}}
+
+	entries, err := metadata.GetMailboxMetadata("Folders/Work/Old")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{MetadataColor: "#ff0000"}, entries)
+
+	entries, err = metadata.GetMailboxMetadata("inbox")
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	_, err = metadata.GetMailboxMetadata("Folders/Work")
+	require.ErrorIs(t, err, ErrNoSuchMailbox)
+}
+
+func TestConnector_Authorize_UnknownSession(t *testing.T) {
+	binder := &fakeSessionBinder{}
+
+	newConnector := func(appPasswordID string, readOnly bool) *Connector {
+		return &Connector{
+			identityState: &fakeIdentity{appPasswordID: appPasswordID, readOnly: readOnly},
+			sessionBinder: binder,
+			log:           logrus.WithField("test", "test"),
+		}
+	}
+
+	unlabelled := context.Background()
+	labelled := pprof.WithLabels(context.Background(), pprof.Labels("SessionID", "7"))
+
+	// A login with the bridge password needs no binding.
+	require.True(t, newConnector("", false).Authorize(unlabelled, "user@pm.me", []byte("pass")))
+
+	// Logins with an app password or read-only credentials are refused when they can't be bound to their session.
+	require.False(t, newConnector("appPassword", false).Authorize(unlabelled, "user@pm.me", []byte("pass")))
+	require.False(t, newConnector("", true).Authorize(unlabelled, "user@pm.me", []byte("pass")))
+	require.Empty(t, binder.bound)
+
+	require.True(t, newConnector("appPassword", true).Authorize(labelled, "user@pm.me", []byte("pass")))
+	require.Equal(t, map[int]bool{7: true}, binder.bound)
+}
+
+func TestConnector_MoveMessages_Snoozed(t *testing.T) {
+	client := &fakeAPIClient{}
+
+	c := &Connector{
+		identityState: &fakeIdentity{},
+		client:        client,
+		log:           logrus.WithField("test", "test"),
+	}
+
+	// Messages can't be snoozed.
+	_, err := c.MoveMessages(context.Background(), nil, []imap.MessageID{"msg"}, proton.InboxLabel, SnoozedLabel)
+	require.ErrorIs(t, err, connector.ErrOperationNotAllowed)
+	require.Empty(t, client.labeled)
+
+	// Moving them out of Snoozed files them in the destination and leaves Snoozed to the API.
+	expunge, err := c.MoveMessages(context.Background(), nil, []imap.MessageID{"msg"}, SnoozedLabel, proton.ArchiveLabel)
+	require.NoError(t, err)
+	require.False(t, expunge)
+	require.Equal(t, map[string][]string{proton.ArchiveLabel: {"msg"}}, client.labeled)
+}
+
+type fakeAPIClient struct {
+	APIClient
+
+	labeled map[string][]string
+}
+
+func (f *fakeAPIClient) LabelMessages(_ context.Context, messageIDs []string, labelID string) error {
+	if f.labeled == nil {
+		f.labeled = make(map[string][]string)
+	}
+
+	f.labeled[labelID] = append(f.labeled[labelID], messageIDs...)
+
+	return nil
+}
+
+type fakeIdentity struct {
+	sharedIdentity
+
+	appPasswordID string
+	readOnly      bool
+}
+
+func (f *fakeIdentity) CheckAuth(string, []byte) (string, string, bool, error) {
+	return "addrID", f.appPasswordID, f.readOnly, nil
+}
+
+func (f *fakeIdentity) ReadOnly() bool {
+	return false
+}
+
+func (f *fakeIdentity) Quota() (uint64, uint64) {
+	return 0, 0
+}
+
+type fakeSessionBinder struct {
+	sessionBinder
+
+	bound map[int]bool
+}
+
+func (f *fakeSessionBinder) BindIMAPSession(sessionID int, _ string, readOnly bool) {
+	if f.bound == nil {
+		f.bound = make(map[int]bool)
+	}
+
+	f.bound[sessionID] = readOnly
+}
+
+func (f *fakeSessionBinder) IsIMAPSessionLockedOut(int) bool {
+	return false
+}
+
+func (f *fakeSessionBinder) BindIMAPSessionQuota(int, func() (uint64, uint64)) {}
+
+func (f *fakeSessionBinder) BindIMAPSessionMetadata(int, MailboxMetadata) {}
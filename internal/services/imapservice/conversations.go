@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sync"
+
+	"github.com/ProtonMail/proton-bridge/v3/pkg/message"
+	"github.com/go-resty/resty/v2"
+)
+
+// maxConversationIDs bounds the conversation IDs recorded, as some messages are downloaded for another purpose than
+// building their literal, e.g. to send a reply, and their conversation ID is never taken.
+const maxConversationIDs = 1 << 16
+
+// messagePathRegexp matches the path of the API requests returning a single message.
+var messagePathRegexp = regexp.MustCompile(`/mail/v4/messages/[^/]+$`) //nolint:gochecknoglobals
+
+// ConversationIDs records the conversation IDs of the messages downloaded from the API, until the literal of the
+// messages is built with them. go-proton-api doesn't decode the conversation ID of the messages, so it is decoded from
+// the responses of the API by a post-request hook of the client, see RecordResponse.
+type ConversationIDs struct {
+	ids  map[string]string
+	lock sync.Mutex
+}
+
+func NewConversationIDs() *ConversationIDs {
+	return &ConversationIDs{ids: make(map[string]string)}
+}
+
+// RecordResponse records the conversation ID of the message returned by the API, if any. It is meant to be added as a
+// post-request hook of the API client.
+func (c *ConversationIDs) RecordResponse(_ *resty.Client, res *resty.Response) error {
+	if res.Request == nil || res.Request.Method != http.MethodGet || !res.IsSuccess() {
+		return nil
+	}
+
+	if u, err := url.Parse(res.Request.URL); err != nil || !messagePathRegexp.MatchString(u.Path) {
+		return nil
+	}
+
+	var body struct {
+		Message struct {
+			ID             string
+			ConversationID string
+		}
+	}
+
+	if err := json.Unmarshal(res.Body(), &body); err != nil || body.Message.ID == "" || body.Message.ConversationID == "" {
+		return nil
+	}
+
+	c.record(body.Message.ID, body.Message.ConversationID)
+
+	return nil
+}
+
+// record records the conversation ID of the message, forgetting another message if there are too many of them.
+func (c *ConversationIDs) record(messageID, conversationID string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, ok := c.ids[messageID]; !ok && len(c.ids) >= maxConversationIDs {
+		for id := range c.ids {
+			delete(c.ids, id)
+			break
+		}
+	}
+
+	c.ids[messageID] = conversationID
+}
+
+// take returns the conversation ID recorded for the message, if any, and forgets it.
+func (c *ConversationIDs) take(messageID string) string {
+	if c == nil {
+		return ""
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	conversationID := c.ids[messageID]
+	delete(c.ids, messageID)
+
+	return conversationID
+}
+
+// messageJobOpts returns the options to build the literal of the message, with its conversation ID if recorded.
+func (c *ConversationIDs) messageJobOpts(messageID string) message.JobOptions {
+	opts := defaultMessageJobOpts()
+	opts.ConversationID = c.take(messageID)
+
+	return opts
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapservice
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConversationIDs_RecordResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/mail/v4/messages/messageID":
+			_, _ = w.Write([]byte(`{"Code":1000,"Message":{"ID":"messageID","ConversationID":"conversationID"}}`))
+
+		default:
+			_, _ = w.Write([]byte(`{"Code":1000,"Message":{"ID":"otherID","ConversationID":"otherConversationID"}}`))
+		}
+	}))
+	defer server.Close()
+
+	manager := proton.New(proton.WithHostURL(server.URL))
+	defer manager.Close()
+
+	client := manager.NewClient("uid", "acc", "ref")
+	defer client.Close()
+
+	conversationIDs := NewConversationIDs()
+	client.AddPostRequestHook(conversationIDs.RecordResponse)
+
+	// The conversation ID of the message returned by the API is recorded until taken.
+	message, err := client.GetMessage(context.Background(), "messageID")
+	require.NoError(t, err)
+	require.Equal(t, "messageID", message.ID)
+
+	require.Equal(t, "conversationID", conversationIDs.messageJobOpts("messageID").ConversationID)
+	require.Empty(t, conversationIDs.take("messageID"))
+
+	// Only the responses returning a single message are decoded.
+	_, err = client.GetLabels(context.Background(), proton.LabelTypeSystem)
+	require.NoError(t, err)
+	require.Empty(t, conversationIDs.take("otherID"))
+
+	// The connectors built without them leave the conversation ID out.
+	require.Empty(t, (*ConversationIDs)(nil).messageJobOpts("messageID").ConversationID)
+}
+
+func TestConversationIDs_Bounded(t *testing.T) {
+	conversationIDs := NewConversationIDs()
+
+	for idx := 0; idx < maxConversationIDs; idx++ {
+		conversationIDs.ids[strconv.Itoa(idx)] = "conversationID"
+	}
+
+	conversationIDs.record("messageID", "conversationID")
+	require.Len(t, conversationIDs.ids, maxConversationIDs)
+	require.Equal(t, "conversationID", conversationIDs.take("messageID"))
+}
@@ -29,11 +29,19 @@ import (
 	"github.com/bradenaw/juniper/xslices"
 )
 
+// System labels which go-proton-api does not define.
+const (
+	AlmostAllMailLabel = "15"
+	SnoozedLabel       = "16"
+)
+
 func toIMAPMailbox(label proton.Label, flags, permFlags, attrs imap.FlagSet) imap.Mailbox {
 	if label.Type == proton.LabelTypeLabel {
 		label.Path = append([]string{labelPrefix}, label.Path...)
 	} else if label.Type == proton.LabelTypeFolder {
 		label.Path = append([]string{folderPrefix}, label.Path...)
+	} else if label.Type == proton.LabelTypeSystem {
+		attrs = attrs.AddFlagSet(systemMailboxAttributes(imap.MailboxID(label.ID)))
 	}
 
 	return imap.Mailbox{
@@ -45,8 +53,51 @@ func toIMAPMailbox(label proton.Label, flags, permFlags, attrs imap.FlagSet) ima
 	}
 }
 
-func isAllMailOrScheduled(mailboxID imap.MailboxID) bool {
-	return (mailboxID == proton.AllMailLabel) || (mailboxID == proton.AllScheduledLabel)
+// systemMailboxAttributes returns the RFC 6154 special-use attributes of the mailbox of a system label.
+func systemMailboxAttributes(labelID imap.MailboxID) imap.FlagSet {
+	switch labelID {
+	case proton.TrashLabel:
+		return imap.NewFlagSet(imap.AttrTrash)
+
+	case proton.SpamLabel:
+		return imap.NewFlagSet(imap.AttrJunk)
+
+	case proton.AllMailLabel:
+		return imap.NewFlagSet(imap.AttrAll)
+
+	case proton.ArchiveLabel:
+		return imap.NewFlagSet(imap.AttrArchive)
+
+	case proton.SentLabel:
+		return imap.NewFlagSet(imap.AttrSent)
+
+	case proton.DraftsLabel:
+		return imap.NewFlagSet(imap.AttrDrafts)
+
+	case proton.StarredLabel:
+		return imap.NewFlagSet(imap.AttrFlagged)
+
+	default:
+		return imap.NewFlagSet()
+	}
+}
+
+// isVirtualMailbox returns whether the mailbox is a view of messages maintained by the API, which messages cannot be
+// added to or removed from.
+func isVirtualMailbox(mailboxID imap.MailboxID) bool {
+	switch mailboxID {
+	case proton.AllMailLabel, proton.AllScheduledLabel, AlmostAllMailLabel:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// isOverQuota returns whether a message of the given size doesn't fit in the space left to the account, which is
+// unlimited if maxSpace is 0.
+func isOverQuota(used, maxSpace uint64, size int) bool {
+	return maxSpace > 0 && used+uint64(size) > maxSpace //nolint:gosec // disable G115
 }
 
 func BuildFlagSetFromMessageMetadata(message proton.MessageMetadata) imap.FlagSet {
@@ -169,6 +220,12 @@ func WantLabel(label proton.Label) bool {
 	case proton.AllScheduledLabel:
 		return true
 
+	case AlmostAllMailLabel:
+		return true
+
+	case SnoozedLabel:
+		return true
+
 	default:
 		return false
 	}
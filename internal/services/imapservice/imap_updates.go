@@ -31,34 +31,15 @@ func newSystemMailboxCreatedUpdate(labelID imap.MailboxID, labelName string) *im
 		labelName = imap.Inbox
 	}
 
-	attrs := imap.NewFlagSet(imap.AttrNoInferiors)
+	attrs := imap.NewFlagSet(imap.AttrNoInferiors).AddFlagSet(systemMailboxAttributes(labelID))
 	permanentFlags := defaultMailboxPermanentFlags()
 	flags := defaultMailboxFlags()
 
 	switch labelID {
-	case proton.TrashLabel:
-		attrs = attrs.Add(imap.AttrTrash)
-
-	case proton.SpamLabel:
-		attrs = attrs.Add(imap.AttrJunk)
-
 	case proton.AllMailLabel:
-		attrs = attrs.Add(imap.AttrAll)
 		flags = imap.NewFlagSet(imap.FlagSeen, imap.FlagFlagged)
 		permanentFlags = imap.NewFlagSet(imap.FlagSeen, imap.FlagFlagged)
 
-	case proton.ArchiveLabel:
-		attrs = attrs.Add(imap.AttrArchive)
-
-	case proton.SentLabel:
-		attrs = attrs.Add(imap.AttrSent)
-
-	case proton.DraftsLabel:
-		attrs = attrs.Add(imap.AttrDrafts)
-
-	case proton.StarredLabel:
-		attrs = attrs.Add(imap.AttrFlagged)
-
 	case proton.AllScheduledLabel:
 		labelName = "Scheduled" // API actual name is "All Scheduled"
 	}
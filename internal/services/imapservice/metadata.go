@@ -0,0 +1,138 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+package imapservice
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/ProtonMail/gluon/imap"
+	"github.com/ProtonMail/go-proton-api"
+)
+
+// The RFC 5464 entries of the mailboxes. Only the colour is a property of the labels in the API; the others are
+// listed by the mail clients but have no value and cannot be set.
+const (
+	MetadataColor   = "/private/color"
+	MetadataComment = "/private/comment"
+	MetadataNotify  = "/private/vendor/proton/notify"
+)
+
+var (
+	ErrNoSuchMailbox        = errors.New("no such mailbox")
+	ErrMetadataNotSupported = errors.New("the entry cannot be set")
+)
+
+// MailboxMetadata gives access to the RFC 5464 entries of the mailboxes of an IMAP session, which gluon doesn't
+// support. The mailboxes are given by their name, decoded from modified UTF-7.
+type MailboxMetadata interface {
+	// GetMailboxMetadata returns the entries of the mailbox which have a value.
+	GetMailboxMetadata(mailbox string) (map[string]string, error)
+
+	// SetMailboxMetadata sets the entries of the mailbox, the entries given without a value being removed.
+	SetMailboxMetadata(ctx context.Context, mailbox string, entries map[string]*string) error
+}
+
+// sessionMetadata reads the entries of the mailboxes from the labels, kept up to date by the label events, and writes
+// them through the API.
+type sessionMetadata struct {
+	connector *Connector
+	sessionID int
+}
+
+func (m *sessionMetadata) GetMailboxMetadata(mailbox string) (map[string]string, error) {
+	label, ok := m.connector.getMailboxLabel(mailbox)
+	if !ok {
+		return nil, ErrNoSuchMailbox
+	}
+
+	entries := make(map[string]string)
+
+	if label.Color != "" {
+		entries[MetadataColor] = label.Color
+	}
+
+	return entries, nil
+}
+
+func (m *sessionMetadata) SetMailboxMetadata(ctx context.Context, mailbox string, entries map[string]*string) error {
+	if m.connector.identityState.ReadOnly() || m.connector.sessionBinder.IsIMAPSessionReadOnly(m.sessionID) {
+		return ErrReadOnlySession
+	}
+
+	label, ok := m.connector.getMailboxLabel(mailbox)
+	if !ok {
+		return ErrNoSuchMailbox
+	}
+
+	// The labels always have a colour, which only the folders and labels of the user can change.
+	for entry, value := range entries {
+		if entry != MetadataColor || value == nil || label.Type == proton.LabelTypeSystem {
+			return ErrMetadataNotSupported
+		}
+	}
+
+	color, ok := entries[MetadataColor]
+	if !ok {
+		return nil
+	}
+
+	label, err := m.connector.client.GetLabel(ctx, label.ID, label.Type)
+	if err != nil {
+		return err
+	}
+
+	update, err := m.connector.client.UpdateLabel(ctx, label.ID, proton.UpdateLabelReq{
+		Name:     label.Name,
+		Color:    *color,
+		ParentID: label.ParentID,
+	})
+	if err != nil {
+		return err
+	}
+
+	wLabels := m.connector.labels.Write()
+	defer wLabels.Close()
+
+	wLabels.SetLabel(label.ID, update, "connectorSetMailboxMetadata")
+
+	return nil
+}
+
+// getMailboxLabel returns the label shown as the mailbox of the given name, whose levels are separated by gluon's
+// default delimiter.
+func (s *Connector) getMailboxLabel(mailbox string) (proton.Label, bool) {
+	rdLabels := s.labels.Read()
+	defer rdLabels.Close()
+
+	for _, label := range rdLabels.GetLabels() {
+		if !WantLabel(label) {
+			continue
+		}
+
+		if label.ID == proton.InboxLabel && strings.EqualFold(mailbox, imap.Inbox) {
+			return label, true
+		}
+
+		if strings.Join(GetMailboxName(label), "/") == mailbox {
+			return label, true
+		}
+	}
+
+	return proton.Label{}, false
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ProtonMail/gluon"
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/proton-bridge/v3/internal/logging"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/cpc"
+)
+
+// RepairMessagesReq lists the messages whose IMAP state should be brought back in line with the server state.
+type RepairMessagesReq struct {
+	// Update contains messages that exist on the server but whose IMAP state (existence, mailboxes or flags) is wrong.
+	Update []proton.MessageMetadata
+
+	// Delete contains the IDs of messages that are present over IMAP but no longer exist on the server.
+	Delete []string
+}
+
+// RepairMessagesResult reports the outcome of a repair per message ID.
+type RepairMessagesResult struct {
+	Repaired []string
+	Failed   map[string]error
+}
+
+// RepairMessages applies the minimal gluon updates needed to fix the given messages. The repair is executed in the
+// service loop so that it cannot interleave with the handling of API events.
+func (s *Service) RepairMessages(ctx context.Context, req RepairMessagesReq) (RepairMessagesResult, error) {
+	return cpc.SendTyped[RepairMessagesResult](ctx, s.cpc, &repairMessagesReq{req: req})
+}
+
+func (s *Service) repairMessages(ctx context.Context, req RepairMessagesReq) RepairMessagesResult {
+	result := RepairMessagesResult{Failed: make(map[string]error)}
+
+	for _, message := range req.Update {
+		ctx := logging.WithLogrusField(ctx, "action", "repair message")
+
+		if err := s.repairMessage(ctx, message); err != nil {
+			s.log.WithField("messageID", message.ID).WithError(err).Error("Failed to repair message")
+			result.Failed[message.ID] = err
+		} else {
+			result.Repaired = append(result.Repaired, message.ID)
+		}
+	}
+
+	for _, messageID := range req.Delete {
+		ctx := logging.WithLogrusField(ctx, "action", "repair deleted message")
+
+		updates := onMessageDeleted(ctx, s, proton.MessageEvent{EventItem: proton.EventItem{ID: messageID}})
+
+		if err := waitOnIMAPUpdates(ctx, updates); err != nil {
+			s.log.WithField("messageID", messageID).WithError(err).Error("Failed to remove message")
+			result.Failed[messageID] = err
+		} else {
+			result.Repaired = append(result.Repaired, messageID)
+		}
+	}
+
+	return result
+}
+
+// repairMessage resets the mailboxes and flags of the message and creates it if gluon doesn't know about it.
+func (s *Service) repairMessage(ctx context.Context, message proton.MessageMetadata) error {
+	updates, err := onMessageUpdate(ctx, s, message, false)
+	if err != nil {
+		return err
+	}
+
+	err = waitOnIMAPUpdates(ctx, updates)
+	if err == nil || !gluon.IsNoSuchMessage(err) {
+		return err
+	}
+
+	updates, err = onMessageCreated(ctx, s, message, false, false)
+	if err != nil {
+		return fmt.Errorf("failed to create message: %w", err)
+	}
+
+	if len(updates) == 0 {
+		return fmt.Errorf("message could not be built")
+	}
+
+	return waitOnIMAPUpdates(ctx, updates)
+}
+
+type repairMessagesReq struct {
+	req RepairMessagesReq
+}
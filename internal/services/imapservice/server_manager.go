@@ -45,6 +45,29 @@ type IMAPServerManager interface {
 	GetOpenIMAPSessionCount() int
 
 	GetRollingIMAPConnectionCount() int
+
+	// BindIMAPSession records that the IMAP session authenticated with the given app password, if any,
+	// and whether it can only read the mailbox.
+	BindIMAPSession(sessionID int, appPasswordID string, readOnly bool)
+
+	// IsIMAPSessionReadOnly returns whether the IMAP session can only read the mailbox.
+	IsIMAPSessionReadOnly(sessionID int) bool
+
+	// IsIMAPSessionLockedOut returns whether the host of the IMAP session is locked out after too many failed
+	// authentications, in which case it can't authenticate.
+	IsIMAPSessionLockedOut(sessionID int) bool
+
+	// BindIMAPSessionQuota records how to get the space used by the account of the IMAP session and its limit,
+	// to answer its QUOTA commands.
+	BindIMAPSessionQuota(sessionID int, quota func() (uint64, uint64))
+
+	// BindIMAPSessionMetadata records how to read and write the entries of the mailboxes of the IMAP session,
+	// to answer its METADATA commands.
+	BindIMAPSessionMetadata(sessionID int, metadata MailboxMetadata)
+
+	// SetIMAPSessionResponseCode makes the IMAP server add the response code to the refusal of the command the IMAP
+	// session is running, as gluon can't send it.
+	SetIMAPSessionResponseCode(sessionID int, code ResponseCode)
 }
 
 type NullIMAPServerManager struct{}
@@ -92,6 +115,22 @@ func (n NullIMAPServerManager) GetRollingIMAPConnectionCount() int {
 	return 0
 }
 
+func (n NullIMAPServerManager) BindIMAPSession(_ int, _ string, _ bool) {}
+
+func (n NullIMAPServerManager) IsIMAPSessionReadOnly(_ int) bool {
+	return false
+}
+
+func (n NullIMAPServerManager) IsIMAPSessionLockedOut(_ int) bool {
+	return false
+}
+
+func (n NullIMAPServerManager) BindIMAPSessionQuota(_ int, _ func() (uint64, uint64)) {}
+
+func (n NullIMAPServerManager) BindIMAPSessionMetadata(_ int, _ MailboxMetadata) {}
+
+func (n NullIMAPServerManager) SetIMAPSessionResponseCode(_ int, _ ResponseCode) {}
+
 func NewNullIMAPServerManager() *NullIMAPServerManager {
 	return &NullIMAPServerManager{}
 }
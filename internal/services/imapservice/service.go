@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/gluon/imap"
 	"github.com/ProtonMail/gluon/reporter"
 	"github.com/ProtonMail/gluon/watcher"
 	"github.com/ProtonMail/go-proton-api"
@@ -39,6 +40,7 @@ import (
 	"github.com/ProtonMail/proton-bridge/v3/internal/unleash"
 	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/cpc"
+	"github.com/bradenaw/juniper/xslices"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
 )
@@ -60,10 +62,12 @@ type Service struct {
 	log *logrus.Entry
 	cpc *cpc.CPC
 
-	client        APIClient
-	identityState *rwIdentity
-	labels        *rwLabels
-	addressMode   usertypes.AddressMode
+	client          APIClient
+	conversationIDs *ConversationIDs
+	identityState   *rwIdentity
+	labels          *rwLabels
+	addressMode     usertypes.AddressMode
+	addressGroups   usertypes.AddressGroups
 
 	subscription *userevents.EventChanneledSubscriber
 
@@ -79,8 +83,10 @@ type Service struct {
 	eventSubscription events.Subscription
 	eventWatcher      *watcher.Watcher[events.Event]
 	connectors        map[string]*Connector
+	resyncingKeys     []string
 	maxSyncMemory     uint64
 	showAllMail       bool
+	systemViews       []string
 
 	syncHandler        *syncservice.Handler
 	syncUpdateApplier  *SyncUpdateApplier
@@ -99,6 +105,7 @@ type Service struct {
 
 func NewService(
 	client APIClient,
+	conversationIDs *ConversationIDs,
 	identityState *useridentity.State,
 	gluonIDProvider GluonIDProvider,
 	eventProvider EventProvider,
@@ -110,10 +117,12 @@ func NewService(
 	sendRecorder *sendrecorder.SendRecorder,
 	reporter reporter.Reporter,
 	addressMode usertypes.AddressMode,
+	addressGroups usertypes.AddressGroups,
 	subscription events.Subscription,
 	syncConfigDir string,
 	maxSyncMemory uint64,
 	showAllMail bool,
+	systemViews []string,
 	observabilitySender observability.Sender,
 	featureFlagProvider unleash.FeatureFlagValueProvider,
 ) *Service {
@@ -127,16 +136,18 @@ func NewService(
 
 	labelConflictManager := NewLabelConflictManager(serverManager, gluonIDProvider, client, reporter, featureFlagProvider)
 	syncUpdateApplier := NewSyncUpdateApplier(labelConflictManager)
-	syncMessageBuilder := NewSyncMessageBuilder(rwIdentity)
+	syncMessageBuilder := NewSyncMessageBuilder(rwIdentity, conversationIDs)
 	syncReporter := newSyncReporter(identityState.User.ID, eventPublisher, time.Second)
 
 	service := &Service{
-		cpc:           cpc.NewCPC(),
-		client:        client,
-		log:           log,
-		identityState: rwIdentity,
-		labels:        newRWLabels(),
-		addressMode:   addressMode,
+		cpc:             cpc.NewCPC(),
+		client:          client,
+		conversationIDs: conversationIDs,
+		log:             log,
+		identityState:   rwIdentity,
+		labels:          newRWLabels(),
+		addressMode:     addressMode,
+		addressGroups:   addressGroups,
 
 		gluonIDProvider: gluonIDProvider,
 		serverManager:   serverManager,
@@ -155,6 +166,7 @@ func NewService(
 		eventWatcher:      subscription.Add(events.IMAPServerCreated{}, events.ConnStatusUp{}, events.ConnStatusDown{}),
 		eventSubscription: subscription,
 		showAllMail:       showAllMail,
+		systemViews:       systemViews,
 
 		syncUpdateApplier:  syncUpdateApplier,
 		syncMessageBuilder: syncMessageBuilder,
@@ -190,7 +202,7 @@ func (s *Service) Start(
 		s.client,
 		s.identityState.UserID(),
 		s.syncStateProvider,
-		s.log,
+		s.log.WithField("service", "sync"),
 		s.panicHandler,
 		s.reporter)
 
@@ -215,6 +227,8 @@ func (s *Service) Start(
 		return err
 	}
 
+	s.addSystemMailboxes(ctx)
+
 	group.Go(ctx, s.identityState.identity.User.ID, "imap-service", s.run)
 	return nil
 }
@@ -225,6 +239,12 @@ func (s *Service) SetAddressMode(ctx context.Context, mode usertypes.AddressMode
 	return err
 }
 
+func (s *Service) SetAddressGroups(ctx context.Context, groups usertypes.AddressGroups) error {
+	_, err := s.cpc.Send(ctx, &setAddressGroupsReq{groups: groups})
+
+	return err
+}
+
 func (s *Service) Resync(ctx context.Context) error {
 	_, err := s.cpc.Send(ctx, &resyncReq{})
 
@@ -255,6 +275,13 @@ func (s *Service) OnDelete(ctx context.Context) error {
 	return err
 }
 
+// SetSystemViews sets the IDs of the system views which are shown, e.g. Starred or Snoozed.
+func (s *Service) SetSystemViews(ctx context.Context, labelIDs []string) error {
+	_, err := s.cpc.Send(ctx, &setSystemViewsReq{labelIDs: labelIDs})
+
+	return err
+}
+
 func (s *Service) ShowAllMail(ctx context.Context, v bool) error {
 	_, err := s.cpc.Send(ctx, &showAllMailReq{v: v})
 
@@ -269,6 +296,10 @@ func (s *Service) GetSyncFailedMessageIDs(ctx context.Context) ([]string, error)
 	return cpc.SendTyped[[]string](ctx, s.cpc, &getSyncFailedMessagesReq{})
 }
 
+func (s *Service) GetSyncStatus(ctx context.Context) (syncservice.Status, error) {
+	return cpc.SendTyped[syncservice.Status](ctx, s.cpc, &getSyncStatusReq{})
+}
+
 func (s *Service) Close() {
 	for _, c := range s.connectors {
 		c.StateClose()
@@ -288,6 +319,7 @@ func (s *Service) HandleRefreshEvent(ctx context.Context, _ proton.RefreshFlag)
 	}
 
 	s.cancelSync()
+	s.resyncingKeys = nil
 
 	if err := s.removeConnectorsFromServer(ctx, s.connectors, true); err != nil {
 		return err
@@ -320,6 +352,17 @@ func (s *Service) HandleUserEvent(_ context.Context, user *proton.User) error {
 	})
 }
 
+// HandleUsedSpaceEvent keeps the used space up to date, to answer the QUOTA commands.
+func (s *Service) HandleUsedSpaceEvent(_ context.Context, newSpace int64) error {
+	s.log.Debug("handling used space event")
+
+	return s.identityState.Write(func(identity *useridentity.State) error {
+		identity.OnUserSpaceChanged(uint64(newSpace)) //nolint:gosec // disable G115
+
+		return nil
+	})
+}
+
 func (s *Service) run(ctx context.Context) { //nolint gocyclo
 	s.log.Info("Starting IMAP Service")
 	defer s.log.Info("Exiting IMAP Service")
@@ -331,11 +374,12 @@ func (s *Service) run(ctx context.Context) { //nolint gocyclo
 	s.startSyncing()
 
 	eventHandler := userevents.EventHandler{
-		UserHandler:    s,
-		AddressHandler: s,
-		RefreshHandler: s,
-		LabelHandler:   s,
-		MessageHandler: s,
+		UserHandler:      s,
+		AddressHandler:   s,
+		RefreshHandler:   s,
+		LabelHandler:     s,
+		MessageHandler:   s,
+		UsedSpaceHandler: s,
 	}
 
 	syncEventHandler := s.newSyncEventHandler()
@@ -358,6 +402,11 @@ func (s *Service) run(ctx context.Context) { //nolint gocyclo
 				err := s.setAddressMode(ctx, r.mode)
 				req.Reply(ctx, nil, err)
 
+			case *setAddressGroupsReq:
+				s.log.Debug("Set Address Groups Request")
+				err := s.setAddressGroups(ctx, r.groups)
+				req.Reply(ctx, nil, err)
+
 			case *resyncReq:
 				s.log.Info("Received resync request, handling as refresh event")
 				err := s.HandleRefreshEvent(ctx, 0)
@@ -400,6 +449,11 @@ func (s *Service) run(ctx context.Context) { //nolint gocyclo
 				req.Reply(ctx, nil, nil)
 				s.setShowAllMail(r.v)
 
+			case *setSystemViewsReq:
+				s.log.Debug("Set system views request")
+				req.Reply(ctx, nil, nil)
+				s.setSystemViews(r.labelIDs)
+
 			case *getSyncFailedMessagesReq:
 				s.log.Debug("Get sync failed messages Request")
 				status, err := s.syncStateProvider.GetSyncStatus(ctx)
@@ -410,6 +464,21 @@ func (s *Service) run(ctx context.Context) { //nolint gocyclo
 
 				req.Reply(ctx, maps.Keys(status.FailedMessages), nil)
 
+			case *getSyncStatusReq:
+				s.log.Debug("Get sync status Request")
+				status, err := s.syncStateProvider.GetSyncStatus(ctx)
+				if err != nil {
+					req.Reply(ctx, nil, fmt.Errorf("failed to get sync status: %w", err))
+					continue
+				}
+
+				req.Reply(ctx, status, nil)
+
+			case *repairMessagesReq:
+				s.log.Debug("Repair messages Request")
+				result := s.repairMessages(ctx, r.req)
+				req.Reply(ctx, result, nil)
+
 			default:
 				s.log.Error("Received unknown request")
 			}
@@ -425,6 +494,8 @@ func (s *Service) run(ctx context.Context) { //nolint gocyclo
 					continue
 				}
 
+				s.resyncingKeys = nil
+
 				// Start a goroutine to wait on event reset as it is possible that the sync received message
 				// was processed during an event publish. This in turn will block the imap service, since the
 				// event service is unable to reply to the request until the events have been processed.
@@ -459,7 +530,7 @@ func (s *Service) run(ctx context.Context) { //nolint gocyclo
 				continue
 			}
 
-			updates, err := request(ctx, s.addressMode, s.connectors)
+			updates, err := request(ctx, s.addressMode, s.addressGroups, s.getSyncedConnectors())
 
 			if err := s.syncUpdateApplier.reply(ctx, updates, err); err != nil {
 				if !errors.Is(err, context.Canceled) {
@@ -529,42 +600,59 @@ func (s *Service) buildConnectors() (map[string]*Connector, error) {
 			return nil, fmt.Errorf("failed to build connector for combined mode: %w", err)
 		}
 
-		connectors[addr.ID] = NewConnector(
-			addr.ID,
-			s.client,
-			s.labels,
-			s.identityState,
-			s.addressMode,
-			s.sendRecorder,
-			s.panicHandler,
-			s.reporter,
-			s.showAllMail,
-			s.syncStateProvider,
-			s.serverManager,
-		)
+		connectors[addr.ID] = s.newConnector(addr.ID)
 
 		return connectors, nil
 	}
 
+	// The addresses of a group share the connector of the first one.
 	for _, addr := range s.identityState.GetAddresses() {
-		connectors[addr.ID] = NewConnector(
-			addr.ID,
-			s.client,
-			s.labels,
-			s.identityState,
-			s.addressMode,
-			s.sendRecorder,
-			s.panicHandler,
-			s.reporter,
-			s.showAllMail,
-			s.syncStateProvider,
-			s.serverManager,
-		)
+		key := s.addressGroups.Key(addr.ID)
+
+		if _, ok := connectors[key]; !ok {
+			connectors[key] = s.newConnector(key)
+		}
 	}
 
 	return connectors, nil
 }
 
+// newConnector creates the connector of the mailbox stored under the given address ID.
+func (s *Service) newConnector(addrID string) *Connector {
+	var groupAddrIDs []string
+
+	if s.addressMode == usertypes.AddressModeSplit {
+		groupAddrIDs = s.addressGroups.Members(addrID)
+	}
+
+	return NewConnector(
+		addrID,
+		groupAddrIDs,
+		s.client,
+		s.conversationIDs,
+		s.labels,
+		s.identityState,
+		s.addressMode,
+		s.sendRecorder,
+		s.panicHandler,
+		s.reporter,
+		s.showAllMail,
+		s.systemViews,
+		s.syncStateProvider,
+		s.serverManager,
+		s.serverManager,
+	)
+}
+
+// getConnectorKey returns the ID of the address the connector of the given address is stored under.
+func (s *Service) getConnectorKey(addrID string) string {
+	if s.addressMode == usertypes.AddressModeSplit {
+		return s.addressGroups.Key(addrID)
+	}
+
+	return addrID
+}
+
 func (s *Service) rebuildConnectors() error {
 	newConnectors, err := s.buildConnectors()
 	if err != nil {
@@ -633,6 +721,7 @@ func (s *Service) setAddressMode(ctx context.Context, mode usertypes.AddressMode
 	}
 
 	s.cancelSync()
+	s.resyncingKeys = nil
 
 	if err := s.removeConnectorsFromServer(ctx, s.connectors, true); err != nil {
 		return err
@@ -655,6 +744,95 @@ func (s *Service) setAddressMode(ctx context.Context, mode usertypes.AddressMode
 	return nil
 }
 
+// setAddressGroups changes the addresses sharing a mailbox in split mode. Only the mailboxes of the addresses whose
+// group changed are recreated and resynced, the other ones are left untouched.
+func (s *Service) setAddressGroups(ctx context.Context, groups usertypes.AddressGroups) error {
+	oldGroups := s.addressGroups
+	s.addressGroups = groups
+
+	if s.addressMode != usertypes.AddressModeSplit {
+		return nil
+	}
+
+	addrIDs := xslices.Map(s.identityState.GetAddresses(), func(addr proton.Address) string { return addr.ID })
+
+	oldKeys, newKeys := oldGroups.ChangedKeys(groups, addrIDs)
+	if len(newKeys) == 0 {
+		return nil
+	}
+
+	s.log.WithField("mailboxes", len(newKeys)).Info("Setting address groups")
+
+	status, err := s.syncStateProvider.GetSyncStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get sync status: %w", err)
+	}
+
+	s.cancelSync()
+
+	removed := make(map[string]*Connector)
+
+	for _, key := range oldKeys {
+		if c, ok := s.connectors[key]; ok {
+			removed[key] = c
+		}
+	}
+
+	if err := s.removeConnectorsFromServer(ctx, removed, true); err != nil {
+		return err
+	}
+
+	for key, c := range removed {
+		c.StateClose()
+		delete(s.connectors, key)
+	}
+
+	added := make(map[string]*Connector)
+
+	for _, key := range newKeys {
+		added[key] = s.newConnector(key)
+		s.connectors[key] = added[key]
+	}
+
+	if err := s.addConnectorsToServer(ctx, added); err != nil {
+		return err
+	}
+
+	// If the other mailboxes are already synced, only the new ones are. Otherwise, they are all still being synced.
+	if status.IsComplete() || len(s.resyncingKeys) > 0 {
+		s.resyncingKeys = append(xslices.Filter(s.resyncingKeys, func(key string) bool {
+			_, ok := s.connectors[key]
+			return ok
+		}), newKeys...)
+	}
+
+	if err := s.syncStateProvider.ClearSyncStatus(ctx); err != nil {
+		return fmt.Errorf("failed to clear sync status:%w", err)
+	}
+
+	s.startSyncing()
+
+	return nil
+}
+
+// getSyncedConnectors returns the connectors the sync applies to: the ones being resynced after their address group
+// changed, or all of them.
+func (s *Service) getSyncedConnectors() map[string]*Connector {
+	if len(s.resyncingKeys) == 0 {
+		return s.connectors
+	}
+
+	connectors := make(map[string]*Connector, len(s.resyncingKeys))
+
+	for _, key := range s.resyncingKeys {
+		if c, ok := s.connectors[key]; ok {
+			connectors[key] = c
+		}
+	}
+
+	return connectors
+}
+
 func (s *Service) setShowAllMail(v bool) {
 	if s.showAllMail == v {
 		return
@@ -667,6 +845,28 @@ func (s *Service) setShowAllMail(v bool) {
 	}
 }
 
+func (s *Service) setSystemViews(labelIDs []string) {
+	s.systemViews = labelIDs
+
+	for _, c := range s.connectors {
+		c.SetSystemViews(labelIDs)
+	}
+}
+
+// addSystemMailboxes creates the mailboxes of the system labels which are missing, such as the views added after the
+// account was synced. Their messages are then only added as they change, until the account is synced again.
+func (s *Service) addSystemMailboxes(ctx context.Context) {
+	for _, label := range s.labels.GetLabelMap() {
+		if label.Type != proton.LabelTypeSystem || !WantLabel(label) {
+			continue
+		}
+
+		for _, c := range s.connectors {
+			c.publishUpdate(ctx, newSystemMailboxCreatedUpdate(imap.MailboxID(label.ID), label.Name))
+		}
+	}
+}
+
 func (s *Service) startSyncing() {
 	s.isSyncing.Store(true)
 	s.syncHandler.Execute(s.syncReporter, s.labels.GetLabelMap(), s.syncUpdateApplier, s.syncMessageBuilder, syncservice.DefaultRetryCoolDown, s.LabelConflictChecker)
@@ -693,14 +893,22 @@ type onLogoutReq struct{}
 
 type showAllMailReq struct{ v bool }
 
+type setSystemViewsReq struct{ labelIDs []string }
+
 type onDeleteReq struct{}
 
+type setAddressGroupsReq struct {
+	groups usertypes.AddressGroups
+}
+
 type setAddressModeReq struct {
 	mode usertypes.AddressMode
 }
 
 type getSyncFailedMessagesReq struct{}
 
+type getSyncStatusReq struct{}
+
 func GetSyncConfigPath(path string, userID string) string {
 	return filepath.Join(path, fmt.Sprintf("sync-%v", userID))
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/useridentity"
 	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
+	"github.com/bradenaw/juniper/xslices"
 	"github.com/sirupsen/logrus"
 )
 
@@ -146,19 +147,14 @@ func (s *Service) HandleAddressEvents(ctx context.Context, events []proton.Addre
 }
 
 func addNewAddressSplitMode(ctx context.Context, s *Service, addrID string) error {
-	connector := NewConnector(
-		addrID,
-		s.client,
-		s.labels,
-		s.identityState,
-		s.addressMode,
-		s.sendRecorder,
-		s.panicHandler,
-		s.reporter,
-		s.showAllMail,
-		s.syncStateProvider,
-		s.serverManager,
-	)
+	key := s.getConnectorKey(addrID)
+
+	// The address joins the mailbox of its group if another address of the group already has it.
+	if _, ok := s.connectors[key]; ok {
+		return nil
+	}
+
+	connector := s.newConnector(key)
 
 	if err := s.serverManager.AddIMAPUser(ctx, connector, connector.addrID, s.gluonIDProvider, s.syncStateProvider); err != nil {
 		return fmt.Errorf("failed to add new account to server: %w", err)
@@ -179,19 +175,29 @@ func addNewAddressSplitMode(ctx context.Context, s *Service, addrID string) erro
 }
 
 func removeAddressSplitMode(ctx context.Context, s *Service, addrID string) error {
-	connector, ok := s.connectors[addrID]
+	key := s.getConnectorKey(addrID)
+
+	connector, ok := s.connectors[key]
 	if !ok {
 		s.log.Warnf("Could not find connector ")
 		return nil
 	}
 
-	if err := s.serverManager.RemoveIMAPUser(ctx, true, s.gluonIDProvider, addrID); err != nil {
+	// The mailbox of a group is kept as long as one of its addresses remains.
+	if xslices.Any(connector.groupAddrIDs, func(groupAddrID string) bool {
+		_, ok := s.identityState.GetAddress(groupAddrID)
+		return groupAddrID != addrID && ok
+	}) {
+		return nil
+	}
+
+	if err := s.serverManager.RemoveIMAPUser(ctx, true, s.gluonIDProvider, key); err != nil {
 		return fmt.Errorf("failed to remove user from server: %w", err)
 	}
 
 	connector.StateClose()
 
-	delete(s.connectors, addrID)
+	delete(s.connectors, key)
 
 	return nil
 }
@@ -174,7 +174,7 @@ func onMessageCreated(
 	apiLabels := s.labels.GetLabelMap()
 
 	if err := s.identityState.WithAddrKR(message.AddressID, func(_, addrKR *crypto.KeyRing) error {
-		res := buildRFC822(apiLabels, full, addrKR, new(bytes.Buffer))
+		res := buildRFC822(apiLabels, full, addrKR, s.conversationIDs.messageJobOpts(full.ID), new(bytes.Buffer))
 
 		if res.err != nil {
 			s.log.WithError(err).Error("Failed to build RFC822 message")
@@ -235,7 +235,7 @@ func onMessageUpdateDraftOrSent(ctx context.Context, s *Service, event proton.Me
 	apiLabels := s.labels.GetLabelMap()
 
 	if err := s.identityState.WithAddrKR(event.Message.AddressID, func(_, addrKR *crypto.KeyRing) error {
-		res := buildRFC822(apiLabels, full, addrKR, new(bytes.Buffer))
+		res := buildRFC822(apiLabels, full, addrKR, s.conversationIDs.messageJobOpts(full.ID), new(bytes.Buffer))
 
 		if res.err != nil {
 			logrus.WithError(err).Error("Failed to build RFC822 message")
@@ -327,7 +327,7 @@ func onMessageDeleted(ctx context.Context, s *Service, event proton.MessageEvent
 // If the user is in combined mode, we simply push the update to the primary address. If the user is in split mode
 // we do not publish the update as the address no longer exists.
 func safePublishMessageUpdate(ctx context.Context, s *Service, addressID string, update imap.Update, duringSync bool) (bool, error) {
-	v, ok := s.connectors[addressID]
+	v, ok := s.connectors[s.getConnectorKey(addressID)]
 	if !ok {
 		if s.addressMode == usertypes.AddressModeCombined {
 			primAddr, err := s.identityState.GetPrimaryAddress()
@@ -37,7 +37,7 @@ func (s *Service) newSyncEventHandler() userevents.EventHandler {
 		UserHandler:         s,
 		LabelHandler:        nil,
 		MessageHandler:      &syncMessageEventHandler{service: s},
-		UsedSpaceHandler:    nil,
+		UsedSpaceHandler:    s,
 		UserSettingsHandler: nil,
 	}
 }
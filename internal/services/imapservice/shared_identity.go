@@ -32,7 +32,9 @@ type sharedIdentity interface {
 	GetPrimaryAddress() (proton.Address, error)
 	GetAddresses() []proton.Address
 	WithAddrKR(addrID string, fn func(userKR, addrKR *crypto.KeyRing) error) error
-	CheckAuth(email string, password []byte) (string, error)
+	CheckAuth(email string, password []byte) (string, string, bool, error)
+	ReadOnly() bool
+	Quota() (uint64, uint64)
 }
 
 type rwIdentity struct {
@@ -102,11 +104,23 @@ func (r *rwIdentity) WithAddrKRs(fn func(*crypto.KeyRing, map[string]*crypto.Key
 	return r.identity.WithAddrKRs(r.keyPassProvider.KeyPass(), fn)
 }
 
-func (r *rwIdentity) CheckAuth(email string, password []byte) (string, error) {
+func (r *rwIdentity) CheckAuth(email string, password []byte) (string, string, bool, error) {
 	r.lock.RLock()
 	defer r.lock.RUnlock()
 
-	return r.identity.CheckAuth(email, password, r.bridgePassProvider)
+	return r.identity.CheckAuth(email, password, useridentity.ProtocolIMAP, r.bridgePassProvider)
+}
+
+// Quota returns the space used by the user and the space they can use, in bytes, the latter being 0 if unlimited.
+func (r *rwIdentity) Quota() (uint64, uint64) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.identity.User.UsedSpace, r.identity.User.MaxSpace
+}
+
+func (r *rwIdentity) ReadOnly() bool {
+	return r.bridgePassProvider.ReadOnly()
 }
 
 func (r *rwIdentity) Write(f func(identity *useridentity.State) error) error {
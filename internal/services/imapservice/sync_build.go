@@ -50,7 +50,13 @@ func defaultMessageJobOpts() message.JobOptions {
 	}
 }
 
-func buildRFC822(apiLabels map[string]proton.Label, full proton.FullMessage, addrKR *crypto.KeyRing, buffer *bytes.Buffer) *buildRes {
+func buildRFC822(
+	apiLabels map[string]proton.Label,
+	full proton.FullMessage,
+	addrKR *crypto.KeyRing,
+	opts message.JobOptions,
+	buffer *bytes.Buffer,
+) *buildRes {
 	var (
 		update *imap.MessageCreated
 		err    error
@@ -58,7 +64,7 @@ func buildRFC822(apiLabels map[string]proton.Label, full proton.FullMessage, add
 
 	buffer.Grow(full.Size)
 
-	if buildErr := message.DecryptAndBuildRFC822Into(addrKR, full.Message, full.AttData, defaultMessageJobOpts(), buffer); buildErr != nil {
+	if buildErr := message.DecryptAndBuildRFC822Into(addrKR, full.Message, full.AttData, opts, buffer); buildErr != nil {
 		update = newMessageCreatedFailedUpdate(apiLabels, full.MessageMetadata, buildErr)
 		err = buildErr
 	} else if created, parseErr := newMessageCreatedUpdate(apiLabels, full.MessageMetadata, buffer.Bytes()); parseErr != nil {
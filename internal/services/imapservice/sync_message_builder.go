@@ -27,11 +27,12 @@ import (
 )
 
 type SyncMessageBuilder struct {
-	state *rwIdentity
+	state           *rwIdentity
+	conversationIDs *ConversationIDs
 }
 
-func NewSyncMessageBuilder(rw *rwIdentity) *SyncMessageBuilder {
-	return &SyncMessageBuilder{state: rw}
+func NewSyncMessageBuilder(rw *rwIdentity, conversationIDs *ConversationIDs) *SyncMessageBuilder {
+	return &SyncMessageBuilder{state: rw, conversationIDs: conversationIDs}
 }
 
 func (s SyncMessageBuilder) WithKeys(f func(*crypto.KeyRing, map[string]*crypto.KeyRing) error) error {
@@ -46,7 +47,7 @@ func (s SyncMessageBuilder) BuildMessage(
 ) (syncservice.BuildResult, error) {
 	buffer.Grow(full.Size)
 
-	if err := message.DecryptAndBuildRFC822Into(addrKR, full.Message, full.AttData, defaultMessageJobOpts(), buffer); err != nil {
+	if err := message.DecryptAndBuildRFC822Into(addrKR, full.Message, full.AttData, s.conversationIDs.messageJobOpts(full.ID), buffer); err != nil {
 		return syncservice.BuildResult{}, err
 	}
 
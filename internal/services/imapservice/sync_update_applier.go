@@ -41,7 +41,9 @@ type updateReply struct {
 	err     error
 }
 
-type updateRequest = func(ctx context.Context, mode usertypes.AddressMode, connectors map[string]*Connector) ([]imap.Update, error)
+// updateRequest applies updates to the connectors being synced, which are all of them unless only the mailboxes of
+// some address groups are being resynced.
+type updateRequest = func(ctx context.Context, mode usertypes.AddressMode, groups usertypes.AddressGroups, connectors map[string]*Connector) ([]imap.Update, error)
 
 func NewSyncUpdateApplier(labelConflictManager *LabelConflictManager) *SyncUpdateApplier {
 	return &SyncUpdateApplier{
@@ -57,7 +59,7 @@ func (s *SyncUpdateApplier) Close() {
 }
 
 func (s *SyncUpdateApplier) ApplySyncUpdates(ctx context.Context, updates []syncservice.BuildResult) error {
-	request := func(ctx context.Context, mode usertypes.AddressMode, connectors map[string]*Connector) ([]imap.Update, error) {
+	request := func(ctx context.Context, mode usertypes.AddressMode, groups usertypes.AddressGroups, connectors map[string]*Connector) ([]imap.Update, error) {
 		if mode == usertypes.AddressModeCombined {
 			if len(connectors) != 1 {
 				return nil, fmt.Errorf("unexpected connecto list state")
@@ -77,24 +79,28 @@ func (s *SyncUpdateApplier) ApplySyncUpdates(ctx context.Context, updates []sync
 		updateMap := make(map[string]*imap.MessagesCreated, len(connectors))
 		result := make([]imap.Update, 0, len(connectors))
 
+		// The addresses of a group share the connector of the first one.
 		for _, up := range updates {
-			update, ok := updateMap[up.AddressID]
+			key := groups.Key(up.AddressID)
+
+			update, ok := updateMap[key]
 			if !ok {
 				update = imap.NewMessagesCreated(true)
-				updateMap[up.AddressID] = update
-				result = append(result, update)
+				updateMap[key] = update
 			}
 
 			update.Messages = append(update.Messages, up.Update)
 		}
 
-		for addrID, update := range updateMap {
-			c, ok := connectors[addrID]
+		for key, update := range updateMap {
+			c, ok := connectors[key]
 			if !ok {
-				logrus.Warnf("Could not find connector for address %v", addrID)
+				logrus.Debugf("Could not find connector for address %v, it may have been deleted or not be resynced", key)
 				continue
 			}
 
+			result = append(result, update)
+
 			c.publishUpdate(ctx, update)
 		}
 
@@ -114,7 +120,7 @@ func (s *SyncUpdateApplier) ApplySyncUpdates(ctx context.Context, updates []sync
 }
 
 func (s *SyncUpdateApplier) SyncLabels(ctx context.Context, labels map[string]proton.Label) error {
-	request := func(ctx context.Context, _ usertypes.AddressMode, connectors map[string]*Connector) ([]imap.Update, error) {
+	request := func(ctx context.Context, _ usertypes.AddressMode, _ usertypes.AddressGroups, connectors map[string]*Connector) ([]imap.Update, error) {
 		return syncLabels(ctx, labels, maps.Values(connectors), s.labelConflictManager)
 	}
 
@@ -23,6 +23,7 @@ import (
 	"github.com/ProtonMail/go-proton-api"
 	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/message/parser"
+	"golang.org/x/exp/slices"
 )
 
 type connectorInterface interface {
@@ -30,6 +31,7 @@ type connectorInterface interface {
 	getAddress(id string) (proton.Address, bool)
 	getPrimaryAddress() (proton.Address, error)
 	getAddressMode() usertypes.AddressMode
+	getGroupAddrIDs() []string
 	logError(err error, errMsg string)
 }
 
@@ -41,6 +43,22 @@ func (s *Connector) getAddressMode() usertypes.AddressMode {
 	return s.addressMode
 }
 
+func (s *Connector) getGroupAddrIDs() []string {
+	return s.groupAddrIDs
+}
+
+// getImportAddrID returns the ID of the address messages are imported with by default. It is the first address of
+// the group still part of the account, the one the mailbox is stored under possibly having been removed.
+func (s *Connector) getImportAddrID() string {
+	for _, addrID := range s.groupAddrIDs {
+		if _, ok := s.identityState.GetAddress(addrID); ok {
+			return addrID
+		}
+	}
+
+	return s.addrID
+}
+
 func (s *Connector) getPrimaryAddress() (proton.Address, error) {
 	return s.identityState.GetPrimaryAddress()
 }
@@ -65,8 +83,9 @@ func getImportAddress(p *parser.Parser, isDraft bool, id string, conn connectorI
 		}
 	}
 
+	// In split mode, the addresses of a group share the mailbox as they would in combined mode.
 	inCombinedMode := conn.getAddressMode() == usertypes.AddressModeCombined
-	if !inCombinedMode {
+	if !inCombinedMode && len(conn.getGroupAddrIDs()) < 2 {
 		return address, nil
 	}
 
@@ -84,6 +103,10 @@ func getImportAddress(p *parser.Parser, isDraft bool, id string, conn connectorI
 		return address, nil
 	}
 
+	if !inCombinedMode && !slices.Contains(conn.getGroupAddrIDs(), senderAddr.ID) {
+		return address, nil
+	}
+
 	// GODT-3185 / BRIDGE-120 In combined mode, in certain cases we adapt the address used for encryption.
 	// - draft with non-default address in combined mode: using sender address
 	// - import with non-default address in combined mode: using sender address
@@ -29,6 +29,7 @@ import (
 
 type testConnector struct {
 	addressMode        usertypes.AddressMode
+	groupAddrIDs       []string
 	primaryAddress     proton.Address
 	senderAddress      proton.Address
 	imapAddress        proton.Address
@@ -51,6 +52,10 @@ func (t *testConnector) getAddressMode() usertypes.AddressMode {
 	return t.addressMode
 }
 
+func (t *testConnector) getGroupAddrIDs() []string {
+	return t.groupAddrIDs
+}
+
 func (t *testConnector) logError(_ error, _ string) {
 }
 
@@ -137,6 +142,64 @@ func Test_GetImportAddress_SplitMode(t *testing.T) {
 	require.Equal(t, primaryAddress.Email, addr.Email)
 }
 
+func Test_GetImportAddress_SplitMode_AddressGroup(t *testing.T) {
+	groupAddress := proton.Address{
+		ID:      "1",
+		Email:   "group@proton.me",
+		Send:    true,
+		Receive: true,
+		Type:    proton.AddressTypeOriginal,
+		Status:  proton.AddressStatusEnabled,
+	}
+
+	otherGroupAddress := proton.Address{
+		ID:      "2",
+		Email:   "other-group@proton.me",
+		Send:    true,
+		Receive: true,
+		Type:    proton.AddressTypeOriginal,
+		Status:  proton.AddressStatusEnabled,
+	}
+
+	outsideAddress := proton.Address{
+		ID:      "3",
+		Email:   "outside@proton.me",
+		Send:    true,
+		Receive: true,
+		Type:    proton.AddressTypeOriginal,
+		Status:  proton.AddressStatusEnabled,
+	}
+
+	testConn := &testConnector{
+		addressMode:    usertypes.AddressModeSplit,
+		groupAddrIDs:   []string{groupAddress.ID, otherGroupAddress.ID},
+		primaryAddress: outsideAddress,
+		imapAddress:    groupAddress,
+		senderAddress:  otherGroupAddress,
+	}
+
+	// The sender is another address of the group.
+	// Expected: returned address is the sender address, like in combined mode.
+	addr, err := getImportAddress(nil, false, groupAddress.ID, testConn)
+	require.NoError(t, err)
+	require.Equal(t, otherGroupAddress.ID, addr.ID)
+
+	// The sender is an address outside the group.
+	// Expected: returned address is the IMAP address.
+	testConn.senderAddress = outsideAddress
+	addr, err = getImportAddress(nil, false, groupAddress.ID, testConn)
+	require.NoError(t, err)
+	require.Equal(t, groupAddress.ID, addr.ID)
+
+	// The address is not part of a group.
+	// Expected: returned address is the IMAP address, whatever the sender.
+	testConn.groupAddrIDs = []string{groupAddress.ID}
+	testConn.senderAddress = otherGroupAddress
+	addr, err = getImportAddress(nil, false, groupAddress.ID, testConn)
+	require.NoError(t, err)
+	require.Equal(t, groupAddress.ID, addr.ID)
+}
+
 func Test_GetImportAddress_CombinedMode_ProtonAddresses(t *testing.T) {
 	primaryAddress := proton.Address{
 		ID:      "1",
@@ -0,0 +1,324 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	imapEvents "github.com/ProtonMail/gluon/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/emersion/go-smtp"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// A host failing to authenticate lockoutThreshold times within lockoutDuration is locked out for lockoutDuration.
+	lockoutThreshold = 5
+	lockoutDuration  = 15 * time.Minute
+)
+
+// Reasons of the connection rejections.
+const (
+	RejectNotAllowed = "not-allowed"
+	RejectLockedOut  = "locked-out"
+)
+
+var logGuard = logrus.WithField("pkg", "server/guard") //nolint:gochecknoglobals
+
+var (
+	errLockedOut   = errors.New("too many failed authentication attempts, try again later")
+	errTLSRequired = errors.New("TLS is required to authenticate from another host, use SSL or STARTTLS")
+)
+
+type hostState struct {
+	failures    []time.Time
+	lockedUntil time.Time
+}
+
+// accessGuard filters the connections of other hosts: they are only accepted from the allowed networks, must use TLS
+// and are locked out after too many failed authentications. Local connections are always accepted.
+type accessGuard struct {
+	settings       NetworkSettingsProvider
+	eventPublisher events.EventPublisher
+
+	hosts     map[string]*hostState
+	sessions  map[int]net.Addr
+	hostsLock sync.Mutex
+
+	now func() time.Time
+}
+
+func newAccessGuard(settings NetworkSettingsProvider, eventPublisher events.EventPublisher) *accessGuard {
+	return &accessGuard{
+		settings:       settings,
+		eventPublisher: eventPublisher,
+
+		hosts:    make(map[string]*hostState),
+		sessions: make(map[int]net.Addr),
+
+		now: time.Now,
+	}
+}
+
+// check returns the reason why a connection from the given address must be rejected, if any.
+func (guard *accessGuard) check(addr net.Addr) string {
+	ip := addrIP(addr)
+	if ip == nil || ip.IsLoopback() {
+		return ""
+	}
+
+	if !guard.isAllowed(ip) {
+		return RejectNotAllowed
+	}
+
+	if guard.isLockedOut(ip) {
+		return RejectLockedOut
+	}
+
+	return ""
+}
+
+func (guard *accessGuard) isAllowed(ip net.IP) bool {
+	for _, network := range guard.settings.AllowedNetworks() {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (guard *accessGuard) isLockedOut(ip net.IP) bool {
+	guard.hostsLock.Lock()
+	defer guard.hostsLock.Unlock()
+
+	host, ok := guard.hosts[ip.String()]
+
+	return ok && guard.now().Before(host.lockedUntil)
+}
+
+func (guard *accessGuard) reject(protocol string, addr net.Addr, reason string) {
+	logGuard.WithField("protocol", protocol).WithField("remoteAddr", addr).WithField("reason", reason).Warn("Rejected connection")
+
+	guard.eventPublisher.PublishEvent(context.Background(), events.ConnectionRejected{
+		Protocol:   protocol,
+		RemoteAddr: addr.String(),
+		Reason:     reason,
+	})
+}
+
+// authFailed records a failed authentication from the given address, locking it out if it failed too often.
+func (guard *accessGuard) authFailed(addr net.Addr) {
+	ip := addrIP(addr)
+	if ip == nil || ip.IsLoopback() {
+		return
+	}
+
+	guard.hostsLock.Lock()
+	defer guard.hostsLock.Unlock()
+
+	now := guard.now()
+
+	host, ok := guard.hosts[ip.String()]
+	if !ok {
+		host = &hostState{}
+		guard.hosts[ip.String()] = host
+	}
+
+	failures := host.failures[:0]
+
+	for _, failure := range host.failures {
+		if now.Sub(failure) < lockoutDuration {
+			failures = append(failures, failure)
+		}
+	}
+
+	host.failures = append(failures, now)
+
+	if len(host.failures) >= lockoutThreshold {
+		host.failures = nil
+		host.lockedUntil = now.Add(lockoutDuration)
+	}
+}
+
+// authSucceeded forgets the failed authentications of the given address.
+func (guard *accessGuard) authSucceeded(addr net.Addr) {
+	ip := addrIP(addr)
+	if ip == nil {
+		return
+	}
+
+	guard.hostsLock.Lock()
+	defer guard.hostsLock.Unlock()
+
+	if host, ok := guard.hosts[ip.String()]; ok && !guard.now().Before(host.lockedUntil) {
+		delete(guard.hosts, ip.String())
+	}
+}
+
+// handleIMAPEvent tracks the IMAP sessions to attribute the failed logins to their remote address.
+func (guard *accessGuard) handleIMAPEvent(event imapEvents.Event) {
+	switch event := event.(type) {
+	case imapEvents.SessionAdded:
+		if event.RemoteAddr != nil {
+			guard.hostsLock.Lock()
+			guard.sessions[event.SessionID] = event.RemoteAddr
+			guard.hostsLock.Unlock()
+		}
+
+	case imapEvents.SessionRemoved:
+		guard.hostsLock.Lock()
+		delete(guard.sessions, event.SessionID)
+		guard.hostsLock.Unlock()
+
+	case imapEvents.LoginFailed:
+		if addr := guard.sessionAddr(event.SessionID); addr != nil {
+			guard.authFailed(addr)
+		}
+
+	case imapEvents.Login:
+		if addr := guard.sessionAddr(event.SessionID); addr != nil {
+			guard.authSucceeded(addr)
+		}
+	}
+}
+
+// isSessionLockedOut returns whether the host of the given IMAP session is locked out.
+func (guard *accessGuard) isSessionLockedOut(sessionID int) bool {
+	ip := addrIP(guard.sessionAddr(sessionID))
+	if ip == nil || ip.IsLoopback() {
+		return false
+	}
+
+	return guard.isLockedOut(ip)
+}
+
+func (guard *accessGuard) sessionAddr(sessionID int) net.Addr {
+	guard.hostsLock.Lock()
+	defer guard.hostsLock.Unlock()
+
+	return guard.sessions[sessionID]
+}
+
+func addrIP(addr net.Addr) net.IP {
+	switch addr := addr.(type) {
+	case *net.TCPAddr:
+		return addr.IP
+
+	default:
+		return nil
+	}
+}
+
+// guardedListener closes the connections rejected by the guard as soon as they are accepted.
+type guardedListener struct {
+	net.Listener
+
+	guard    *accessGuard
+	protocol string
+}
+
+func (l *guardedListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+
+		if reason := l.guard.check(conn.RemoteAddr()); reason != "" {
+			_ = conn.Close()
+			l.guard.reject(l.protocol, conn.RemoteAddr(), reason)
+
+			continue
+		}
+
+		return conn, nil
+	}
+}
+
+// appPasswordSession is implemented by the SMTP sessions which can authenticate with an app password.
+type appPasswordSession interface {
+	AppPasswordID() string
+}
+
+// guardedBackend reports the SMTP authentications to the guard and the session tracker.
+type guardedBackend struct {
+	smtp.Backend
+
+	guard    *accessGuard
+	sessions *sessionTracker
+}
+
+func (be *guardedBackend) NewSession(conn *smtp.Conn) (smtp.Session, error) {
+	session, err := be.Backend.NewSession(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &guardedSession{
+		Session:  session,
+		guard:    be.guard,
+		sessions: be.sessions,
+		conn:     conn,
+		addr:     conn.Conn().RemoteAddr(),
+	}, nil
+}
+
+type guardedSession struct {
+	smtp.Session
+
+	guard    *accessGuard
+	sessions *sessionTracker
+	conn     *smtp.Conn
+	addr     net.Addr
+}
+
+func (s *guardedSession) AuthPlain(username, password string) error {
+	if s.guard.check(s.addr) == RejectLockedOut {
+		return errLockedOut
+	}
+
+	if ip := addrIP(s.addr); ip != nil && !ip.IsLoopback() {
+		if _, isTLS := s.conn.TLSConnectionState(); !isTLS {
+			return errTLSRequired
+		}
+	}
+
+	if err := s.Session.AuthPlain(username, password); err != nil {
+		s.guard.authFailed(s.addr)
+		return err
+	}
+
+	s.guard.authSucceeded(s.addr)
+
+	if session, ok := s.Session.(appPasswordSession); ok && session.AppPasswordID() != "" {
+		s.sessions.bindSMTPSession(s.conn, session.AppPasswordID())
+	}
+
+	return nil
+}
+
+func (s *guardedSession) Logout() error {
+	s.sessions.unbindSMTPSession(s.conn)
+
+	return s.Session.Logout()
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	imapEvents "github.com/ProtonMail/gluon/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/stretchr/testify/require"
+)
+
+type testNetworkSettings struct {
+	networks []*net.IPNet
+}
+
+func (s *testNetworkSettings) BindAddress() string {
+	return "0.0.0.0"
+}
+
+func (s *testNetworkSettings) AllowedNetworks() []*net.IPNet {
+	return s.networks
+}
+
+func (s *testNetworkSettings) SocketDirectory() (string, error) {
+	return "", nil
+}
+
+type testEventPublisher struct {
+	events []events.Event
+}
+
+func (p *testEventPublisher) PublishEvent(_ context.Context, event events.Event) {
+	p.events = append(p.events, event)
+}
+
+func newTestGuard(t *testing.T, networks ...string) *accessGuard {
+	settings := &testNetworkSettings{}
+
+	for _, network := range networks {
+		_, ipNet, err := net.ParseCIDR(network)
+		require.NoError(t, err)
+
+		settings.networks = append(settings.networks, ipNet)
+	}
+
+	return newAccessGuard(settings, &testEventPublisher{})
+}
+
+func tcpAddr(ip string) net.Addr {
+	return &net.TCPAddr{IP: net.ParseIP(ip), Port: 12345}
+}
+
+func TestAccessGuard_AllowedNetworks(t *testing.T) {
+	guard := newTestGuard(t, "192.168.1.0/24", "fd00::/8")
+
+	require.Empty(t, guard.check(tcpAddr("127.0.0.1")))
+	require.Empty(t, guard.check(tcpAddr("::1")))
+	require.Empty(t, guard.check(tcpAddr("192.168.1.10")))
+	require.Empty(t, guard.check(tcpAddr("fd12::1")))
+
+	require.Equal(t, RejectNotAllowed, guard.check(tcpAddr("192.168.2.10")))
+	require.Equal(t, RejectNotAllowed, guard.check(tcpAddr("8.8.8.8")))
+}
+
+func TestAccessGuard_Lockout(t *testing.T) {
+	guard := newTestGuard(t, "192.168.1.0/24")
+
+	now := time.Now()
+	guard.now = func() time.Time { return now }
+
+	host, other := tcpAddr("192.168.1.10"), tcpAddr("192.168.1.11")
+
+	for i := 0; i < lockoutThreshold-1; i++ {
+		guard.authFailed(host)
+	}
+
+	require.Empty(t, guard.check(host))
+
+	// A successful authentication forgets the failures.
+	guard.authSucceeded(host)
+
+	for i := 0; i < lockoutThreshold; i++ {
+		guard.authFailed(host)
+	}
+
+	require.Equal(t, RejectLockedOut, guard.check(host))
+	require.Empty(t, guard.check(other))
+
+	// Loopback clients are never locked out.
+	for i := 0; i < lockoutThreshold; i++ {
+		guard.authFailed(tcpAddr("127.0.0.1"))
+	}
+
+	require.Empty(t, guard.check(tcpAddr("127.0.0.1")))
+
+	now = now.Add(lockoutDuration)
+	require.Empty(t, guard.check(host))
+}
+
+func TestAccessGuard_IMAPSessions(t *testing.T) {
+	guard := newTestGuard(t, "192.168.1.0/24")
+
+	host := tcpAddr("192.168.1.10")
+
+	guard.handleIMAPEvent(imapEvents.SessionAdded{SessionID: 1, RemoteAddr: host})
+	guard.handleIMAPEvent(imapEvents.SessionAdded{SessionID: 2, RemoteAddr: tcpAddr("127.0.0.1")})
+
+	for i := 0; i < lockoutThreshold; i++ {
+		require.False(t, guard.isSessionLockedOut(1))
+		guard.handleIMAPEvent(imapEvents.LoginFailed{SessionID: 1})
+	}
+
+	// The session can't keep trying to authenticate once its host is locked out.
+	require.True(t, guard.isSessionLockedOut(1))
+	require.False(t, guard.isSessionLockedOut(2))
+	require.False(t, guard.isSessionLockedOut(3))
+
+	guard.handleIMAPEvent(imapEvents.SessionRemoved{SessionID: 1})
+
+	require.Equal(t, RejectLockedOut, guard.check(host))
+}
+
+func TestGuardedListener(t *testing.T) {
+	publisher := &testEventPublisher{}
+
+	guard := newTestGuard(t, "192.168.1.0/24")
+	guard.eventPublisher = publisher
+
+	listener, err := newListener("127.0.0.1", 0, false, nil, guard, "IMAP")
+	require.NoError(t, err)
+	defer func() { _ = listener.Close() }()
+
+	go func() {
+		if conn, err := net.Dial("tcp", listener.Addr().String()); err == nil {
+			_ = conn.Close()
+		}
+	}()
+
+	// Loopback connections are accepted.
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+	require.Empty(t, publisher.events)
+
+	guard.reject("SMTP", tcpAddr("8.8.8.8"), RejectNotAllowed)
+	require.Equal(t, []events.Event{events.ConnectionRejected{
+		Protocol:   "SMTP",
+		RemoteAddr: "8.8.8.8:12345",
+		Reason:     RejectNotAllowed,
+	}}, publisher.events)
+}
@@ -85,6 +85,8 @@ func newIMAPServer(
 	logClient, logServer bool,
 	disableIMAPAuthenticate bool,
 	eventPublisher IMAPEventPublisher,
+	guard *accessGuard,
+	sessions *sessionTracker,
 	tasks *async.Group,
 	uidValidityGenerator imap.UIDValidityGenerator,
 	panicHandler async.PanicHandler,
@@ -159,6 +161,9 @@ func newIMAPServer(
 					return
 				}
 
+				guard.handleIMAPEvent(e)
+				sessions.handleIMAPEvent(e)
+
 				eventPublisher.PublishIMAPEvent(ctx, e)
 			}
 		}
@@ -198,14 +203,16 @@ func (*storeBuilder) Delete(path, userID string) error {
 	return os.RemoveAll(filepath.Join(path, userID))
 }
 
-func moveGluonCacheDir(settings IMAPSettingsProvider, oldGluonDir, newGluonDir string) error {
+// MoveGluonCacheDir moves the gluon cache from the old directory to the new one, which is then saved with setCacheDir.
+// The gluon server must not be running.
+func MoveGluonCacheDir(oldGluonDir, newGluonDir string, setCacheDir func(string) error) error {
 	logIMAP.WithField("pkg", "service/imap").Infof("gluon cache moving from %s to %s", oldGluonDir, newGluonDir)
 	oldCacheDir := ApplyGluonCachePathSuffix(oldGluonDir)
 	if err := files.CopyDir(oldCacheDir, ApplyGluonCachePathSuffix(newGluonDir)); err != nil {
 		return fmt.Errorf("failed to copy gluon dir: %w", err)
 	}
 
-	if err := settings.SetCacheDirectory(newGluonDir); err != nil {
+	if err := setCacheDir(newGluonDir); err != nil {
 		return fmt.Errorf("failed to set new gluon cache dir: %w", err)
 	}
 
@@ -19,28 +19,159 @@ package imapsmtpserver
 
 import (
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"net"
+	"os"
+	"strconv"
+	"sync"
+)
 
-	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+// The names of the Unix sockets, in the socket directory.
+const (
+	IMAPSocketName = "imap.sock"
+	SMTPSocketName = "smtp.sock"
 )
 
-func newListener(port int, useTLS bool, tlsConfig *tls.Config) (net.Listener, error) {
+type NetworkSettingsProvider interface {
+	// BindAddress returns the IP address the servers listen on.
+	BindAddress() string
+
+	// AllowedNetworks returns the networks from which other hosts are accepted.
+	AllowedNetworks() []*net.IPNet
+
+	// SocketDirectory returns the directory of the IMAP and SMTP Unix sockets, empty if they are disabled.
+	SocketDirectory() (string, error)
+}
+
+// newListener listens on the given address. Connections from other hosts are filtered by the guard.
+func newListener(host string, port int, useTLS bool, tlsConfig *tls.Config, guard *accessGuard, protocol string) (net.Listener, error) {
+	netListener, err := net.Listen("tcp", net.JoinHostPort(host, strconv.Itoa(port)))
+	if err != nil {
+		return nil, err
+	}
+
+	var listener net.Listener = &guardedListener{Listener: netListener, guard: guard, protocol: protocol}
+
 	if useTLS {
-		tlsListener, err := tls.Listen("tcp", fmt.Sprintf("%v:%v", constants.Host, port), tlsConfig)
-		if err != nil {
-			return nil, err
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
+	return listener, nil
+}
+
+// newSocketListener listens on a Unix socket only accessible by the current user. A socket left behind by a previous
+// instance is replaced, but not one still in use.
+func newSocketListener(path string) (net.Listener, error) {
+	if _, err := os.Stat(path); err == nil {
+		if conn, err := net.Dial("unix", path); err == nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("socket %v is already in use", path)
 		}
 
-		return tlsListener, nil
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("failed to remove stale socket: %w", err)
+		}
 	}
 
-	netListener, err := net.Listen("tcp", fmt.Sprintf("%v:%v", constants.Host, port))
+	listener, err := net.Listen("unix", path)
 	if err != nil {
 		return nil, err
 	}
 
-	return netListener, nil
+	if err := os.Chmod(path, 0o600); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("failed to restrict socket permissions: %w", err)
+	}
+
+	return listener, nil
+}
+
+// multiListener accepts the connections of several listeners, so that a single server can serve them all.
+// Its address is the one of the first listener.
+type multiListener struct {
+	listeners []net.Listener
+
+	connCh    chan net.Conn
+	errCh     chan error
+	closeCh   chan struct{}
+	closeOnce sync.Once
+}
+
+func newMultiListener(listeners ...net.Listener) *multiListener {
+	l := &multiListener{
+		listeners: listeners,
+		connCh:    make(chan net.Conn),
+		errCh:     make(chan error),
+		closeCh:   make(chan struct{}),
+	}
+
+	for _, listener := range listeners {
+		go l.accept(listener)
+	}
+
+	return l
+}
+
+func (l *multiListener) accept(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			select {
+			case l.errCh <- err:
+			case <-l.closeCh:
+			}
+
+			return
+		}
+
+		select {
+		case l.connCh <- conn:
+		case <-l.closeCh:
+			_ = conn.Close()
+			return
+		}
+	}
+}
+
+func (l *multiListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.connCh:
+		return conn, nil
+
+	case err := <-l.errCh:
+		return nil, err
+
+	case <-l.closeCh:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *multiListener) Close() error {
+	var errs []error
+
+	l.closeOnce.Do(func() {
+		close(l.closeCh)
+
+		for _, listener := range l.listeners {
+			if err := listener.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	})
+
+	return errors.Join(errs...)
+}
+
+func (l *multiListener) Addr() net.Addr {
+	return l.listeners[0].Addr()
+}
+
+// isLoopback returns whether the given bind address is only reachable from the local host.
+func isLoopback(host string) bool {
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
 }
 
 func getPort(addr net.Addr) int {
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSocketListener(t *testing.T) {
+	path := filepath.Join(t.TempDir(), IMAPSocketName)
+
+	// A stale socket is replaced.
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+
+	listener, err := newSocketListener(path)
+	require.NoError(t, err)
+
+	// A socket in use is not.
+	_, err = newSocketListener(path)
+	require.Error(t, err)
+
+	require.NoError(t, listener.Close())
+	require.NoFileExists(t, path)
+}
+
+func TestMultiListener(t *testing.T) {
+	path := filepath.Join(t.TempDir(), SMTPSocketName)
+
+	tcpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	socketListener, err := newSocketListener(path)
+	require.NoError(t, err)
+
+	listener := newMultiListener(tcpListener, socketListener)
+	require.Equal(t, tcpListener.Addr(), listener.Addr())
+
+	for network, address := range map[string]string{"tcp": tcpListener.Addr().String(), "unix": path} {
+		go func() {
+			if conn, err := net.Dial(network, address); err == nil {
+				_ = conn.Close()
+			}
+		}()
+
+		conn, err := listener.Accept()
+		require.NoError(t, err)
+		require.Equal(t, network, conn.LocalAddr().Network())
+		require.NoError(t, conn.Close())
+	}
+
+	require.NoError(t, listener.Close())
+
+	_, err = listener.Accept()
+	require.ErrorIs(t, err, net.ErrClosed)
+}
@@ -0,0 +1,407 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapservice"
+	"github.com/emersion/go-imap/utf7"
+)
+
+// metadataCapabilities are advertised next to the capabilities of gluon, see RFC 5464.
+const metadataCapabilities = "METADATA"
+
+// metadataTimeout bounds the time taken to set the entries of a mailbox, which is done through the API.
+const metadataTimeout = 30 * time.Second
+
+// metadataHandler answers the METADATA commands of the sessions bound to an account with the entries of their
+// mailboxes. The commands sending literals are left to gluon, which refuses them. As the commands are answered in the
+// goroutine reading the client commands, gluon keeps running the commands sent before while the entries are set.
+type metadataHandler struct {
+	metadata atomic.Pointer[imapservice.MailboxMetadata]
+}
+
+// setMetadata makes the handler answer the METADATA commands with the entries of the mailboxes of the session.
+func (h *metadataHandler) setMetadata(metadata imapservice.MailboxMetadata) {
+	h.metadata.Store(&metadata)
+}
+
+func (h *metadataHandler) capabilities(bool) string {
+	return metadataCapabilities
+}
+
+func (h *metadataHandler) handleCommand(conn *imapProxyConn, cmd *proxyCommand) (bool, error) {
+	metadata := h.metadata.Load()
+	if metadata == nil || cmd.literal > 0 || !isMetadataCommand(cmd.name) {
+		return false, nil
+	}
+
+	ctx, cancel := context.WithTimeout(conn.ctx, metadataTimeout)
+	defer cancel()
+
+	return true, conn.reply(metadataResponse(ctx, cmd.tag, cmd.name, cmd.args, *metadata))
+}
+
+func (h *metadataHandler) handleResponse(_ string, res []byte) []byte {
+	return res
+}
+
+// isMetadataCommand returns whether the command is one of the METADATA commands, which gluon doesn't support.
+func isMetadataCommand(command string) bool {
+	switch command {
+	case "GETMETADATA", "SETMETADATA":
+		return true
+
+	default:
+		return false
+	}
+}
+
+// metadataToken is an argument of a METADATA command: a string, NIL or a parenthesized list.
+type metadataToken struct {
+	value  string
+	isNil  bool
+	isList bool
+	list   []metadataToken
+}
+
+// metadataResponse answers a METADATA command. The entries of the server, given with the empty mailbox name, have no
+// value and cannot be set.
+func metadataResponse(ctx context.Context, tag, command, args string, metadata imapservice.MailboxMetadata) string {
+	tokens, err := parseMetadataArgs([]byte(args))
+	if err != nil {
+		return fmt.Sprintf("%s BAD %v\r\n", tag, err)
+	}
+
+	if command == "GETMETADATA" {
+		return getMetadataResponse(tag, tokens, metadata)
+	}
+
+	return setMetadataResponse(ctx, tag, tokens, metadata)
+}
+
+func getMetadataResponse(tag string, tokens []metadataToken, metadata imapservice.MailboxMetadata) string {
+	maxSize, depth := -1, 0
+
+	if len(tokens) == 3 && tokens[0].isList {
+		options := tokens[0].list
+
+		if len(options)%2 != 0 {
+			return fmt.Sprintf("%s BAD invalid options\r\n", tag)
+		}
+
+		for i := 0; i < len(options); i += 2 {
+			name, value := strings.ToUpper(options[i].value), strings.ToUpper(options[i+1].value)
+
+			switch {
+			case name == "MAXSIZE":
+				size, err := strconv.Atoi(value)
+				if err != nil || size < 0 {
+					return fmt.Sprintf("%s BAD invalid MAXSIZE\r\n", tag)
+				}
+
+				maxSize = size
+
+			case name == "DEPTH" && value == "0":
+				depth = 0
+
+			case name == "DEPTH" && value == "1":
+				depth = 1
+
+			case name == "DEPTH" && value == "INFINITY":
+				depth = -1
+
+			default:
+				return fmt.Sprintf("%s BAD invalid option %s\r\n", tag, options[i].value)
+			}
+		}
+
+		tokens = tokens[1:]
+	}
+
+	if len(tokens) != 2 || tokens[0].isList || tokens[0].isNil {
+		return fmt.Sprintf("%s BAD expected mailbox and entries\r\n", tag)
+	}
+
+	mailbox := tokens[0].value
+
+	entries, err := metadataEntries(tokens[1])
+	if err != nil {
+		return fmt.Sprintf("%s BAD %v\r\n", tag, err)
+	}
+
+	values := make(map[string]string)
+
+	if mailbox != "" {
+		name, err := utf7.Encoding.NewDecoder().String(mailbox)
+		if err != nil {
+			return fmt.Sprintf("%s BAD invalid mailbox name\r\n", tag)
+		}
+
+		if values, err = metadata.GetMailboxMetadata(name); err != nil {
+			return fmt.Sprintf("%s NO %v\r\n", tag, err)
+		}
+	}
+
+	var (
+		items   []string
+		longest int
+	)
+
+	addItem := func(entry, value string) {
+		if maxSize >= 0 && len(value) > maxSize {
+			longest = max(longest, len(value))
+			return
+		}
+
+		items = append(items, entry+" "+quoteMetadataString(value))
+	}
+
+	for _, entry := range entries {
+		if value, ok := values[entry]; ok {
+			addItem(entry, value)
+		} else {
+			items = append(items, entry+" NIL")
+		}
+
+		// The entries below the requested one are returned up to the requested depth.
+		for _, child := range sortedKeys(values) {
+			if suffix, ok := strings.CutPrefix(child, entry+"/"); ok && (depth < 0 || strings.Count(suffix, "/") < depth) {
+				addItem(child, values[child])
+			}
+		}
+	}
+
+	var res strings.Builder
+
+	fmt.Fprintf(&res, "* METADATA %s (%s)\r\n", quoteMetadataString(mailbox), strings.Join(items, " "))
+
+	if longest > 0 {
+		fmt.Fprintf(&res, "%s OK [METADATA LONGENTRIES %d] GETMETADATA completed\r\n", tag, longest)
+	} else {
+		fmt.Fprintf(&res, "%s OK GETMETADATA completed\r\n", tag)
+	}
+
+	return res.String()
+}
+
+func setMetadataResponse(ctx context.Context, tag string, tokens []metadataToken, metadata imapservice.MailboxMetadata) string {
+	if len(tokens) != 2 || tokens[0].isList || tokens[0].isNil || !tokens[1].isList || len(tokens[1].list)%2 != 0 {
+		return fmt.Sprintf("%s BAD expected mailbox and entry values\r\n", tag)
+	}
+
+	entries := make(map[string]*string)
+
+	for i := 0; i < len(tokens[1].list); i += 2 {
+		entry, value := tokens[1].list[i], tokens[1].list[i+1]
+
+		if entry.isList || entry.isNil || !isMetadataEntry(entry.value) || value.isList {
+			return fmt.Sprintf("%s BAD invalid entry value\r\n", tag)
+		}
+
+		if value.isNil {
+			entries[strings.ToLower(entry.value)] = nil
+		} else {
+			entries[strings.ToLower(entry.value)] = &value.value
+		}
+	}
+
+	if tokens[0].value == "" {
+		return fmt.Sprintf("%s NO [METADATA NOPRIVATE] %v\r\n", tag, imapservice.ErrMetadataNotSupported)
+	}
+
+	name, err := utf7.Encoding.NewDecoder().String(tokens[0].value)
+	if err != nil {
+		return fmt.Sprintf("%s BAD invalid mailbox name\r\n", tag)
+	}
+
+	if err := metadata.SetMailboxMetadata(ctx, name, entries); errors.Is(err, imapservice.ErrMetadataNotSupported) {
+		return fmt.Sprintf("%s NO [METADATA NOPRIVATE] %v\r\n", tag, err)
+	} else if errors.Is(err, imapservice.ErrReadOnlySession) {
+		return fmt.Sprintf("%s NO [%s] %v\r\n", tag, responseCodeNoPerm, err)
+	} else if err != nil {
+		return fmt.Sprintf("%s NO %v\r\n", tag, err)
+	}
+
+	return fmt.Sprintf("%s OK SETMETADATA completed\r\n", tag)
+}
+
+// metadataEntries returns the entries requested by GETMETADATA, a single entry or a list of entries, in lower case.
+func metadataEntries(token metadataToken) ([]string, error) {
+	list := []metadataToken{token}
+
+	if token.isList {
+		list = token.list
+	}
+
+	entries := make([]string, 0, len(list))
+
+	for _, entry := range list {
+		if entry.isList || entry.isNil || !isMetadataEntry(entry.value) {
+			return nil, errors.New("invalid entry name")
+		}
+
+		entries = append(entries, strings.ToLower(entry.value))
+	}
+
+	if len(entries) == 0 {
+		return nil, errors.New("missing entry names")
+	}
+
+	return entries, nil
+}
+
+// isMetadataEntry returns whether the entry name is valid: the entries are /private, /shared or below them.
+func isMetadataEntry(entry string) bool {
+	lower := strings.ToLower(entry)
+
+	if lower == "/private" || lower == "/shared" {
+		return true
+	}
+
+	if !strings.HasPrefix(lower, "/private/") && !strings.HasPrefix(lower, "/shared/") {
+		return false
+	}
+
+	return !strings.HasSuffix(entry, "/") && !strings.Contains(entry, "//") && !strings.ContainsAny(entry, "*%")
+}
+
+// parseMetadataArgs splits the arguments of a METADATA command into atoms, quoted strings and parenthesized lists.
+func parseMetadataArgs(args []byte) ([]metadataToken, error) {
+	tokens, rest, err := parseMetadataList(args, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rest) > 0 {
+		return nil, errors.New("unexpected closing parenthesis")
+	}
+
+	return tokens, nil
+}
+
+func parseMetadataList(args []byte, nested bool) ([]metadataToken, []byte, error) {
+	var tokens []metadataToken
+
+	for {
+		args = bytes.TrimLeft(args, " ")
+
+		if len(args) == 0 {
+			if nested {
+				return nil, nil, errors.New("missing closing parenthesis")
+			}
+
+			return tokens, nil, nil
+		}
+
+		switch args[0] {
+		case ')':
+			if !nested {
+				return tokens, args, nil
+			}
+
+			return tokens, args[1:], nil
+
+		case '(':
+			list, rest, err := parseMetadataList(args[1:], true)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			tokens = append(tokens, metadataToken{isList: true, list: list})
+			args = rest
+
+		case '"':
+			value, rest, err := parseQuotedString(args[1:])
+			if err != nil {
+				return nil, nil, err
+			}
+
+			tokens = append(tokens, metadataToken{value: value})
+			args = rest
+
+		default:
+			end := bytes.IndexAny(args, " ()")
+			if end < 0 {
+				end = len(args)
+			}
+
+			if atom := string(args[:end]); strings.EqualFold(atom, "NIL") {
+				tokens = append(tokens, metadataToken{isNil: true})
+			} else {
+				tokens = append(tokens, metadataToken{value: atom})
+			}
+
+			args = args[end:]
+		}
+	}
+}
+
+func parseQuotedString(args []byte) (string, []byte, error) {
+	var value []byte
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case '\\':
+			if i+1 == len(args) {
+				return "", nil, errors.New("invalid quoted string")
+			}
+
+			i++
+			value = append(value, args[i])
+
+		case '"':
+			return string(value), args[i+1:], nil
+
+		default:
+			value = append(value, args[i])
+		}
+	}
+
+	return "", nil, errors.New("invalid quoted string")
+}
+
+// quoteMetadataString returns the value as a quoted string, or as a literal if it cannot be quoted.
+func quoteMetadataString(value string) string {
+	if strings.ContainsAny(value, "\r\n\x00") {
+		return fmt.Sprintf("{%d}\r\n%s", len(value), value)
+	}
+
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+package imapsmtpserver
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapservice"
+	"github.com/stretchr/testify/require"
+)
+
+type testMetadata struct {
+	mailboxes map[string]map[string]string
+}
+
+func (m *testMetadata) GetMailboxMetadata(mailbox string) (map[string]string, error) {
+	entries, ok := m.mailboxes[mailbox]
+	if !ok {
+		return nil, imapservice.ErrNoSuchMailbox
+	}
+
+	return entries, nil
+}
+
+func (m *testMetadata) SetMailboxMetadata(ctx context.Context, mailbox string, entries map[string]*string) error {
+	// The entries are set through the API, which mustn't hold the session forever.
+	if _, ok := ctx.Deadline(); !ok {
+		return errors.New("no deadline")
+	}
+
+	if mailbox == "ReadOnly" {
+		return imapservice.ErrReadOnlySession
+	}
+
+	if _, ok := m.mailboxes[mailbox]; !ok {
+		return imapservice.ErrNoSuchMailbox
+	}
+
+	for entry, value := range entries {
+		if entry != imapservice.MetadataColor || value == nil {
+			return imapservice.ErrMetadataNotSupported
+		}
+
+		m.mailboxes[mailbox][entry] = *value
+	}
+
+	return nil
+}
+
+func TestMetadataHandler(t *testing.T) {
+	handler := new(metadataHandler)
+
+	_, gluon, clientConn := newTestProxyConn(t, handler)
+
+	handler.setMetadata(&testMetadata{mailboxes: map[string]map[string]string{
+		"Folders/Été": {imapservice.MetadataColor: "#ff0000"},
+	}})
+
+	// Gluon only gets the commands it supports.
+	forwarded := make(chan string)
+
+	go func() {
+		line, _ := gluon.ReadString('\n')
+		forwarded <- line
+	}()
+
+	client := bufio.NewReader(clientConn)
+
+	for _, tc := range []struct {
+		command string
+		want    []string
+	}{
+		{"a1 GETMETADATA \"Folders/&AMk-t&AOk-\" (/private/color /private/comment)\r\n", []string{
+			"* METADATA \"Folders/&AMk-t&AOk-\" (/private/color \"#ff0000\" /private/comment NIL)\r\n",
+			"a1 OK GETMETADATA completed\r\n",
+		}},
+		{"a2 GETMETADATA (DEPTH infinity MAXSIZE 3) Folders/&AMk-t&AOk- /private\r\n", []string{
+			"* METADATA \"Folders/&AMk-t&AOk-\" (/private NIL)\r\n",
+			"a2 OK [METADATA LONGENTRIES 7] GETMETADATA completed\r\n",
+		}},
+		{"a3 SETMETADATA Folders/&AMk-t&AOk- (/private/color \"#00ff00\")\r\n", []string{
+			"a3 OK SETMETADATA completed\r\n",
+		}},
+		{"a4 GETMETADATA (DEPTH 1) Folders/&AMk-t&AOk- /private\r\n", []string{
+			"* METADATA \"Folders/&AMk-t&AOk-\" (/private NIL /private/color \"#00ff00\")\r\n",
+			"a4 OK GETMETADATA completed\r\n",
+		}},
+		{"a5 SETMETADATA Folders/&AMk-t&AOk- (/private/comment \"work\")\r\n", []string{
+			"a5 NO [METADATA NOPRIVATE] the entry cannot be set\r\n",
+		}},
+		{"a6 GETMETADATA Unknown /private/color\r\n", []string{
+			"a6 NO no such mailbox\r\n",
+		}},
+		{"a7 GETMETADATA INBOX (/color)\r\n", []string{
+			"a7 BAD invalid entry name\r\n",
+		}},
+		{"a8 SETMETADATA ReadOnly (/private/color \"#00ff00\")\r\n", []string{
+			"a8 NO [NOPERM] the session is read-only: operation not allowed\r\n",
+		}},
+	} {
+		go func() { _, _ = clientConn.Write([]byte(tc.command)) }()
+
+		for _, want := range tc.want {
+			line, err := client.ReadString('\n')
+			require.NoError(t, err)
+			require.Equal(t, want, line)
+		}
+	}
+
+	go func() { _, _ = clientConn.Write([]byte("a9 NOOP\r\n")) }()
+
+	require.Equal(t, "a9 NOOP\r\n", <-forwarded)
+}
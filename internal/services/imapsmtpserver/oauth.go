@@ -0,0 +1,159 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/emersion/go-sasl"
+	"github.com/emersion/go-smtp"
+)
+
+// The SASL mechanisms authenticating with an OAuth2 access token.
+const (
+	mechOAuthBearer = sasl.OAuthBearer
+	mechXOAuth2     = "XOAUTH2"
+)
+
+// oauthErrorChallenge is the challenge sent when the token is refused, see RFC 7628 section 3.2.2.
+const oauthErrorChallenge = `{"status":"invalid_token","schemes":"bearer"}`
+
+var (
+	errInvalidOAuthResponse = errors.New("invalid OAuth SASL response")
+
+	errOAuthFailed = &smtp.SMTPError{
+		Code:         535,
+		EnhancedCode: smtp.EnhancedCode{5, 7, 8},
+		Message:      "Authentication failed",
+	}
+)
+
+// OAuthVerifier verifies the access tokens presented with the OAUTHBEARER and XOAUTH2 mechanisms.
+type OAuthVerifier interface {
+	// OAuthEnabled returns whether the mechanisms are offered.
+	OAuthEnabled() bool
+
+	// VerifyOAuthToken returns the address to authenticate as and the password to authenticate with in place of the
+	// token, if the token was issued to the user of the given username. An empty username stands for the primary
+	// address of the token's user. The token itself is not accepted as a password.
+	VerifyOAuthToken(username, token string) (string, string, error)
+}
+
+func isOAuthMechanism(mech string) bool {
+	return strings.EqualFold(mech, mechOAuthBearer) || strings.EqualFold(mech, mechXOAuth2)
+}
+
+// parseOAuthResponse returns the username and the token of an OAUTHBEARER (RFC 7628) or XOAUTH2 client response.
+func parseOAuthResponse(mech string, response []byte) (string, string, error) {
+	fields := strings.Split(string(response), "\x01")
+
+	var username, token string
+
+	if strings.EqualFold(mech, mechOAuthBearer) {
+		// The GS2 header holds the optional authorization identity, channel binding is not supported.
+		header := strings.Split(fields[0], ",")
+		if len(header) < 3 || (header[0] != "n" && header[0] != "y") {
+			return "", "", errInvalidOAuthResponse
+		}
+
+		if authzID, ok := strings.CutPrefix(header[1], "a="); ok {
+			username = strings.NewReplacer("=2C", ",", "=3D", "=").Replace(authzID)
+		}
+
+		fields = fields[1:]
+	}
+
+	for _, field := range fields {
+		key, value, _ := strings.Cut(field, "=")
+
+		switch key {
+		case "user":
+			if strings.EqualFold(mech, mechXOAuth2) {
+				username = value
+			}
+
+		case "auth":
+			if scheme, bearer, ok := strings.Cut(value, " "); ok && strings.EqualFold(scheme, "Bearer") {
+				token = bearer
+			}
+		}
+	}
+
+	if token == "" {
+		return "", "", errInvalidOAuthResponse
+	}
+
+	return username, token, nil
+}
+
+// oauthSASLServer is the server side of the OAUTHBEARER and XOAUTH2 mechanisms.
+type oauthSASLServer struct {
+	mech         string
+	authenticate func(username, token string) error
+
+	err error
+}
+
+func (s *oauthSASLServer) Next(response []byte) ([]byte, bool, error) {
+	// After a failure, the client acknowledges the error challenge before the failure is reported.
+	if s.err != nil {
+		return nil, true, s.err
+	}
+
+	if response == nil {
+		return []byte{}, false, nil
+	}
+
+	username, token, err := parseOAuthResponse(s.mech, response)
+	if err == nil {
+		err = s.authenticate(username, token)
+	}
+
+	if err != nil {
+		s.err = err
+		return []byte(oauthErrorChallenge), false, nil
+	}
+
+	return nil, true, nil
+}
+
+// enableSMTPOAuth adds the OAUTHBEARER and XOAUTH2 mechanisms to the SMTP server. A valid token authenticates the
+// session as its user would with an app password of the token's scope.
+func enableSMTPOAuth(smtpServer *smtp.Server, verifier OAuthVerifier, guard *accessGuard) {
+	for _, mech := range []string{mechOAuthBearer, mechXOAuth2} {
+		mech := mech
+
+		smtpServer.EnableAuth(mech, func(conn *smtp.Conn) sasl.Server {
+			return &oauthSASLServer{
+				mech: mech,
+				authenticate: func(username, token string) error {
+					email, password, err := verifier.VerifyOAuthToken(username, token)
+					if err != nil {
+						logSMTP.WithError(err).Warn("Refused OAuth token")
+						guard.authFailed(conn.Conn().RemoteAddr())
+
+						return errOAuthFailed
+					}
+
+					return conn.Session().AuthPlain(email, password)
+				},
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+var logOAuth = logrus.WithField("pkg", "server/oauth") //nolint:gochecknoglobals
+
+// oauthCapabilities are advertised next to the capabilities of gluon before authentication.
+const oauthCapabilities = "AUTH=" + mechOAuthBearer + " AUTH=" + mechXOAuth2
+
+// oauthHandler lets the IMAP clients authenticate with the OAUTHBEARER and XOAUTH2 mechanisms, which gluon doesn't
+// support: a successful OAuth authentication is replaced with a LOGIN command using the password standing for the
+// token, which the user checks like an app password of the token's scope.
+type oauthHandler struct {
+	verifier OAuthVerifier
+	guard    *accessGuard
+}
+
+func (h *oauthHandler) capabilities(authenticated bool) string {
+	if authenticated {
+		return ""
+	}
+
+	return oauthCapabilities
+}
+
+// handleCommand handles an AUTHENTICATE command with an OAuth mechanism, see RFC 3501 section 6.2.2 and RFC 7628.
+func (h *oauthHandler) handleCommand(conn *imapProxyConn, cmd *proxyCommand) (bool, error) {
+	if cmd.name != "AUTHENTICATE" || cmd.literal > 0 {
+		return false, nil
+	}
+
+	mech, encoded, hasResponse := strings.Cut(cmd.args, " ")
+	if !isOAuthMechanism(mech) {
+		return false, nil
+	}
+
+	if !hasResponse {
+		if err := conn.reply("+ \r\n"); err != nil {
+			return false, err
+		}
+
+		line, err := conn.readLine()
+		if err != nil {
+			return false, err
+		}
+
+		encoded = line
+	}
+
+	if encoded == "*" {
+		return true, conn.reply(cmd.tag + " BAD Authentication cancelled\r\n")
+	}
+
+	if encoded == "=" {
+		encoded = ""
+	}
+
+	response, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return true, conn.reply(cmd.tag + " BAD Invalid base64 response\r\n")
+	}
+
+	username, token, err := parseOAuthResponse(mech, response)
+	if err != nil {
+		return true, h.refuse(conn, cmd.tag, mech, err)
+	}
+
+	email, password, err := h.verifier.VerifyOAuthToken(username, token)
+	if err != nil {
+		return true, h.refuse(conn, cmd.tag, mech, err)
+	}
+
+	cmd.rewrite("LOGIN", quoteIMAPString(email)+" "+quoteIMAPString(password))
+
+	return false, nil
+}
+
+func (h *oauthHandler) handleResponse(_ string, res []byte) []byte {
+	return res
+}
+
+// refuse reports a failed OAuth authentication, once the client acknowledged the error challenge.
+func (h *oauthHandler) refuse(conn *imapProxyConn, tag, mech string, err error) error {
+	logOAuth.WithError(err).WithField("mechanism", mech).Warn("Refused OAuth authentication")
+	h.guard.authFailed(conn.RemoteAddr())
+
+	if err := conn.reply("+ " + base64.StdEncoding.EncodeToString([]byte(oauthErrorChallenge)) + "\r\n"); err != nil {
+		return err
+	}
+
+	if _, err := conn.readLine(); err != nil {
+		return err
+	}
+
+	return conn.reply(tag + " NO [AUTHENTICATIONFAILED] Authentication failed\r\n")
+}
+
+func quoteIMAPString(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
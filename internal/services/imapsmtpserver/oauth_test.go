@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"bufio"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testOAuthVerifier struct{}
+
+func (testOAuthVerifier) OAuthEnabled() bool {
+	return true
+}
+
+func (testOAuthVerifier) VerifyOAuthToken(username, token string) (string, string, error) {
+	if token != "token" || (username != "" && username != "user@pm.me") {
+		return "", "", errors.New("invalid token")
+	}
+
+	return "user@pm.me", "login-token", nil
+}
+
+func TestParseOAuthResponse(t *testing.T) {
+	tests := []struct {
+		mech, response  string
+		username, token string
+		wantErr         bool
+	}{
+		{mech: mechOAuthBearer, response: "n,a=user@pm.me,\x01host=127.0.0.1\x01port=1143\x01auth=Bearer token\x01\x01", username: "user@pm.me", token: "token"},
+		{mech: mechOAuthBearer, response: "n,,\x01auth=bearer token\x01\x01", token: "token"},
+		{mech: mechOAuthBearer, response: "n,a=a=3Db=2Cc,\x01auth=Bearer token\x01\x01", username: "a=b,c", token: "token"},
+		{mech: mechOAuthBearer, response: "p=tls-unique,,\x01auth=Bearer token\x01\x01", wantErr: true},
+		{mech: mechOAuthBearer, response: "n,,\x01host=127.0.0.1\x01\x01", wantErr: true},
+		{mech: mechXOAuth2, response: "user=user@pm.me\x01auth=Bearer token\x01\x01", username: "user@pm.me", token: "token"},
+		{mech: mechXOAuth2, response: "user=user@pm.me\x01auth=Basic token\x01\x01", wantErr: true},
+	}
+
+	for _, test := range tests {
+		username, token, err := parseOAuthResponse(test.mech, []byte(test.response))
+		if test.wantErr {
+			require.Error(t, err, test.response)
+			continue
+		}
+
+		require.NoError(t, err, test.response)
+		require.Equal(t, test.username, username)
+		require.Equal(t, test.token, token)
+	}
+}
+
+func TestOAuthHandler(t *testing.T) {
+	conn, gluon, clientConn := newTestProxyConn(t, &oauthHandler{verifier: testOAuthVerifier{}, guard: newTestGuard(t)})
+
+	client := bufio.NewReader(clientConn)
+
+	send := func(line string) {
+		go func() { _, _ = clientConn.Write([]byte(line + "\r\n")) }()
+	}
+
+	respond := func(res string) {
+		go func() { _, _ = conn.Write([]byte(res)) }()
+	}
+
+	expectForwarded := func(data string) {
+		line, err := gluon.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, data, line)
+	}
+
+	expect := func(prefix string) string {
+		line, err := client.ReadString('\n')
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(line, prefix), line)
+
+		return line
+	}
+
+	// The OAuth mechanisms are advertised before authentication, in the CAPABILITY responses only.
+	respond("* OK [CAPABILITY IMAP4rev1 ID AUTH=PLAIN] Proton Mail Bridge ready\r\n")
+	require.Contains(t, expect("* OK"), "IMAP4rev1 AUTH=OAUTHBEARER AUTH=XOAUTH2 ID AUTH=PLAIN")
+
+	send("a0 CAPABILITY")
+	expectForwarded("a0 CAPABILITY\r\n")
+
+	respond("* CAPABILITY IMAP4rev1 ID AUTH=PLAIN\r\na0 OK CAPABILITY completed IMAP4rev1\r\n")
+	require.Contains(t, expect("* CAPABILITY"), "AUTH=XOAUTH2")
+	require.Equal(t, "a0 OK CAPABILITY completed IMAP4rev1\r\n", expect("a0 OK"))
+
+	// The other mechanisms are left to gluon.
+	send("a1 AUTHENTICATE PLAIN")
+	expectForwarded("a1 AUTHENTICATE PLAIN\r\n")
+
+	respond("a1 NO Authentication failed\r\n")
+	expect("a1 NO")
+
+	// An invalid token is refused once the client acknowledged the error challenge.
+	send("a2 AUTHENTICATE XOAUTH2 " + base64.StdEncoding.EncodeToString([]byte("user=user@pm.me\x01auth=Bearer wrong\x01\x01")))
+	challenge, err := base64.StdEncoding.DecodeString(strings.TrimSpace(strings.TrimPrefix(expect("+ "), "+ ")))
+	require.NoError(t, err)
+	require.Equal(t, oauthErrorChallenge, string(challenge))
+
+	send("")
+	expect("a2 NO [AUTHENTICATIONFAILED]")
+
+	// A valid token is turned into a login with the token as password, which the user checks with its scope.
+	send("a3 AUTHENTICATE OAUTHBEARER")
+	expect("+ ")
+
+	send(base64.StdEncoding.EncodeToString([]byte("n,,\x01auth=Bearer token\x01\x01")))
+	expectForwarded("a3 LOGIN \"user@pm.me\" \"login-token\"\r\n")
+
+	respond("a3 OK [CAPABILITY IMAP4rev1 ID IDLE] Logged in\r\n")
+	require.Equal(t, "a3 OK [CAPABILITY IMAP4rev1 ID IDLE] Logged in\r\n", expect("a3 OK"))
+
+	// Once authenticated, the mechanisms are no longer advertised.
+	send("a4 CAPABILITY")
+	expectForwarded("a4 CAPABILITY\r\n")
+
+	respond("* CAPABILITY IMAP4rev1 ID IDLE\r\n")
+	require.Equal(t, "* CAPABILITY IMAP4rev1 ID IDLE\r\n", expect("* CAPABILITY"))
+}
@@ -0,0 +1,627 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ProtonMail/gluon/async"
+)
+
+// proxyTagPrefix starts the tags of the commands the proxy runs through gluon, so that their responses are told apart.
+const proxyTagPrefix = "bridge-proxy-"
+
+// proxyHandler adds an extension gluon doesn't support to the IMAP server, see imapProxyConn.
+type proxyHandler interface {
+	// capabilities returns the capabilities advertised next to those of gluon, if any, before or after the client
+	// authenticated.
+	capabilities(authenticated bool) string
+
+	// handleCommand is called with each command of the client, in the goroutine reading them. It returns true if it
+	// answered the command, which is then not forwarded to gluon, and may otherwise rewrite it. The commands announcing
+	// a literal are never answered.
+	handleCommand(conn *imapProxyConn, cmd *proxyCommand) (bool, error)
+
+	// handleResponse returns the response of gluon to send in place of the given one. The command is the name of the
+	// client command the response completes, empty for untagged responses.
+	handleResponse(command string, res []byte) []byte
+}
+
+// proxyCommand is a command line of the client.
+type proxyCommand struct {
+	tag string
+
+	// name is the name of the command in upper case, followed by the name of the command it applies to for UID.
+	name string
+
+	// args are the arguments of the command up to the end of the line.
+	args string
+
+	// line is what is forwarded to gluon.
+	line []byte
+
+	// literal is the size of the literal announced at the end of the line, 0 if none.
+	literal int
+}
+
+// parseCommand returns the command starting with the given line, if any.
+func parseCommand(line []byte, literal int) (*proxyCommand, bool) {
+	tag, rest, ok := strings.Cut(strings.TrimRight(string(line), "\r\n"), " ")
+	if !ok || tag == "" {
+		return nil, false
+	}
+
+	name, args, _ := strings.Cut(rest, " ")
+	name = strings.ToUpper(name)
+
+	if name == "UID" {
+		command, commandArgs, _ := strings.Cut(args, " ")
+		name, args = name+" "+strings.ToUpper(command), commandArgs
+	}
+
+	return &proxyCommand{tag: tag, name: name, args: args, line: bytes.Clone(line), literal: literal}, name != ""
+}
+
+// rewrite replaces the name and the arguments of the command forwarded to gluon.
+func (cmd *proxyCommand) rewrite(name, args string) {
+	ending := cmd.line[len(bytes.TrimRight(cmd.line, "\r\n")):]
+
+	cmd.name, cmd.args = name, args
+	cmd.line = append([]byte(cmd.tag+" "+name+" "+args), ending...)
+}
+
+// proxyExchange is a command the proxy runs through gluon.
+type proxyExchange struct {
+	tag     string
+	capture func(res []byte) bool
+	doneCh  chan []byte
+}
+
+// imapProxyConn stands between an IMAP client and gluon to add the extensions gluon doesn't support, each of them
+// implemented by a proxyHandler. The client commands are read in a goroutine of the connection and passed to the
+// handlers, which can rewrite them, answer them or run commands of their own through gluon; the other commands are
+// handed to gluon, which reads them from a pipe. The responses of gluon, which writes each of them at once, are passed
+// to the handlers before being sent, and get the capabilities of the handlers and the response codes reported by the
+// connector, which gluon can't send. As gluon encrypts the connection after STARTTLS underneath it, the connection
+// handles STARTTLS itself when given a TLS config.
+//
+// The commands are followed line by line, skipping literals. Those following a LOGIN or AUTHENTICATE command are held
+// until gluon completed it, so that they are handled knowing the session it opened, e.g. whether it is read-only.
+type imapProxyConn struct {
+	net.Conn
+
+	handlers     []proxyHandler
+	tlsConfig    *tls.Config
+	panicHandler async.PanicHandler
+
+	// gluon reads what is written to pipeWriter from pipeReader.
+	pipeReader *io.PipeReader
+	pipeWriter *io.PipeWriter
+
+	// The following fields are only used by the goroutine reading the commands of the client.
+	reader  *bufio.Reader
+	nextTag int
+
+	// commands holds the names of the commands gluon has yet to complete, by tag.
+	commands map[string]string
+
+	// responseCode is added to the next refusal of gluon, see setResponseCode.
+	responseCode string
+
+	// authenticated is set once gluon accepted a LOGIN or AUTHENTICATE command of the client.
+	authenticated bool
+
+	// tlsStarted is set once the connection handled STARTTLS, which is then no longer advertised.
+	tlsStarted bool
+
+	// authTag is the tag of the LOGIN or AUTHENTICATE command gluon has yet to complete, if any. authSent is set once
+	// the whole command was handed to gluon, whose continuation requests are then counted in authContinuations until
+	// the client answered them. The changes are signalled on authCh.
+	authTag           string
+	authSent          bool
+	authContinuations int
+	authCh            chan struct{}
+
+	// exchange is the command the proxy runs through gluon, if any.
+	exchange *proxyExchange
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	lock sync.Mutex
+}
+
+func newIMAPProxyConn(conn net.Conn, tlsConfig *tls.Config, panicHandler async.PanicHandler, handlers ...proxyHandler) *imapProxyConn {
+	pipeReader, pipeWriter := io.Pipe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := &imapProxyConn{
+		Conn:         conn,
+		handlers:     handlers,
+		tlsConfig:    tlsConfig,
+		panicHandler: panicHandler,
+		pipeReader:   pipeReader,
+		pipeWriter:   pipeWriter,
+		reader:       bufio.NewReader(conn),
+		commands:     make(map[string]string),
+		authCh:       make(chan struct{}, 1),
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+
+	go c.readCommands()
+
+	return c
+}
+
+// setResponseCode makes the connection add the response code to the next tagged NO response of gluon, the connector
+// having refused the command being run with it.
+func (c *imapProxyConn) setResponseCode(code string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.responseCode = code
+}
+
+func (c *imapProxyConn) Read(p []byte) (int, error) {
+	return c.pipeReader.Read(p)
+}
+
+func (c *imapProxyConn) Write(p []byte) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	tag, status, _ := bytes.Cut(p, []byte(" "))
+
+	res := p
+
+	switch {
+	case c.exchange != nil && string(tag) == c.exchange.tag:
+		c.exchange.doneCh <- bytes.Clone(status)
+		c.exchange = nil
+
+		return len(p), nil
+
+	case string(tag) == "*":
+		if c.exchange != nil && c.exchange.capture != nil && c.exchange.capture(p) {
+			return len(p), nil
+		}
+
+		res = c.handleResponse("", res)
+
+	case string(tag) == "+":
+		if c.authSent {
+			c.authContinuations++
+			c.signalAuthentication()
+		}
+
+	default:
+		command := c.commands[string(tag)]
+		delete(c.commands, string(tag))
+
+		if string(tag) == c.authTag {
+			c.authTag, c.authSent, c.authContinuations = "", false, 0
+			c.signalAuthentication()
+		}
+
+		if (command == "LOGIN" || command == "AUTHENTICATE") && bytes.HasPrefix(bytes.ToUpper(status), []byte("OK")) {
+			c.authenticated = true
+		}
+
+		if c.responseCode != "" {
+			res = addResponseCode(res, c.responseCode)
+			c.responseCode = ""
+		}
+
+		res = c.handleResponse(command, res)
+	}
+
+	if _, err := c.Conn.Write(res); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// signalAuthentication wakes up the goroutine reading the commands if it waits for gluon to complete an authentication.
+func (c *imapProxyConn) signalAuthentication() {
+	select {
+	case c.authCh <- struct{}{}:
+	default:
+	}
+}
+
+func (c *imapProxyConn) Close() error {
+	c.cancel()
+
+	// Gluon stops reading, or gets an error if it didn't close the connection itself.
+	_ = c.pipeReader.CloseWithError(net.ErrClosed)
+
+	c.lock.Lock()
+	conn := c.Conn
+	c.lock.Unlock()
+
+	return conn.Close()
+}
+
+// handleResponse passes the response to the handlers and adds their capabilities, in a CAPABILITY response or response
+// code only.
+func (c *imapProxyConn) handleResponse(command string, res []byte) []byte {
+	if c.tlsStarted {
+		res = removeCapability(res, "STARTTLS")
+	}
+
+	var capabilities []string
+
+	for _, handler := range c.handlers {
+		res = handler.handleResponse(command, res)
+
+		if handlerCapabilities := handler.capabilities(c.authenticated); handlerCapabilities != "" {
+			capabilities = append(capabilities, handlerCapabilities)
+		}
+	}
+
+	if len(capabilities) > 0 {
+		if withCapabilities, ok := addCapabilities(res, strings.Join(capabilities, " ")); ok {
+			return withCapabilities
+		}
+	}
+
+	return res
+}
+
+// readCommands hands the data of the client to gluon until the connection fails.
+func (c *imapProxyConn) readCommands() {
+	defer async.HandlePanic(c.panicHandler)
+
+	_ = c.pipeWriter.CloseWithError(c.forwardCommands())
+}
+
+func (c *imapProxyConn) forwardCommands() error {
+	// continued is true while reading the rest of a command after a literal or a line too long to be inspected.
+	var continued bool
+
+	for {
+		line, err := c.reader.ReadSlice('\n')
+		if errors.Is(err, bufio.ErrBufferFull) {
+			if err := c.forward(line); err != nil {
+				return err
+			}
+
+			continued = true
+
+			continue
+		} else if err != nil {
+			// The data read before the error is handed over first.
+			if len(line) > 0 {
+				if err := c.forward(line); err != nil {
+					return err
+				}
+			}
+
+			return err
+		}
+
+		literal := literalSize(line)
+
+		if cmd, ok := parseCommand(line, literal); ok && !continued {
+			if err := c.handleCommand(cmd); err != nil {
+				return err
+			}
+		} else {
+			if literal == 0 {
+				c.setAuthenticationSent()
+			}
+
+			if err := c.forward(line); err != nil {
+				return err
+			}
+		}
+
+		continued = literal > 0
+
+		if literal > 0 {
+			if _, err := io.CopyN(c.pipeWriter, c.reader, int64(literal)); err != nil {
+				return err
+			}
+		} else if err := c.waitAuthentication(); err != nil {
+			return err
+		}
+	}
+}
+
+// waitAuthentication waits for gluon to complete the LOGIN or AUTHENTICATE command sent, if any, handing it the lines
+// the client sends in response to its continuation requests.
+func (c *imapProxyConn) waitAuthentication() error {
+	for {
+		c.lock.Lock()
+		waiting, continued := c.authTag != "", c.authContinuations > 0
+
+		if continued {
+			c.authContinuations--
+		}
+		c.lock.Unlock()
+
+		if !waiting {
+			return nil
+		}
+
+		if !continued {
+			select {
+			case <-c.authCh:
+				continue
+
+			case <-c.ctx.Done():
+				return net.ErrClosed
+			}
+		}
+
+		line, err := c.reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+
+		if err := c.forward([]byte(line)); err != nil {
+			return err
+		}
+	}
+}
+
+// setAuthenticationSent records that the LOGIN or AUTHENTICATE command being sent, if any, is about to be complete,
+// before the last line of the command is handed to gluon.
+func (c *imapProxyConn) setAuthenticationSent() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.authSent = c.authTag != ""
+}
+
+func (c *imapProxyConn) handleCommand(cmd *proxyCommand) error {
+	if cmd.name == "STARTTLS" && c.tlsConfig != nil {
+		// The field is only set by this goroutine.
+		if c.tlsStarted {
+			return c.reply(cmd.tag + " BAD TLS is already active\r\n")
+		}
+
+		return c.startTLS(cmd.tag)
+	}
+
+	for _, handler := range c.handlers {
+		if handled, err := handler.handleCommand(c, cmd); err != nil || handled {
+			return err
+		}
+	}
+
+	return c.forwardCommand(cmd)
+}
+
+// forwardCommand hands a command to gluon, which is recorded until gluon completes it.
+func (c *imapProxyConn) forwardCommand(cmd *proxyCommand) error {
+	c.lock.Lock()
+	c.commands[cmd.tag] = cmd.name
+
+	// The continuation requests of gluon before the command is complete are for its literals.
+	if cmd.name == "LOGIN" || cmd.name == "AUTHENTICATE" {
+		c.authTag, c.authSent = cmd.tag, cmd.literal == 0
+	}
+	c.lock.Unlock()
+
+	return c.forward(cmd.line)
+}
+
+func (c *imapProxyConn) forward(data []byte) error {
+	_, err := c.pipeWriter.Write(data)
+
+	return err
+}
+
+// readLine reads a line the client sent in response to a continuation request of the proxy.
+func (c *imapProxyConn) readLine() (string, error) {
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// reply sends a response of the proxy to the client.
+func (c *imapProxyConn) reply(res string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_, err := c.Conn.Write([]byte(res))
+
+	return err
+}
+
+// exec runs a command through gluon once it completed the commands before, and returns the status of its tagged
+// response. Its untagged responses are passed to capture, if any, which returns whether it consumed them; the others
+// are sent to the client.
+func (c *imapProxyConn) exec(command string, capture func(res []byte) bool) (string, error) {
+	c.nextTag++
+
+	exchange := &proxyExchange{
+		tag:     proxyTagPrefix + strconv.Itoa(c.nextTag),
+		capture: capture,
+		doneCh:  make(chan []byte, 1),
+	}
+
+	c.lock.Lock()
+	c.exchange = exchange
+	c.lock.Unlock()
+
+	if err := c.forward([]byte(exchange.tag + " " + command + "\r\n")); err != nil {
+		return "", err
+	}
+
+	select {
+	case status := <-exchange.doneCh:
+		return strings.TrimRight(string(status), "\r\n"), nil
+
+	case <-c.ctx.Done():
+		return "", net.ErrClosed
+	}
+}
+
+// startTLS upgrades the connection to TLS; gluon keeps using the connection as if it were not encrypted.
+func (c *imapProxyConn) startTLS(tag string) error {
+	// The commands sent before are completed first so that gluon doesn't write during the handshake.
+	if _, err := c.exec("NOOP", nil); err != nil {
+		return err
+	}
+
+	if err := c.reply(tag + " OK Begin TLS negotiation now\r\n"); err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	conn := tls.Server(c.Conn, c.tlsConfig)
+	c.lock.Unlock()
+
+	if err := conn.Handshake(); err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// Data sent before the handshake is discarded to prevent command injection (RFC 3501 section 6.2.1).
+	c.Conn = conn
+	c.reader = bufio.NewReader(conn)
+	c.tlsStarted = true
+
+	return nil
+}
+
+// literalSize returns the size of the literal announced at the end of the line, if any. The braces of a quoted string
+// don't announce a literal, even if the string is not terminated.
+func literalSize(line []byte) int {
+	line = bytes.TrimRight(line, "\r\n")
+
+	start := -1
+
+	var quoted, escaped bool
+
+	for i, b := range line {
+		switch {
+		case escaped:
+			escaped = false
+
+		case quoted && b == '\\':
+			escaped = true
+
+		case b == '"':
+			quoted = !quoted
+
+		case !quoted && b == '{':
+			start = i
+		}
+	}
+
+	if quoted || start < 0 || !bytes.HasSuffix(line, []byte("}")) {
+		return 0
+	}
+
+	size, err := strconv.Atoi(string(bytes.TrimSuffix(line[start+1:len(line)-1], []byte("+"))))
+	if err != nil || size < 0 {
+		return 0
+	}
+
+	return size
+}
+
+// addResponseCode adds the response code to a tagged NO response which has none.
+func addResponseCode(res []byte, code string) []byte {
+	tag, rest, ok := bytes.Cut(res, []byte(" "))
+	if !ok || bytes.Equal(tag, []byte("*")) || bytes.Equal(tag, []byte("+")) {
+		return res
+	}
+
+	text, ok := bytes.CutPrefix(rest, []byte("NO "))
+	if !ok || bytes.HasPrefix(text, []byte("[")) {
+		return res
+	}
+
+	return append(append(append([]byte{}, tag...), " NO ["+code+"] "...), text...)
+}
+
+// addCapabilities adds the given capabilities to those listed by gluon, in a CAPABILITY response or response code.
+func addCapabilities(res []byte, capabilities string) ([]byte, bool) {
+	line, ok := capabilityLine(res)
+	if !ok {
+		return nil, false
+	}
+
+	idx := bytes.Index(line, []byte("IMAP4rev1"))
+	if idx < 0 {
+		return nil, false
+	}
+
+	idx += len("IMAP4rev1")
+
+	return append(append(append([]byte{}, res[:idx]...), " "+capabilities...), res[idx:]...), true
+}
+
+// removeCapability removes a capability listed by gluon, in a CAPABILITY response or response code.
+func removeCapability(res []byte, capability string) []byte {
+	line, ok := capabilityLine(res)
+	if !ok {
+		return res
+	}
+
+	idx := bytes.Index(bytes.ToUpper(line), []byte(" "+capability))
+	if idx < 0 {
+		return res
+	}
+
+	// The capability must not be the start of another one.
+	end := idx + 1 + len(capability)
+	if end < len(line) && bytes.IndexByte([]byte(" ]\r"), line[end]) < 0 {
+		return res
+	}
+
+	return append(append([]byte{}, res[:idx]...), res[end:]...)
+}
+
+// capabilityLine returns the first line of the response if it is a CAPABILITY response or has a CAPABILITY response
+// code.
+func capabilityLine(res []byte) ([]byte, bool) {
+	line, _, _ := bytes.Cut(res, []byte("\n"))
+
+	_, status, ok := bytes.Cut(line, []byte(" "))
+	if !ok {
+		return nil, false
+	}
+
+	if upper := bytes.ToUpper(status); !bytes.HasPrefix(upper, []byte("CAPABILITY ")) && !bytes.HasPrefix(upper, []byte("OK [CAPABILITY ")) {
+		return nil, false
+	}
+
+	return line, true
+}
@@ -0,0 +1,432 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/gluon"
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/gluon/connector"
+	"github.com/ProtonMail/gluon/imap"
+	"github.com/ProtonMail/proton-bridge/v3/internal/certs"
+	"github.com/ProtonMail/proton-bridge/v3/tests"
+	"github.com/stretchr/testify/require"
+)
+
+// testProxyHandler answers the X-TEST commands with the status of a NOOP run through gluon, of which it captures the
+// SEARCH responses.
+type testProxyHandler struct {
+	captured []string
+}
+
+func (h *testProxyHandler) capabilities(bool) string {
+	return "X-TEST"
+}
+
+func (h *testProxyHandler) handleCommand(conn *imapProxyConn, cmd *proxyCommand) (bool, error) {
+	if cmd.name != "X-TEST" || cmd.literal > 0 {
+		return false, nil
+	}
+
+	status, err := conn.exec("NOOP", func(res []byte) bool {
+		if !bytes.HasPrefix(res, []byte("* SEARCH")) {
+			return false
+		}
+
+		h.captured = append(h.captured, string(res))
+
+		return true
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, conn.reply(cmd.tag + " " + status + "\r\n")
+}
+
+func (h *testProxyHandler) handleResponse(_ string, res []byte) []byte {
+	return res
+}
+
+// newTestProxyConn returns a proxy connection, what it hands to gluon and the client side of the connection.
+func newTestProxyConn(t *testing.T, handlers ...proxyHandler) (*imapProxyConn, *bufio.Reader, net.Conn) {
+	clientConn, serverConn := net.Pipe()
+
+	conn := newIMAPProxyConn(serverConn, nil, async.NoopPanicHandler{}, handlers...)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		_ = clientConn.Close()
+	})
+
+	return conn, bufio.NewReader(conn), clientConn
+}
+
+func TestIMAPProxyConn_Commands(t *testing.T) {
+	handler := &testProxyHandler{}
+
+	conn, gluon, clientConn := newTestProxyConn(t, handler)
+
+	client := bufio.NewReader(clientConn)
+
+	send := func(data string) {
+		go func() { _, _ = clientConn.Write([]byte(data)) }()
+	}
+
+	expectForwarded := func(data string) {
+		line, err := gluon.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, data, line)
+	}
+
+	expectSent := func(data string) {
+		line, err := client.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, data, line)
+	}
+
+	// The commands the handlers don't answer are forwarded, the literals being skipped.
+	send("a1 APPEND INBOX {11}\r\na2 X-TEST\r\n\r\n")
+	expectForwarded("a1 APPEND INBOX {11}\r\n")
+	expectForwarded("a2 X-TEST\r\n")
+	expectForwarded("\r\n")
+
+	// The commands run by the handlers get their own tag, and their responses don't reach the client unless not captured.
+	send("a3 X-TEST\r\n")
+
+	line, err := gluon.ReadString('\n')
+	require.NoError(t, err)
+
+	tag, command, _ := strings.Cut(strings.TrimSpace(line), " ")
+	require.True(t, strings.HasPrefix(tag, proxyTagPrefix))
+	require.Equal(t, "NOOP", command)
+
+	go func() {
+		for _, res := range []string{"* SEARCH 1 2\r\n", "* 3 EXISTS\r\n", tag + " OK NOOP completed\r\n"} {
+			_, _ = conn.Write([]byte(res))
+		}
+	}()
+
+	expectSent("* 3 EXISTS\r\n")
+	expectSent("a3 OK NOOP completed\r\n")
+	require.Equal(t, []string{"* SEARCH 1 2\r\n"}, handler.captured)
+
+	// The braces ending an unterminated quoted string don't announce a literal, the next line being a command.
+	send("a4 SEARCH SUBJECT \"x{5}\r\na5 X-TEST\r\n")
+	expectForwarded("a4 SEARCH SUBJECT \"x{5}\r\n")
+
+	line, err = gluon.ReadString('\n')
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(line, proxyTagPrefix))
+}
+
+func TestIMAPProxyConn_Responses(t *testing.T) {
+	conn, _, clientConn := newTestProxyConn(t, &testProxyHandler{})
+
+	client := bufio.NewReader(clientConn)
+
+	// The response code reported by the connector is added to the next refusal only.
+	conn.setResponseCode("OVERQUOTA")
+
+	for _, tc := range []struct{ res, want string }{
+		{"a1 NO the account is over quota\r\n", "a1 NO [OVERQUOTA] the account is over quota\r\n"},
+		{"a2 NO the account is over quota\r\n", "a2 NO the account is over quota\r\n"},
+		{"* OK [CAPABILITY IMAP4rev1 IDLE] ready\r\n", "* OK [CAPABILITY IMAP4rev1 X-TEST IDLE] ready\r\n"},
+		{"* CAPABILITY IMAP4rev1 IDLE\r\n", "* CAPABILITY IMAP4rev1 X-TEST IDLE\r\n"},
+		{"a3 OK [CAPABILITY IMAP4rev1 IDLE] authenticated\r\n", "a3 OK [CAPABILITY IMAP4rev1 X-TEST IDLE] authenticated\r\n"},
+		{"* 1 FETCH (BODY[] {25}\r\n", "* 1 FETCH (BODY[] {25}\r\n"},
+		{"* OK IMAP4rev1 CAPABILITY\r\n", "* OK IMAP4rev1 CAPABILITY\r\n"},
+	} {
+		go func() { _, _ = conn.Write([]byte(tc.res)) }()
+
+		line, err := client.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, tc.want, line)
+	}
+}
+
+func TestIMAPProxyConn_StartTLS(t *testing.T) {
+	template, err := certs.NewTLSTemplate()
+	require.NoError(t, err)
+
+	certPEM, keyPEM, err := tests.FastGenerateCert(template)
+	require.NoError(t, err)
+
+	tlsConfig, err := certs.GetConfig(certPEM, keyPEM)
+	require.NoError(t, err)
+
+	clientConn, serverConn := net.Pipe()
+
+	conn := newIMAPProxyConn(serverConn, tlsConfig, async.NoopPanicHandler{})
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		_ = clientConn.Close()
+	})
+
+	gluon := bufio.NewReader(conn)
+
+	// The commands sent before are completed by gluon before the handshake.
+	go func() { _, _ = clientConn.Write([]byte("a1 STARTTLS\r\n")) }()
+
+	line, err := gluon.ReadString('\n')
+	require.NoError(t, err)
+
+	tag, _, _ := strings.Cut(line, " ")
+	go func() { _, _ = conn.Write([]byte(tag + " OK NOOP completed\r\n")) }()
+
+	line, err = bufio.NewReader(clientConn).ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "a1 OK Begin TLS negotiation now\r\n", line)
+
+	tlsConn := tls.Client(clientConn, &tls.Config{InsecureSkipVerify: true}) //nolint:gosec
+	require.NoError(t, tlsConn.Handshake())
+
+	client := bufio.NewReader(tlsConn)
+
+	// STARTTLS is refused once TLS is active, and no longer advertised.
+	go func() { _, _ = tlsConn.Write([]byte("a2 STARTTLS\r\n")) }()
+
+	line, err = client.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "a2 BAD TLS is already active\r\n", line)
+
+	go func() { _, _ = conn.Write([]byte("* CAPABILITY IMAP4rev1 STARTTLS IDLE\r\n")) }()
+
+	line, err = client.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "* CAPABILITY IMAP4rev1 IDLE\r\n", line)
+}
+
+func TestIMAPProxyConn_Authentication(t *testing.T) {
+	conn, gluon, clientConn := newTestProxyConn(t)
+
+	client := bufio.NewReader(clientConn)
+
+	respond := func(res string) {
+		go func() { _, _ = conn.Write([]byte(res)) }()
+
+		line, err := client.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, res, line)
+	}
+
+	expectForwarded := func(data string) {
+		line, err := gluon.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, data, line)
+	}
+
+	// The commands sent after an authentication are held until gluon completed it, the lines sent in response to its
+	// continuation requests being forwarded.
+	go func() { _, _ = clientConn.Write([]byte("a1 AUTHENTICATE PLAIN\r\n")) }()
+	expectForwarded("a1 AUTHENTICATE PLAIN\r\n")
+
+	respond("+ \r\n")
+
+	go func() { _, _ = clientConn.Write([]byte("AHVzZXIAcGFzcw==\r\na2 NOOP\r\n")) }()
+	expectForwarded("AHVzZXIAcGFzcw==\r\n")
+
+	respond("a1 OK [CAPABILITY IMAP4rev1 IDLE] Logged in\r\n")
+	expectForwarded("a2 NOOP\r\n")
+
+	// The commands are no longer held once authenticated.
+	go func() { _, _ = clientConn.Write([]byte("a3 NOOP\r\na4 NOOP\r\n")) }()
+	expectForwarded("a3 NOOP\r\n")
+	expectForwarded("a4 NOOP\r\n")
+}
+
+func TestIMAPProxyConn_Close(t *testing.T) {
+	conn, gluon, _ := newTestProxyConn(t)
+
+	// Gluon stops reading once the connection is closed by someone else.
+	require.NoError(t, conn.Close())
+
+	_, err := gluon.ReadString('\n')
+	require.Error(t, err)
+}
+
+func TestRemoveCapability(t *testing.T) {
+	for _, tc := range []struct{ res, want string }{
+		{"* CAPABILITY IMAP4rev1 STARTTLS IDLE\r\n", "* CAPABILITY IMAP4rev1 IDLE\r\n"},
+		{"* CAPABILITY IMAP4rev1 STARTTLS\r\n", "* CAPABILITY IMAP4rev1\r\n"},
+		{"* OK [CAPABILITY IMAP4rev1 STARTTLS] ready\r\n", "* OK [CAPABILITY IMAP4rev1] ready\r\n"},
+		{"* CAPABILITY IMAP4rev1 STARTTLSX\r\n", "* CAPABILITY IMAP4rev1 STARTTLSX\r\n"},
+		{"* OK STARTTLS\r\n", "* OK STARTTLS\r\n"},
+	} {
+		require.Equal(t, tc.want, string(removeCapability([]byte(tc.res), "STARTTLS")))
+	}
+}
+
+func TestLiteralSize(t *testing.T) {
+	require.Equal(t, 0, literalSize([]byte("a1 SELECT INBOX\r\n")))
+	require.Equal(t, 12, literalSize([]byte("a1 APPEND INBOX {12}\r\n")))
+	require.Equal(t, 12, literalSize([]byte("a1 APPEND INBOX {12+}\r\n")))
+	require.Equal(t, 0, literalSize([]byte("a1 SEARCH SUBJECT }\r\n")))
+	require.Equal(t, 0, literalSize([]byte("a1 SEARCH SUBJECT {x}\r\n")))
+	require.Equal(t, 0, literalSize([]byte("a1 SEARCH SUBJECT \"{5}\r\n")))
+	require.Equal(t, 0, literalSize([]byte("a1 SEARCH SUBJECT \"a\\\" {5}\r\n")))
+	require.Equal(t, 5, literalSize([]byte("a1 SEARCH SUBJECT \"{3}\" BODY {5}\r\n")))
+	require.Equal(t, 5, literalSize([]byte("a1 SEARCH SUBJECT \"a\\\\\" BODY {5}\r\n")))
+}
+
+// writeRecordingListener records the writes made on the connections it accepts.
+type writeRecordingListener struct {
+	net.Listener
+
+	writes [][]byte
+	lock   sync.Mutex
+}
+
+func (l *writeRecordingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	return &writeRecordingConn{Conn: conn, listener: l}, nil
+}
+
+func (l *writeRecordingListener) getWrites() [][]byte {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.writes
+}
+
+type writeRecordingConn struct {
+	net.Conn
+
+	listener *writeRecordingListener
+}
+
+func (c *writeRecordingConn) Write(p []byte) (int, error) {
+	c.listener.lock.Lock()
+	c.listener.writes = append(c.listener.writes, bytes.Clone(p))
+	c.listener.lock.Unlock()
+
+	return c.Conn.Write(p)
+}
+
+// TestGluon_WritesEachResponseAtOnce pins the behaviour imapProxyConn relies on: gluon writes each response, literals
+// included, in a single call.
+func TestGluon_WritesEachResponseAtOnce(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server, err := gluon.New(gluon.WithDataDir(t.TempDir()), gluon.WithDatabaseDir(t.TempDir()))
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, server.Close(context.Background())) }()
+
+	flags := imap.NewFlagSet(imap.FlagSeen, imap.FlagFlagged, imap.FlagDeleted)
+
+	dummy := connector.NewDummy([]string{"user"}, []byte("pass"), time.Millisecond, flags, flags, imap.NewFlagSet())
+
+	_, err = server.AddUser(ctx, dummy, bytes.Repeat([]byte("k"), 32))
+	require.NoError(t, err)
+
+	tcpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	listener := &writeRecordingListener{Listener: tcpListener}
+	require.NoError(t, server.Serve(ctx, listener))
+
+	conn, err := net.Dial("tcp", tcpListener.Addr().String())
+	require.NoError(t, err)
+
+	defer func() { _ = conn.Close() }()
+
+	client := bufio.NewReader(conn)
+
+	// wait reads the responses up to the tagged one.
+	wait := func(tag string) {
+		for {
+			line, err := client.ReadString('\n')
+			require.NoError(t, err)
+
+			if strings.HasPrefix(line, tag+" ") {
+				require.True(t, strings.HasPrefix(line, tag+" OK"), line)
+				return
+			}
+		}
+	}
+
+	run := func(tag, command string) {
+		_, err := conn.Write([]byte(tag + " " + command + "\r\n"))
+		require.NoError(t, err)
+
+		wait(tag)
+	}
+
+	_, err = client.ReadString('\n')
+	require.NoError(t, err)
+
+	const message = "Date: Wed, 01 Jan 2025 10:00:00 +0000\r\nSubject: Hello\r\nFrom: a@pm.me\r\nTo: b@pm.me\r\n\r\nHello {5}\r\nworld\r\n"
+
+	run("a1", "LOGIN user pass")
+	run("a2", "CREATE Folder")
+
+	_, err = conn.Write([]byte("a3 APPEND Folder {" + strconv.Itoa(len(message)) + "}\r\n"))
+	require.NoError(t, err)
+
+	line, err := client.ReadString('\n')
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(line, "+"), line)
+
+	_, err = conn.Write([]byte(message + "\r\n"))
+	require.NoError(t, err)
+
+	wait("a3")
+
+	run("a4", "SELECT Folder")
+	run("a5", "FETCH 1:* (FLAGS ENVELOPE BODY[] BODY[HEADER.FIELDS (SUBJECT)])")
+	run("a6", "CAPABILITY")
+
+	// Each write holds exactly one response, the literals it announces included.
+	writes := listener.getWrites()
+	require.NotEmpty(t, writes)
+
+	for _, write := range writes {
+		res := write
+
+		for {
+			line, rest, ok := bytes.Cut(res, []byte("\n"))
+			require.True(t, ok, "incomplete response %q", write)
+
+			literal := literalSize(line)
+			require.GreaterOrEqual(t, len(rest), literal, "incomplete literal in %q", write)
+
+			if res = rest[literal:]; literal == 0 {
+				break
+			}
+		}
+
+		require.Empty(t, res, "several responses in %q", write)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// quotaFunc returns the space used by the account of an IMAP session and its limit, in bytes, the latter being 0 if
+// unlimited.
+type quotaFunc func() (uint64, uint64)
+
+// quotaCapabilities are advertised next to the capabilities of gluon, see RFC 9208.
+const quotaCapabilities = "QUOTA QUOTA=RES-STORAGE"
+
+// quotaRoot is the name of the quota root holding all the mailboxes of an account.
+const quotaRoot = `""`
+
+// quotaHandler answers the QUOTA commands of the sessions bound to an account. The messages refused because the
+// account is full get the OVERQUOTA response code from the connector.
+type quotaHandler struct {
+	quota atomic.Pointer[quotaFunc]
+}
+
+// setQuota makes the handler answer the QUOTA commands with the space of the account of the session.
+func (h *quotaHandler) setQuota(quota quotaFunc) {
+	h.quota.Store(&quota)
+}
+
+// capabilities advertises QUOTA once the client authenticated, as the commands are only answered from then on.
+func (h *quotaHandler) capabilities(authenticated bool) string {
+	if !authenticated {
+		return ""
+	}
+
+	return quotaCapabilities
+}
+
+func (h *quotaHandler) handleCommand(conn *imapProxyConn, cmd *proxyCommand) (bool, error) {
+	quota := h.quota.Load()
+	if quota == nil || cmd.literal > 0 || !isQuotaCommand(cmd.name) {
+		return false, nil
+	}
+
+	return true, conn.reply(quotaResponse(cmd.tag, cmd.name, cmd.args, *quota))
+}
+
+func (h *quotaHandler) handleResponse(_ string, res []byte) []byte {
+	return res
+}
+
+// isQuotaCommand returns whether the command is one of the QUOTA commands, which gluon doesn't support.
+func isQuotaCommand(command string) bool {
+	switch command {
+	case "GETQUOTAROOT", "GETQUOTA", "SETQUOTA":
+		return true
+
+	default:
+		return false
+	}
+}
+
+// quotaResponse answers a QUOTA command with the STORAGE resource of the account, in units of 1024 octets. The quota
+// root of the account has no resources if its space is unlimited, and cannot be changed.
+func quotaResponse(tag, command, args string, quota quotaFunc) string {
+	var res strings.Builder
+
+	used, limit := quota()
+	args = strings.TrimSpace(args)
+
+	switch command {
+	case "GETQUOTAROOT":
+		if len(args) == 0 {
+			fmt.Fprintf(&res, "%s BAD missing mailbox name\r\n", tag)
+			break
+		}
+
+		if limit == 0 {
+			fmt.Fprintf(&res, "* QUOTAROOT %s\r\n", args)
+		} else {
+			fmt.Fprintf(&res, "* QUOTAROOT %s %s\r\n", args, quotaRoot)
+			fmt.Fprintf(&res, "* QUOTA %s (STORAGE %d %d)\r\n", quotaRoot, (used+1023)/1024, limit/1024)
+		}
+
+		fmt.Fprintf(&res, "%s OK GETQUOTAROOT completed\r\n", tag)
+
+	case "GETQUOTA":
+		if args != quotaRoot || limit == 0 {
+			fmt.Fprintf(&res, "%s NO no such quota root\r\n", tag)
+			break
+		}
+
+		fmt.Fprintf(&res, "* QUOTA %s (STORAGE %d %d)\r\n", quotaRoot, (used+1023)/1024, limit/1024)
+		fmt.Fprintf(&res, "%s OK GETQUOTA completed\r\n", tag)
+
+	default:
+		fmt.Fprintf(&res, "%s NO the quota cannot be changed\r\n", tag)
+	}
+
+	return res.String()
+}
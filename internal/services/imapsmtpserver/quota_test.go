@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+package imapsmtpserver
+
+import (
+	"bufio"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaHandler(t *testing.T) {
+	handler := new(quotaHandler)
+
+	_, gluon, clientConn := newTestProxyConn(t, handler)
+
+	client := bufio.NewReader(clientConn)
+
+	// The QUOTA commands are handed over to gluon until the session is bound to an account.
+	go func() { _, _ = clientConn.Write([]byte("a1 GETQUOTAROOT INBOX\r\n")) }()
+
+	line, err := gluon.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "a1 GETQUOTAROOT INBOX\r\n", line)
+
+	handler.setQuota(func() (uint64, uint64) { return 2049, 10240 })
+
+	// They are then answered by the handler, gluon only getting the next command.
+	forwarded := make(chan string)
+
+	go func() {
+		line, _ := gluon.ReadString('\n')
+		forwarded <- line
+	}()
+
+	go func() { _, _ = clientConn.Write([]byte("a2 getquotaroot INBOX\r\na3 NOOP\r\n")) }()
+
+	for _, want := range []string{
+		"* QUOTAROOT INBOX \"\"\r\n",
+		"* QUOTA \"\" (STORAGE 3 10)\r\n",
+		"a2 OK GETQUOTAROOT completed\r\n",
+	} {
+		line, err := client.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, want, line)
+	}
+
+	require.Equal(t, "a3 NOOP\r\n", <-forwarded)
+}
+
+func TestQuotaHandler_Capabilities(t *testing.T) {
+	conn, gluon, clientConn := newTestProxyConn(t, new(quotaHandler))
+
+	client := bufio.NewReader(clientConn)
+
+	// QUOTA is only advertised once the client authenticated.
+	go func() { _, _ = conn.Write([]byte("* CAPABILITY IMAP4rev1 IDLE\r\n")) }()
+
+	line, err := client.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "* CAPABILITY IMAP4rev1 IDLE\r\n", line)
+
+	go func() { _, _ = clientConn.Write([]byte("a1 LOGIN user pass\r\n")) }()
+
+	_, err = gluon.ReadString('\n')
+	require.NoError(t, err)
+
+	go func() { _, _ = conn.Write([]byte("a1 OK [CAPABILITY IMAP4rev1 IDLE] Logged in\r\n")) }()
+
+	line, err = client.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "a1 OK [CAPABILITY IMAP4rev1 "+quotaCapabilities+" IDLE] Logged in\r\n", line)
+}
+
+func TestQuotaResponse(t *testing.T) {
+	limited := func() (uint64, uint64) { return 0, 1024 * 1024 }
+	unlimited := func() (uint64, uint64) { return 1024, 0 }
+
+	for _, tc := range []struct {
+		command, args string
+		quota         quotaFunc
+		want          string
+	}{
+		{"GETQUOTAROOT", "INBOX", unlimited, "* QUOTAROOT INBOX\r\na OK GETQUOTAROOT completed\r\n"},
+		{"GETQUOTAROOT", "", limited, "a BAD missing mailbox name\r\n"},
+		{"GETQUOTA", "\"\"", limited, "* QUOTA \"\" (STORAGE 0 1024)\r\na OK GETQUOTA completed\r\n"},
+		{"GETQUOTA", "\"\"", unlimited, "a NO no such quota root\r\n"},
+		{"GETQUOTA", "INBOX", limited, "a NO no such quota root\r\n"},
+		{"SETQUOTA", "\"\" (STORAGE 1)", limited, "a NO the quota cannot be changed\r\n"},
+	} {
+		require.Equal(t, tc.want, quotaResponse("a", tc.command, tc.args, tc.quota))
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"strings"
+	"sync/atomic"
+)
+
+// responseCodeNoPerm is the response code of the changes refused to read-only sessions, see RFC 5530.
+const responseCodeNoPerm = "NOPERM"
+
+// readOnlyHandler makes gluon open the mailboxes of read-only IMAP sessions with EXAMINE instead of SELECT, so that
+// they are reported as read-only and fetching a message doesn't mark it as seen. Gluon then refuses the changes of
+// the opened mailbox, and its refusals get the NOPERM response code; the other changes are refused by the connector,
+// which reports the code itself.
+type readOnlyHandler struct {
+	readOnly atomic.Bool
+}
+
+// setReadOnly makes the commands read from now on read-only.
+func (h *readOnlyHandler) setReadOnly() {
+	h.readOnly.Store(true)
+}
+
+func (h *readOnlyHandler) capabilities(bool) string {
+	return ""
+}
+
+func (h *readOnlyHandler) handleCommand(_ *imapProxyConn, cmd *proxyCommand) (bool, error) {
+	if cmd.name == "SELECT" && h.readOnly.Load() {
+		cmd.rewrite("EXAMINE", cmd.args)
+	}
+
+	return false, nil
+}
+
+func (h *readOnlyHandler) handleResponse(command string, res []byte) []byte {
+	if !h.readOnly.Load() || !isChangeCommand(command) {
+		return res
+	}
+
+	return addResponseCode(res, responseCodeNoPerm)
+}
+
+// isChangeCommand returns whether the command changes the mailboxes or their messages.
+func isChangeCommand(command string) bool {
+	switch strings.TrimPrefix(command, "UID ") {
+	case "APPEND", "COPY", "CREATE", "DELETE", "EXPUNGE", "MOVE", "RENAME", "STORE":
+		return true
+
+	default:
+		return false
+	}
+}
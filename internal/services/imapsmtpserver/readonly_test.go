@@ -0,0 +1,117 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"bufio"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOnlyHandler(t *testing.T) {
+	handler := new(readOnlyHandler)
+
+	conn, gluon, clientConn := newTestProxyConn(t, handler)
+
+	send := func(data string) {
+		go func() { _, _ = clientConn.Write([]byte(data)) }()
+	}
+
+	expect := func(data string) {
+		buf := make([]byte, len(data))
+
+		_, err := io.ReadFull(gluon, buf)
+		require.NoError(t, err)
+		require.Equal(t, data, string(buf))
+	}
+
+	// The commands are left alone until the session is read-only.
+	send("a1 SELECT INBOX\r\n")
+	expect("a1 SELECT INBOX\r\n")
+
+	handler.setReadOnly()
+
+	send("a2 select INBOX\r\n")
+	expect("a2 EXAMINE INBOX\r\n")
+
+	// Literals are not inspected.
+	send("a3 APPEND INBOX {17}\r\na4 SELECT INBOX\r\n\r\n")
+	expect("a3 APPEND INBOX {17}\r\na4 SELECT INBOX\r\n\r\n")
+
+	send("a5 SELECT {5+}\r\nINBOX\r\n")
+	expect("a5 EXAMINE {5+}\r\nINBOX\r\n")
+
+	send("a6 STORE 1 +FLAGS (\\Seen)\r\na7 UID MOVE 1 Trash\r\na8 COPY 1 Missing\r\na9 FETCH 1 FLAGS\r\n")
+	expect("a6 STORE 1 +FLAGS (\\Seen)\r\na7 UID MOVE 1 Trash\r\na8 COPY 1 Missing\r\na9 FETCH 1 FLAGS\r\n")
+
+	// The refusals of the changes get the NOPERM response code.
+	client := bufio.NewReader(clientConn)
+
+	for _, tc := range []struct{ res, want string }{
+		{"a6 NO the mailbox is read-only\r\n", "a6 NO [NOPERM] the mailbox is read-only\r\n"},
+		{"a7 NO the mailbox is read-only\r\n", "a7 NO [NOPERM] the mailbox is read-only\r\n"},
+		{"a8 NO [TRYCREATE] no such mailbox\r\n", "a8 NO [TRYCREATE] no such mailbox\r\n"},
+		{"a9 NO no such message\r\n", "a9 NO no such message\r\n"},
+		{"* 1 FETCH (FLAGS (\\Seen))\r\n", "* 1 FETCH (FLAGS (\\Seen))\r\n"},
+	} {
+		go func() { _, _ = conn.Write([]byte(tc.res)) }()
+
+		line, err := client.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, tc.want, line)
+	}
+}
+
+func TestReadOnlyHandler_PipelinedLogin(t *testing.T) {
+	handler := new(readOnlyHandler)
+
+	conn, gluon, clientConn := newTestProxyConn(t, handler)
+
+	client := bufio.NewReader(clientConn)
+
+	respond := func(res string) {
+		go func() { _, _ = conn.Write([]byte(res)) }()
+
+		line, err := client.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, res, line)
+	}
+
+	expectForwarded := func(data string) {
+		line, err := gluon.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, data, line)
+	}
+
+	// The login is sent with a literal, whose continuation request is not taken for one of the authentication.
+	go func() { _, _ = clientConn.Write([]byte("a1 LOGIN {4}\r\n")) }()
+	expectForwarded("a1 LOGIN {4}\r\n")
+
+	respond("+ Ready\r\n")
+
+	go func() { _, _ = clientConn.Write([]byte("user pass\r\na2 SELECT INBOX\r\n")) }()
+	expectForwarded("user pass\r\n")
+
+	// The commands sent along with the login are handled once the session it opened is known to be read-only.
+	handler.setReadOnly()
+
+	respond("a1 OK Logged in\r\n")
+	expectForwarded("a2 EXAMINE INBOX\r\n")
+}
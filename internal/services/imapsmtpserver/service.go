@@ -51,11 +51,15 @@ type Service struct {
 	smtpListener net.Listener
 	smtpAccounts *bridgesmtp.Accounts
 
-	smtpSettings   SMTPSettingsProvider
-	imapSettings   IMAPSettingsProvider
-	eventPublisher events.EventPublisher
-	panicHandler   async.PanicHandler
-	reporter       reporter.Reporter
+	smtpSettings    SMTPSettingsProvider
+	imapSettings    IMAPSettingsProvider
+	networkSettings NetworkSettingsProvider
+	oauthVerifier   OAuthVerifier
+	guard           *accessGuard
+	sessions        *sessionTracker
+	eventPublisher  events.EventPublisher
+	panicHandler    async.PanicHandler
+	reporter        reporter.Reporter
 
 	log   *logrus.Entry
 	tasks *async.Group
@@ -71,6 +75,8 @@ func NewService(
 	ctx context.Context,
 	smtpSettings SMTPSettingsProvider,
 	imapSettings IMAPSettingsProvider,
+	networkSettings NetworkSettingsProvider,
+	oauthVerifier OAuthVerifier,
 	eventPublisher events.EventPublisher,
 	panicHandler async.PanicHandler,
 	reporter reporter.Reporter,
@@ -87,6 +93,10 @@ func NewService(
 		reporter:             reporter,
 		smtpSettings:         smtpSettings,
 		imapSettings:         imapSettings,
+		networkSettings:      networkSettings,
+		oauthVerifier:        oauthVerifier,
+		guard:                newAccessGuard(networkSettings, eventPublisher),
+		sessions:             newSessionTracker(),
 		eventPublisher:       eventPublisher,
 		log:                  logrus.WithField("service", "server-manager"),
 		tasks:                async.NewGroup(ctx, panicHandler),
@@ -216,6 +226,49 @@ func (sm *Service) GetOpenIMAPSessionCount() int {
 	return sm.imapServer.GetOpenSessionCount()
 }
 
+// BindIMAPSession records that the IMAP session authenticated with the given app password, if any,
+// and whether it can only read the mailbox.
+func (sm *Service) BindIMAPSession(sessionID int, appPasswordID string, readOnly bool) {
+	sm.sessions.bindIMAPSession(sessionID, appPasswordID, readOnly)
+}
+
+// IsIMAPSessionReadOnly returns whether the IMAP session can only read the mailbox.
+func (sm *Service) IsIMAPSessionReadOnly(sessionID int) bool {
+	return sm.sessions.isIMAPSessionReadOnly(sessionID)
+}
+
+// IsIMAPSessionLockedOut returns whether the host of the IMAP session is locked out after too many failed
+// authentications.
+func (sm *Service) IsIMAPSessionLockedOut(sessionID int) bool {
+	return sm.guard.isSessionLockedOut(sessionID)
+}
+
+// BindIMAPSessionQuota records how to get the space used by the account of the IMAP session and its limit,
+// to answer its QUOTA commands.
+func (sm *Service) BindIMAPSessionQuota(sessionID int, quota func() (uint64, uint64)) {
+	sm.sessions.bindIMAPSessionQuota(sessionID, quota)
+}
+
+// BindIMAPSessionMetadata records how to read and write the entries of the mailboxes of the IMAP session,
+// to answer its METADATA commands.
+func (sm *Service) BindIMAPSessionMetadata(sessionID int, metadata imapservice.MailboxMetadata) {
+	sm.sessions.bindIMAPSessionMetadata(sessionID, metadata)
+}
+
+// SetIMAPSessionResponseCode makes the IMAP server add the response code to the refusal of the command the IMAP
+// session is running.
+func (sm *Service) SetIMAPSessionResponseCode(sessionID int, code imapservice.ResponseCode) {
+	sm.sessions.setIMAPSessionResponseCode(sessionID, string(code))
+}
+
+// CloseAppPasswordSessions closes the IMAP and SMTP sessions authenticated with the given app password, or with the
+// tokens of the given OAuth authorization.
+func (sm *Service) CloseAppPasswordSessions(appPasswordID string) {
+	if count := sm.sessions.closeSessions(appPasswordID); count > 0 {
+		sm.log.WithField("count", count).Info("Closed sessions of revoked app password")
+	}
+}
+
 func (sm *Service) GetRollingIMAPConnectionCount() int {
 	return sm.imapServer.GetRollingIMAPConnectionCount()
 }
@@ -514,6 +567,8 @@ func (sm *Service) createIMAPServer(ctx context.Context) (*gluon.Server, error)
 		sm.imapSettings.LogServer(),
 		sm.imapSettings.DisableIMAPAuthenticate(),
 		sm.imapSettings.EventPublisher(),
+		sm.guard,
+		sm.sessions,
 		sm.tasks,
 		sm.uidValidityGenerator,
 		sm.panicHandler,
@@ -528,7 +583,7 @@ func (sm *Service) createIMAPServer(ctx context.Context) (*gluon.Server, error)
 }
 
 func (sm *Service) createSMTPServer() *smtp.Server {
-	return newSMTPServer(sm.smtpAccounts, sm.smtpSettings)
+	return newSMTPServer(sm.smtpAccounts, sm.smtpSettings, sm.oauthVerifier, sm.guard, sm.sessions)
 }
 
 func (sm *Service) closeSMTPServer(ctx context.Context) error {
@@ -612,7 +667,7 @@ func (sm *Service) restartSMTP(ctx context.Context) error {
 
 	sm.eventPublisher.PublishEvent(ctx, events.SMTPServerStopped{})
 
-	sm.smtpServer = newSMTPServer(sm.smtpAccounts, sm.smtpSettings)
+	sm.smtpServer = sm.createSMTPServer()
 
 	return sm.serveSMTP(ctx)
 }
@@ -620,15 +675,28 @@ func (sm *Service) restartSMTP(ctx context.Context) error {
 func (sm *Service) serveSMTP(ctx context.Context) error {
 	port, err := func() (int, error) {
 		sm.log.WithFields(logrus.Fields{
+			"host": sm.networkSettings.BindAddress(),
 			"port": sm.smtpSettings.Port(),
 			"ssl":  sm.smtpSettings.UseSSL(),
 		}).Info("Starting SMTP server")
 
-		smtpListener, err := newListener(sm.smtpSettings.Port(), sm.smtpSettings.UseSSL(), sm.smtpSettings.TLSConfig())
+		smtpListener, err := newListener(
+			sm.networkSettings.BindAddress(),
+			sm.smtpSettings.Port(),
+			sm.smtpSettings.UseSSL(),
+			sm.smtpSettings.TLSConfig(),
+			sm.guard,
+			"SMTP",
+		)
 		if err != nil {
 			return 0, fmt.Errorf("failed to create SMTP listener: %w", err)
 		}
 
+		smtpListener, err = sm.withSocket(smtpListener, SMTPSocketName)
+		if err != nil {
+			return 0, err
+		}
+
 		sm.smtpListener = smtpListener
 
 		sm.tasks.Once(func(context.Context) {
@@ -659,23 +727,69 @@ func (sm *Service) serveSMTP(ctx context.Context) error {
 	return nil
 }
 
+// withSocket makes the listener also accept the connections of the Unix socket with the given name, if enabled.
+func (sm *Service) withSocket(listener net.Listener, name string) (net.Listener, error) {
+	dir, err := sm.networkSettings.SocketDirectory()
+	if err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("failed to get socket directory: %w", err)
+	}
+
+	if dir == "" {
+		return listener, nil
+	}
+
+	socketListener, err := newSocketListener(filepath.Join(dir, name))
+	if err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("failed to create socket listener: %w", err)
+	}
+
+	sm.log.WithField("path", socketListener.Addr()).Info("Listening on Unix socket")
+
+	return newMultiListener(listener, socketListener), nil
+}
+
 func (sm *Service) serveIMAP(ctx context.Context) error {
 	port, err := func() (int, error) {
 		if sm.imapServer == nil {
 			return 0, fmt.Errorf("no IMAP server instance running")
 		}
 
+		host := sm.networkSettings.BindAddress()
+
+		// IMAP clients can log in before STARTTLS, so implicit TLS is mandatory when other hosts can connect.
+		useSSL := sm.imapSettings.UseSSL() || !isLoopback(host)
+
 		sm.log.WithFields(logrus.Fields{
+			"host": host,
 			"port": sm.imapSettings.Port(),
-			"ssl":  sm.imapSettings.UseSSL(),
+			"ssl":  useSSL,
 		}).Info("Starting IMAP server")
 
-		imapListener, err := newListener(sm.imapSettings.Port(), sm.imapSettings.UseSSL(), sm.imapSettings.TLSConfig())
+		imapListener, err := newListener(host, sm.imapSettings.Port(), useSSL, sm.imapSettings.TLSConfig(), sm.guard, "IMAP")
 		if err != nil {
 			return 0, fmt.Errorf("failed to create IMAP listener: %w", err)
 		}
 
-		sm.imapListener = imapListener
+		imapListener, err = sm.withSocket(imapListener, IMAPSocketName)
+		if err != nil {
+			return 0, err
+		}
+
+		// STARTTLS is handled in front of gluon so that the commands of the clients can be inspected.
+		startTLSConfig := sm.imapSettings.TLSConfig()
+		if useSSL {
+			startTLSConfig = nil
+		}
+
+		handlers := []proxyHandler{threadHandler{}}
+
+		if sm.oauthVerifier.OAuthEnabled() {
+			handlers = append(handlers, &oauthHandler{verifier: sm.oauthVerifier, guard: sm.guard})
+		}
+
+		sm.imapListener = sm.sessions.trackListener(imapListener, startTLSConfig, sm.panicHandler, handlers...)
 
 		if err := sm.imapServer.Serve(ctx, sm.imapListener); err != nil {
 			return 0, fmt.Errorf("failed to serve IMAP: %w", err)
@@ -729,7 +843,7 @@ func (sm *Service) handleSetGluonDir(ctx context.Context, newGluonDir string) er
 		return fmt.Errorf("failed to close IMAP: %w", err)
 	}
 
-	if err := moveGluonCacheDir(sm.imapSettings, currentGluonDir, newGluonDir); err != nil {
+	if err := MoveGluonCacheDir(currentGluonDir, newGluonDir, sm.imapSettings.SetCacheDirectory); err != nil {
 		sm.log.WithError(err).Error("failed to move GluonCacheDir")
 
 		if err := sm.imapSettings.SetCacheDirectory(currentGluonDir); err != nil {
@@ -0,0 +1,280 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/ProtonMail/gluon/async"
+	imapEvents "github.com/ProtonMail/gluon/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/imapservice"
+)
+
+// sessionTracker records which app password the IMAP and SMTP sessions authenticated with,
+// so that the sessions of an app password can be closed when it is revoked, which IMAP sessions are read-only
+// and the quota and mailbox entries of the accounts of the IMAP sessions.
+type sessionTracker struct {
+	// conns holds the open IMAP connections by remote address; gluon reports new sessions by remote address only.
+	conns map[net.Addr]*trackedConn
+
+	imapConns        map[int]*trackedConn
+	imapAppPasswords map[int]string
+	imapReadOnly     map[int]struct{}
+	imapQuotas       map[int]quotaFunc
+	imapMetadata     map[int]imapservice.MailboxMetadata
+	smtpAppPasswords map[io.Closer]string
+
+	lock sync.Mutex
+}
+
+func newSessionTracker() *sessionTracker {
+	return &sessionTracker{
+		conns:            make(map[net.Addr]*trackedConn),
+		imapConns:        make(map[int]*trackedConn),
+		imapAppPasswords: make(map[int]string),
+		imapReadOnly:     make(map[int]struct{}),
+		imapQuotas:       make(map[int]quotaFunc),
+		imapMetadata:     make(map[int]imapservice.MailboxMetadata),
+		smtpAppPasswords: make(map[io.Closer]string),
+	}
+}
+
+// trackListener returns a listener recording the connections it accepts until they are closed.
+// The connections handle STARTTLS themselves if given a TLS config, and the given handlers next to those of the
+// sessions, see imapProxyConn.
+func (tracker *sessionTracker) trackListener(
+	l net.Listener,
+	tlsConfig *tls.Config,
+	panicHandler async.PanicHandler,
+	handlers ...proxyHandler,
+) net.Listener {
+	return &trackedListener{Listener: l, tracker: tracker, tlsConfig: tlsConfig, panicHandler: panicHandler, handlers: handlers}
+}
+
+// handleIMAPEvent associates the IMAP sessions with their connection.
+func (tracker *sessionTracker) handleIMAPEvent(event imapEvents.Event) {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	switch event := event.(type) {
+	case imapEvents.SessionAdded:
+		if conn, ok := tracker.conns[event.RemoteAddr]; ok {
+			tracker.imapConns[event.SessionID] = conn
+
+			// The session may have been authenticated before the event was handled.
+			if _, ok := tracker.imapReadOnly[event.SessionID]; ok {
+				conn.readOnly.setReadOnly()
+			}
+
+			if quota, ok := tracker.imapQuotas[event.SessionID]; ok {
+				conn.quota.setQuota(quota)
+			}
+
+			if metadata, ok := tracker.imapMetadata[event.SessionID]; ok {
+				conn.metadata.setMetadata(metadata)
+			}
+		}
+
+	case imapEvents.SessionRemoved:
+		delete(tracker.imapConns, event.SessionID)
+		delete(tracker.imapAppPasswords, event.SessionID)
+		delete(tracker.imapReadOnly, event.SessionID)
+		delete(tracker.imapQuotas, event.SessionID)
+		delete(tracker.imapMetadata, event.SessionID)
+	}
+}
+
+func (tracker *sessionTracker) bindIMAPSession(sessionID int, appPasswordID string, readOnly bool) {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	if appPasswordID != "" {
+		tracker.imapAppPasswords[sessionID] = appPasswordID
+	}
+
+	if readOnly {
+		tracker.imapReadOnly[sessionID] = struct{}{}
+
+		if conn, ok := tracker.imapConns[sessionID]; ok {
+			conn.readOnly.setReadOnly()
+		}
+	}
+}
+
+func (tracker *sessionTracker) bindIMAPSessionQuota(sessionID int, quota quotaFunc) {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	tracker.imapQuotas[sessionID] = quota
+
+	if conn, ok := tracker.imapConns[sessionID]; ok {
+		conn.quota.setQuota(quota)
+	}
+}
+
+func (tracker *sessionTracker) bindIMAPSessionMetadata(sessionID int, metadata imapservice.MailboxMetadata) {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	tracker.imapMetadata[sessionID] = metadata
+
+	if conn, ok := tracker.imapConns[sessionID]; ok {
+		conn.metadata.setMetadata(metadata)
+	}
+}
+
+// setIMAPSessionResponseCode makes the connection of the IMAP session add the response code to the refusal of the
+// command being run.
+func (tracker *sessionTracker) setIMAPSessionResponseCode(sessionID int, code string) {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	if conn, ok := tracker.imapConns[sessionID]; ok {
+		conn.setResponseCode(code)
+	}
+}
+
+func (tracker *sessionTracker) isIMAPSessionReadOnly(sessionID int) bool {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	_, ok := tracker.imapReadOnly[sessionID]
+
+	return ok
+}
+
+func (tracker *sessionTracker) bindSMTPSession(conn io.Closer, appPasswordID string) {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	tracker.smtpAppPasswords[conn] = appPasswordID
+}
+
+func (tracker *sessionTracker) unbindSMTPSession(conn io.Closer) {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	delete(tracker.smtpAppPasswords, conn)
+}
+
+// closeSessions closes the IMAP and SMTP sessions authenticated with the given app password.
+// It returns the number of closed sessions.
+func (tracker *sessionTracker) closeSessions(appPasswordID string) int {
+	tracker.lock.Lock()
+
+	var closers []io.Closer
+
+	for sessionID, id := range tracker.imapAppPasswords {
+		if id != appPasswordID {
+			continue
+		}
+
+		if conn, ok := tracker.imapConns[sessionID]; ok {
+			closers = append(closers, conn)
+		}
+	}
+
+	for conn, id := range tracker.smtpAppPasswords {
+		if id == appPasswordID {
+			closers = append(closers, conn)
+		}
+	}
+
+	tracker.lock.Unlock()
+
+	// The connections are closed without the lock as closing them reports back to the tracker.
+	for _, closer := range closers {
+		_ = closer.Close()
+	}
+
+	return len(closers)
+}
+
+func (tracker *sessionTracker) addConn(conn *trackedConn) {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	tracker.conns[conn.RemoteAddr()] = conn
+}
+
+func (tracker *sessionTracker) removeConn(conn *trackedConn) {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	if tracker.conns[conn.RemoteAddr()] == conn {
+		delete(tracker.conns, conn.RemoteAddr())
+	}
+}
+
+type trackedListener struct {
+	net.Listener
+
+	tracker      *sessionTracker
+	tlsConfig    *tls.Config
+	panicHandler async.PanicHandler
+
+	// handlers are shared by all the connections.
+	handlers []proxyHandler
+}
+
+func (l *trackedListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	if conn.RemoteAddr() == nil {
+		return conn, nil
+	}
+
+	tracked := &trackedConn{
+		readOnly: new(readOnlyHandler),
+		quota:    new(quotaHandler),
+		metadata: new(metadataHandler),
+		tracker:  l.tracker,
+	}
+
+	handlers := append([]proxyHandler{tracked.readOnly, tracked.quota, tracked.metadata}, l.handlers...)
+
+	tracked.imapProxyConn = newIMAPProxyConn(conn, l.tlsConfig, l.panicHandler, handlers...)
+
+	l.tracker.addConn(tracked)
+
+	return tracked, nil
+}
+
+// trackedConn is an IMAP connection with the handlers of the extensions bound to its session.
+type trackedConn struct {
+	*imapProxyConn
+
+	readOnly *readOnlyHandler
+	quota    *quotaHandler
+	metadata *metadataHandler
+
+	tracker   *sessionTracker
+	closeOnce sync.Once
+}
+
+func (c *trackedConn) Close() error {
+	c.closeOnce.Do(func() { c.tracker.removeConn(c) })
+
+	return c.imapProxyConn.Close()
+}
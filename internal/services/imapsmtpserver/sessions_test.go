@@ -0,0 +1,88 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"net"
+	"testing"
+
+	"github.com/ProtonMail/gluon/async"
+	imapEvents "github.com/ProtonMail/gluon/events"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCloser struct {
+	closed bool
+}
+
+func (c *fakeCloser) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestSessionTracker_CloseSessions(t *testing.T) {
+	tracker := newSessionTracker()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	l = tracker.trackListener(l, nil, async.NoopPanicHandler{})
+	defer func() { _ = l.Close() }()
+
+	accept := func(sessionID int) (net.Conn, net.Conn) {
+		client, err := net.Dial("tcp", l.Addr().String())
+		require.NoError(t, err)
+
+		conn, err := l.Accept()
+		require.NoError(t, err)
+
+		tracker.handleIMAPEvent(imapEvents.SessionAdded{SessionID: sessionID, RemoteAddr: conn.RemoteAddr()})
+
+		return client, conn
+	}
+
+	revokedClient, _ := accept(1)
+	otherClient, _ := accept(2)
+	mainClient, _ := accept(3)
+
+	tracker.bindIMAPSession(1, "revoked", false)
+	tracker.bindIMAPSession(2, "other", true)
+
+	revokedSMTP, otherSMTP := &fakeCloser{}, &fakeCloser{}
+	tracker.bindSMTPSession(revokedSMTP, "revoked")
+	tracker.bindSMTPSession(otherSMTP, "other")
+
+	require.Equal(t, 2, tracker.closeSessions("revoked"))
+
+	// Only the sessions of the revoked app password are closed.
+	_, err = revokedClient.Read(make([]byte, 1))
+	require.Error(t, err)
+
+	require.True(t, revokedSMTP.closed)
+	require.False(t, otherSMTP.closed)
+
+	for _, client := range []net.Conn{otherClient, mainClient} {
+		require.NoError(t, client.Close())
+	}
+
+	// Closed sessions are forgotten.
+	tracker.handleIMAPEvent(imapEvents.SessionRemoved{SessionID: 1})
+	tracker.unbindSMTPSession(revokedSMTP)
+
+	require.Zero(t, tracker.closeSessions("revoked"))
+}
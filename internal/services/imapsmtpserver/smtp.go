@@ -40,13 +40,24 @@ type SMTPSettingsProvider interface {
 	Identifier() identifier.UserAgentUpdater
 }
 
-func newSMTPServer(accounts *smtpservice.Accounts, settings SMTPSettingsProvider) *smtp.Server {
+func newSMTPServer(
+	accounts *smtpservice.Accounts,
+	settings SMTPSettingsProvider,
+	oauthVerifier OAuthVerifier,
+	guard *accessGuard,
+	sessions *sessionTracker,
+) *smtp.Server {
 	logSMTP.WithField("logSMTP", settings.Log()).Info("Creating SMTP server")
 
-	smtpServer := smtp.NewServer(smtpservice.NewBackend(accounts, settings.Identifier()))
+	smtpServer := smtp.NewServer(&guardedBackend{
+		Backend:  smtpservice.NewBackend(accounts, settings.Identifier()),
+		guard:    guard,
+		sessions: sessions,
+	})
 
 	smtpServer.TLSConfig = settings.TLSConfig()
 	smtpServer.Domain = constants.Host
+	// Other hosts are required to use TLS by the guard.
 	smtpServer.AllowInsecureAuth = true
 	smtpServer.MaxLineLength = 1 << 16
 	smtpServer.ErrorLog = logging.NewSMTPLogger()
@@ -58,6 +69,10 @@ func newSMTPServer(accounts *smtpservice.Accounts, settings SMTPSettingsProvider
 		})
 	})
 
+	if oauthVerifier.OAuthEnabled() {
+		enableSMTPOAuth(smtpServer, oauthVerifier, guard)
+	}
+
 	if settings.Log() {
 		logSMTP.Warning("================================================")
 		logSMTP.Warning("THIS LOG WILL CONTAIN **DECRYPTED** MESSAGE DATA")
@@ -0,0 +1,581 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"bytes"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// threadCapabilities are advertised next to the capabilities of gluon: THREAD=REFERENCES (RFC 5256) and the FETCH item
+// returning the conversation of the messages.
+const threadCapabilities = "THREAD=REFERENCES X-PM-CONVERSATION"
+
+// conversationFetchItem is the FETCH item returning the Proton conversation ID of the messages, which bridge adds to
+// their literal as the X-Pm-Conversation-Id header.
+const conversationFetchItem = "X-PM-CONVERSATION-ID"
+
+// conversationSection is the header section fetched through gluon in place of conversationFetchItem. The field is
+// listed twice, as gluon echoes it in its responses, so that they are told from those of clients fetching the header.
+const conversationSection = "HEADER.FIELDS (X-PM-CONVERSATION-ID X-PM-CONVERSATION-ID)"
+
+// threadSection is the header section fetched to thread the messages.
+const threadSection = "HEADER.FIELDS (MESSAGE-ID IN-REPLY-TO REFERENCES DATE X-PM-CONVERSATION-ID)"
+
+// threadFetchItems are the items fetched to thread the messages.
+const threadFetchItems = "(UID INTERNALDATE BODY.PEEK[" + threadSection + "])"
+
+// internalDateFormat is the format of the INTERNALDATE items of gluon.
+const internalDateFormat = "2-Jan-2006 15:04:05 -0700"
+
+var (
+	threadUIDRegexp          = regexp.MustCompile(`\bUID (\d+)`)                                //nolint:gochecknoglobals
+	threadInternalDateRegexp = regexp.MustCompile(`\bINTERNALDATE "([^"]*)"`)                   //nolint:gochecknoglobals
+	threadMessageIDRegexp    = regexp.MustCompile(`<[^<>]+>`)                                   //nolint:gochecknoglobals
+	conversationItemRegexp   = regexp.MustCompile(`^(?i)` + conversationFetchItem + `([ )]|$)`) //nolint:gochecknoglobals
+)
+
+// threadHandler exposes the Proton conversations of the messages, which gluon doesn't know of. The THREAD command
+// with the REFERENCES algorithm of RFC 5256 makes a thread of each conversation, whose messages are searched and
+// their headers fetched through gluon, and the X-PM-CONVERSATION-ID FETCH item returns the conversation ID of the
+// messages. Both read the X-Pm-Conversation-Id header of the messages, which those built before bridge added it lack.
+type threadHandler struct{}
+
+func (threadHandler) capabilities(bool) string {
+	return threadCapabilities
+}
+
+func (threadHandler) handleCommand(conn *imapProxyConn, cmd *proxyCommand) (bool, error) {
+	if (cmd.name == "FETCH" || cmd.name == "UID FETCH") && cmd.literal == 0 {
+		if args, ok := replaceConversationItem(cmd.args); ok {
+			cmd.rewrite(cmd.name, args)
+		}
+
+		return false, nil
+	}
+
+	if (cmd.name != "THREAD" && cmd.name != "UID THREAD") || cmd.literal > 0 {
+		return false, nil
+	}
+
+	args := strings.SplitN(cmd.args, " ", 3)
+	if len(args) < 3 {
+		return true, conn.reply(cmd.tag + " BAD " + cmd.name + " needs an algorithm, a charset and search criteria\r\n")
+	}
+
+	if !strings.EqualFold(args[0], "REFERENCES") {
+		return true, conn.reply(cmd.tag + " BAD Unsupported threading algorithm\r\n")
+	}
+
+	// The commands sent before are completed first so that their responses aren't mistaken for those of the search.
+	if _, err := conn.exec("NOOP", nil); err != nil {
+		return false, err
+	}
+
+	var uids []string
+
+	status, err := conn.exec("UID SEARCH CHARSET "+args[1]+" "+args[2], func(res []byte) bool {
+		fields, ok := bytes.CutPrefix(bytes.TrimRight(res, "\r\n"), []byte("* SEARCH"))
+		if ok {
+			uids = append(uids, strings.Fields(string(fields))...)
+		}
+
+		return ok
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if !strings.HasPrefix(status, "OK") {
+		return true, conn.reply(cmd.tag + " " + status + "\r\n")
+	}
+
+	var messages []*threadMessage
+
+	if len(uids) > 0 {
+		status, err := conn.exec("UID FETCH "+strings.Join(uids, ",")+" "+threadFetchItems, func(res []byte) bool {
+			message, ok := parseThreadMessage(res, cmd.name == "UID THREAD")
+			if ok {
+				messages = append(messages, message)
+			}
+
+			return ok
+		})
+		if err != nil {
+			return false, err
+		}
+
+		if !strings.HasPrefix(status, "OK") {
+			return true, conn.reply(cmd.tag + " " + status + "\r\n")
+		}
+	}
+
+	threads := threadConversations(messages)
+
+	return true, conn.reply("* THREAD" + formatThreads(threads) + "\r\n" + cmd.tag + " OK " + cmd.name + " completed\r\n")
+}
+
+// handleResponse replaces the header section fetched in place of the conversation item by the item.
+func (threadHandler) handleResponse(command string, res []byte) []byte {
+	if command != "" || !bytes.Contains(res, []byte("BODY["+conversationSection+"] ")) {
+		return res
+	}
+
+	before, header, after, ok := cutBodySection(res, conversationSection)
+	if !ok {
+		return res
+	}
+
+	value := "NIL"
+
+	if conversationID := readHeader(header).Get("X-Pm-Conversation-Id"); conversationID != "" {
+		value = strconv.Quote(conversationID)
+	}
+
+	return append(append(bytes.Clone(before), conversationFetchItem+" "+value...), after...)
+}
+
+// replaceConversationItem replaces the conversation item in the arguments of a FETCH command by the header section
+// fetched in its place, and returns whether it did.
+func replaceConversationItem(args string) (string, bool) {
+	var (
+		res      strings.Builder
+		depth    int
+		replaced bool
+	)
+
+	for idx := 0; idx < len(args); idx++ {
+		switch args[idx] {
+		case '[':
+			depth++
+
+		case ']':
+			depth--
+		}
+
+		// The item is matched outside of the body sections, e.g. not as a header field.
+		if depth == 0 && (idx == 0 || args[idx-1] == ' ' || args[idx-1] == '(') && conversationItemRegexp.MatchString(args[idx:]) {
+			res.WriteString("BODY.PEEK[" + conversationSection + "]")
+			idx += len(conversationFetchItem) - 1
+			replaced = true
+
+			continue
+		}
+
+		res.WriteByte(args[idx])
+	}
+
+	return res.String(), replaced
+}
+
+// cutBodySection cuts a FETCH response of gluon around the body section given as a literal, returning what is before
+// the section, its literal and what is after it.
+func cutBodySection(res []byte, section string) ([]byte, []byte, []byte, bool) {
+	prefix := []byte("BODY[" + section + "] {")
+
+	start := bytes.Index(res, prefix)
+	if start < 0 {
+		return nil, nil, nil, false
+	}
+
+	size, rest, ok := bytes.Cut(res[start+len(prefix):], []byte("}\r\n"))
+	if !ok {
+		return nil, nil, nil, false
+	}
+
+	literal, err := strconv.Atoi(string(size))
+	if err != nil || literal > len(rest) {
+		return nil, nil, nil, false
+	}
+
+	return res[:start], rest[:literal], rest[literal:], true
+}
+
+// readHeader returns the fields of a header section, none if it can't be read.
+func readHeader(header []byte) mail.Header {
+	msg, err := mail.ReadMessage(bytes.NewReader(append(bytes.Clone(header), "\r\n"...)))
+	if err != nil {
+		return mail.Header{}
+	}
+
+	return msg.Header
+}
+
+// threadMessage is what the threading algorithm needs to know about a message.
+type threadMessage struct {
+	// id is the sequence number or the UID of the message, which of the two is returned to the client.
+	id uint32
+
+	conversationID string
+	messageID      string
+	references     []string
+	date           time.Time
+}
+
+// parseThreadMessage returns the message of a FETCH response of gluon to the threadFetchItems.
+func parseThreadMessage(res []byte, useUID bool) (*threadMessage, bool) {
+	rest, ok := bytes.CutPrefix(res, []byte("* "))
+	if !ok {
+		return nil, false
+	}
+
+	seq, rest, ok := bytes.Cut(rest, []byte(" FETCH ("))
+	if !ok {
+		return nil, false
+	}
+
+	// The header fields are a literal, the other items are before or after it.
+	before, header, after, ok := cutBodySection(rest, threadSection)
+	if !ok {
+		return nil, false
+	}
+
+	items := append(bytes.Clone(before), after...)
+
+	id := string(seq)
+
+	if useUID {
+		match := threadUIDRegexp.FindSubmatch(items)
+		if match == nil {
+			return nil, false
+		}
+
+		id = string(match[1])
+	}
+
+	number, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil, false
+	}
+
+	message := &threadMessage{id: uint32(number)}
+
+	if match := threadInternalDateRegexp.FindSubmatch(items); match != nil {
+		message.date, _ = time.Parse(internalDateFormat, strings.TrimSpace(string(match[1])))
+	}
+
+	message.setHeader(readHeader(header))
+
+	return message, true
+}
+
+// setHeader sets the conversation ID, the message ID, the references and the sent date of the message from its header.
+func (message *threadMessage) setHeader(header mail.Header) {
+	message.conversationID = strings.TrimSpace(header.Get("X-Pm-Conversation-Id"))
+
+	if ids := threadMessageIDRegexp.FindAllString(header.Get("Message-Id"), 1); len(ids) > 0 {
+		message.messageID = ids[0]
+	}
+
+	// The In-Reply-To field is only used when there are no references.
+	message.references = threadMessageIDRegexp.FindAllString(header.Get("References"), -1)
+	if len(message.references) == 0 {
+		message.references = threadMessageIDRegexp.FindAllString(header.Get("In-Reply-To"), 1)
+	}
+
+	if date, err := mail.ParseDate(header.Get("Date")); err == nil {
+		message.date = date
+	}
+}
+
+// threadNode is a message in a thread, or a dummy standing for a message that is referenced but wasn't found.
+type threadNode struct {
+	message  *threadMessage
+	parent   *threadNode
+	children []*threadNode
+}
+
+func (node *threadNode) addChild(child *threadNode) {
+	child.parent = node
+	node.children = append(node.children, child)
+}
+
+func (node *threadNode) removeChild(child *threadNode) {
+	for idx, other := range node.children {
+		if other == child {
+			node.children = append(node.children[:idx], node.children[idx+1:]...)
+			break
+		}
+	}
+
+	child.parent = nil
+}
+
+// isAncestorOf returns whether the node is the other node or one of its ancestors.
+func (node *threadNode) isAncestorOf(other *threadNode) bool {
+	for ; other != nil; other = other.parent {
+		if other == node {
+			return true
+		}
+	}
+
+	return false
+}
+
+// date returns the sent date of the message of the node, that of its first child for a dummy.
+func (node *threadNode) date() time.Time {
+	if node.message == nil {
+		if len(node.children) == 0 {
+			return time.Time{}
+		}
+
+		return node.children[0].date()
+	}
+
+	return node.message.date
+}
+
+// first returns the message of the node, that of its first child for a dummy.
+func (node *threadNode) first() *threadMessage {
+	if node.message == nil {
+		if len(node.children) == 0 {
+			return nil
+		}
+
+		return node.children[0].first()
+	}
+
+	return node.message
+}
+
+// threadConversations makes a thread of the messages of each conversation, their replies being threaded by their
+// references. The messages without conversation are threaded by their references only.
+func threadConversations(messages []*threadMessage) []*threadNode {
+	conversations := make(map[string][]*threadMessage)
+
+	var (
+		conversationIDs []string
+		others          []*threadMessage
+	)
+
+	for _, message := range messages {
+		if message.conversationID == "" {
+			others = append(others, message)
+			continue
+		}
+
+		if _, ok := conversations[message.conversationID]; !ok {
+			conversationIDs = append(conversationIDs, message.conversationID)
+		}
+
+		conversations[message.conversationID] = append(conversations[message.conversationID], message)
+	}
+
+	threads := threadReferences(others)
+
+	for _, conversationID := range conversationIDs {
+		roots := threadReferences(conversations[conversationID])
+		if len(roots) == 1 {
+			threads = append(threads, roots[0])
+			continue
+		}
+
+		// The messages which don't reference each other are put together under a dummy.
+		thread := &threadNode{}
+
+		for _, root := range roots {
+			if root.message == nil {
+				for _, child := range root.children {
+					thread.addChild(child)
+				}
+			} else {
+				thread.addChild(root)
+			}
+		}
+
+		threads = append(threads, thread)
+	}
+
+	sortThreads(threads)
+
+	return threads
+}
+
+// threadReferences threads the messages by their references, see RFC 5256 section 3.
+func threadReferences(messages []*threadMessage) []*threadNode {
+	containers := make(map[string]*threadNode)
+
+	var nodes []*threadNode
+
+	container := func(messageID string) *threadNode {
+		node, ok := containers[messageID]
+		if !ok {
+			node = &threadNode{}
+			containers[messageID] = node
+			nodes = append(nodes, node)
+		}
+
+		return node
+	}
+
+	for _, message := range messages {
+		var node *threadNode
+
+		// Messages without ID or with the ID of another message get their own node.
+		if message.messageID != "" && container(message.messageID).message == nil {
+			node = containers[message.messageID]
+		} else {
+			node = &threadNode{}
+			nodes = append(nodes, node)
+		}
+
+		node.message = message
+
+		// The references are linked in order, unless already linked or making a loop.
+		var parent *threadNode
+
+		for _, reference := range message.references {
+			ref := container(reference)
+
+			if parent != nil && ref.parent == nil && !ref.isAncestorOf(parent) {
+				parent.addChild(ref)
+			}
+
+			parent = ref
+		}
+
+		// The last reference is the parent of the message, unless making a loop.
+		if node.parent != nil {
+			node.parent.removeChild(node)
+		}
+
+		if parent != nil && !node.isAncestorOf(parent) {
+			parent.addChild(node)
+		}
+	}
+
+	var roots []*threadNode
+
+	for _, node := range nodes {
+		if node.parent == nil {
+			roots = append(roots, node)
+		}
+	}
+
+	roots = pruneThreads(roots, true)
+
+	sortThreads(roots)
+
+	return roots
+}
+
+// pruneThreads removes the dummies without children and replaces those with children by their children, except at the
+// root when there are several of them.
+func pruneThreads(nodes []*threadNode, root bool) []*threadNode {
+	var pruned []*threadNode
+
+	for _, node := range nodes {
+		node.children = pruneThreads(node.children, false)
+
+		if node.message == nil && (len(node.children) == 0 || !root || len(node.children) == 1) {
+			for _, child := range node.children {
+				child.parent = node.parent
+			}
+
+			pruned = append(pruned, node.children...)
+
+			continue
+		}
+
+		pruned = append(pruned, node)
+	}
+
+	return pruned
+}
+
+// sortThreads sorts the nodes and their descendants by sent date.
+func sortThreads(nodes []*threadNode) {
+	for _, node := range nodes {
+		sortThreads(node.children)
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return lessThreadMessage(nodes[i].first(), nodes[j].first())
+	})
+}
+
+// lessThreadMessage orders the messages by sent date, then by sequence number.
+func lessThreadMessage(a, b *threadMessage) bool {
+	if a == nil || b == nil {
+		return b != nil
+	}
+
+	if !a.date.Equal(b.date) {
+		return a.date.Before(b.date)
+	}
+
+	return a.id < b.id
+}
+
+// formatThreads returns the threads as listed by a THREAD response, starting with a space if there are any.
+func formatThreads(threads []*threadNode) string {
+	var res strings.Builder
+
+	if len(threads) > 0 {
+		res.WriteByte(' ')
+	}
+
+	for _, thread := range threads {
+		writeThread(&res, thread)
+	}
+
+	return res.String()
+}
+
+// writeThread writes a thread: the chain of its first messages, then its branches if it has several.
+func writeThread(res *strings.Builder, node *threadNode) {
+	res.WriteByte('(')
+
+	var written bool
+
+	for {
+		if node.message != nil {
+			if written {
+				res.WriteByte(' ')
+			}
+
+			fmt.Fprint(res, node.message.id)
+
+			written = true
+		}
+
+		if len(node.children) != 1 {
+			break
+		}
+
+		node = node.children[0]
+	}
+
+	if len(node.children) > 1 {
+		if written {
+			res.WriteByte(' ')
+		}
+
+		for _, child := range node.children {
+			writeThread(res, child)
+		}
+	}
+
+	res.WriteByte(')')
+}
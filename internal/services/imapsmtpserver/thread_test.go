@@ -0,0 +1,189 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package imapsmtpserver
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceConversationItem(t *testing.T) {
+	section := "BODY.PEEK[" + conversationSection + "]"
+
+	for _, tc := range []struct {
+		args, want string
+		replaced   bool
+	}{
+		{args: "1:* (FLAGS X-PM-CONVERSATION-ID)", want: "1:* (FLAGS " + section + ")", replaced: true},
+		{args: "1 x-pm-conversation-id", want: "1 " + section, replaced: true},
+		{args: "1 (X-PM-CONVERSATION-ID UID)", want: "1 (" + section + " UID)", replaced: true},
+		{args: "1 (FLAGS X-PM-CONVERSATION-IDS)", want: "1 (FLAGS X-PM-CONVERSATION-IDS)"},
+		{args: "1 BODY.PEEK[HEADER.FIELDS (X-PM-CONVERSATION-ID)]", want: "1 BODY.PEEK[HEADER.FIELDS (X-PM-CONVERSATION-ID)]"},
+	} {
+		args, replaced := replaceConversationItem(tc.args)
+		require.Equal(t, tc.want, args, tc.args)
+		require.Equal(t, tc.replaced, replaced, tc.args)
+	}
+}
+
+func TestThreadConversations(t *testing.T) {
+	date := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	message := func(id uint32, conversationID, messageID string, references ...string) *threadMessage {
+		return &threadMessage{
+			id:             id,
+			conversationID: conversationID,
+			messageID:      messageID,
+			references:     references,
+			date:           date.Add(time.Duration(id) * time.Hour),
+		}
+	}
+
+	messages := []*threadMessage{
+		message(2, "c1", "<2@pm.me>", "<1@pm.me>"),
+		message(1, "c1", "<1@pm.me>"),
+		message(3, "c2", "<3@pm.me>"),
+		message(4, "c2", "<4@pm.me>", "<3@pm.me>"),
+		message(5, "c1", "<5@pm.me>"),
+		message(6, "", "<6@pm.me>", "<1@pm.me>"),
+		message(7, "", "<7@pm.me>", "<6@pm.me>"),
+	}
+
+	// The messages of a conversation make a thread even when they don't reference each other, and the messages
+	// without conversation are threaded by their references.
+	require.Equal(t, " ((1 2)(5))(3 4)(6 7)", formatThreads(threadConversations(messages)))
+
+	// References making a loop are ignored.
+	require.Equal(t, " (2 1)", formatThreads(threadConversations([]*threadMessage{
+		message(1, "c1", "<a@pm.me>", "<b@pm.me>"),
+		message(2, "c1", "<b@pm.me>", "<a@pm.me>"),
+	})))
+
+	require.Empty(t, formatThreads(threadConversations(nil)))
+}
+
+func TestThreadHandler(t *testing.T) {
+	conn, gluon, clientConn := newTestProxyConn(t, threadHandler{})
+
+	client := bufio.NewReader(clientConn)
+
+	// exchange expects the command the handler runs through gluon and answers it.
+	exchange := func(command string, res ...string) {
+		line, err := gluon.ReadString('\n')
+		require.NoError(t, err)
+
+		tag, got, _ := strings.Cut(strings.TrimSpace(line), " ")
+		require.True(t, strings.HasPrefix(tag, proxyTagPrefix))
+		require.Equal(t, command, got)
+
+		go func() {
+			for _, res := range append(res, tag+" OK completed\r\n") {
+				_, _ = conn.Write([]byte(res))
+			}
+		}()
+	}
+
+	fetch := func(seq, uid int, header string) string {
+		return fmt.Sprintf("* %v FETCH (UID %v INTERNALDATE \"01-Jan-2025 00:00:00 +0000\" BODY[%v] {%v}\r\n%v)\r\n", seq, uid, threadSection, len(header), header)
+	}
+
+	go func() { _, _ = clientConn.Write([]byte("a1 UID THREAD REFERENCES UTF-8 ALL\r\n")) }()
+
+	exchange("NOOP")
+	exchange("UID SEARCH CHARSET UTF-8 ALL", "* SEARCH 10 20 30\r\n")
+	exchange("UID FETCH 10,20,30 "+threadFetchItems,
+		fetch(1, 10, "Message-Id: <1@pm.me>\r\nDate: Wed, 01 Jan 2025 10:00:00 +0000\r\nX-Pm-Conversation-Id: c1\r\n\r\n"),
+		"* 3 EXISTS\r\n",
+		fetch(2, 20, "Message-Id: <2@pm.me>\r\nDate: Wed, 01 Jan 2025 11:00:00 +0000\r\nX-Pm-Conversation-Id: c2\r\n\r\n"),
+		fetch(3, 30, "Message-Id: <3@pm.me>\r\nDate: Wed, 01 Jan 2025 12:00:00 +0000\r\nX-Pm-Conversation-Id: c1\r\n\r\n"),
+	)
+
+	// The responses of gluon the handler doesn't need reach the client.
+	for _, want := range []string{"* 3 EXISTS\r\n", "* THREAD ((10)(30))(20)\r\n", "a1 OK UID THREAD completed\r\n"} {
+		line, err := client.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, want, line)
+	}
+
+	// The refusals of gluon are relayed.
+	go func() { _, _ = clientConn.Write([]byte("a2 THREAD REFERENCES UTF-8 ALL\r\n")) }()
+
+	exchange("NOOP")
+
+	line, err := gluon.ReadString('\n')
+	require.NoError(t, err)
+
+	tag, _, _ := strings.Cut(line, " ")
+	go func() { _, _ = conn.Write([]byte(tag + " BAD No mailbox selected\r\n")) }()
+
+	line, err = client.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "a2 BAD No mailbox selected\r\n", line)
+
+	// The other algorithms are refused.
+	go func() { _, _ = clientConn.Write([]byte("a3 THREAD ORDEREDSUBJECT UTF-8 ALL\r\n")) }()
+
+	line, err = client.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "a3 BAD Unsupported threading algorithm\r\n", line)
+}
+
+func TestThreadHandler_ConversationItem(t *testing.T) {
+	conn, gluon, clientConn := newTestProxyConn(t, threadHandler{})
+
+	client := bufio.NewReader(clientConn)
+
+	go func() { _, _ = clientConn.Write([]byte("a1 UID FETCH 1:* (FLAGS X-PM-CONVERSATION-ID)\r\n")) }()
+
+	// The item is fetched through gluon as a header section.
+	line, err := gluon.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "a1 UID FETCH 1:* (FLAGS BODY.PEEK["+conversationSection+"])\r\n", line)
+
+	header := "X-Pm-Conversation-Id: conversation==\r\n\r\n"
+
+	go func() {
+		for _, res := range []string{
+			fmt.Sprintf("* 1 FETCH (FLAGS (\\Seen) BODY[%v] {%v}\r\n%v UID 10)\r\n", conversationSection, len(header), header),
+			fmt.Sprintf("* 2 FETCH (FLAGS () BODY[%v] {2}\r\n\r\n UID 20)\r\n", conversationSection),
+			"* 3 FETCH (BODY[HEADER.FIELDS (X-PM-CONVERSATION-ID)] {2}\r\n\r\n)\r\n",
+			"a1 OK UID FETCH completed\r\n",
+		} {
+			_, _ = conn.Write([]byte(res))
+		}
+	}()
+
+	// The header section is replaced by the item, the header fetched by the client being left as is.
+	for _, want := range []string{
+		"* 1 FETCH (FLAGS (\\Seen) X-PM-CONVERSATION-ID \"conversation==\" UID 10)\r\n",
+		"* 2 FETCH (FLAGS () X-PM-CONVERSATION-ID NIL UID 20)\r\n",
+		"* 3 FETCH (BODY[HEADER.FIELDS (X-PM-CONVERSATION-ID)] {2}\r\n",
+		"\r\n",
+		")\r\n",
+		"a1 OK UID FETCH completed\r\n",
+	} {
+		line, err := client.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, want, line)
+	}
+}
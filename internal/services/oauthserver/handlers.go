@@ -0,0 +1,289 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package oauthserver
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/google/uuid"
+)
+
+// OAuth2 error codes, see RFC 6749.
+const (
+	errInvalidRequest          = "invalid_request"
+	errAccessDenied            = "access_denied"
+	errUnsupportedResponseType = "unsupported_response_type"
+	errInvalidGrant            = "invalid_grant"
+	errUnsupportedGrantType    = "unsupported_grant_type"
+	errInvalidScope            = "invalid_scope"
+	errTemporarilyUnavailable  = "temporarily_unavailable"
+)
+
+var errInvalidRedirectURI = errors.New("only loopback http redirect URIs are accepted")
+
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+	Scope        string `json:"scope"`
+}
+
+type errorResponse struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+// checkHost rejects the requests not addressed to the loopback interface, to prevent DNS rebinding.
+func (s *Service) checkHost(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.Host)
+		if err != nil {
+			host = r.Host
+		}
+
+		if !isLoopbackHost(host) {
+			http.Error(w, "invalid host", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handleAuthorize implements the authorization endpoint of the authorization code grant (RFC 6749 section 4.1)
+// with PKCE (RFC 7636), which is required with the S256 method. The response is sent once the user allowed or denied
+// the request.
+func (s *Service) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+
+	clientID := query.Get("client_id")
+	state := query.Get("state")
+
+	// Without a valid redirect URI, the error can only be shown to the user.
+	redirectURI, err := parseRedirectURI(query.Get("redirect_uri"))
+	if clientID == "" || err != nil {
+		http.Error(w, "missing client_id or invalid redirect_uri, only loopback redirect URIs are accepted", http.StatusBadRequest)
+		return
+	}
+
+	if query.Get("response_type") != "code" {
+		redirectError(w, r, redirectURI, state, errUnsupportedResponseType)
+		return
+	}
+
+	// Any client can listen on a loopback redirect URI: only the client having the verifier can use the code.
+	challenge := query.Get("code_challenge")
+	if challenge == "" || query.Get("code_challenge_method") != "S256" {
+		redirectError(w, r, redirectURI, state, errInvalidRequest)
+		return
+	}
+
+	scope, ok := parseScope(query.Get("scope"))
+	if !ok {
+		redirectError(w, r, redirectURI, state, errInvalidScope)
+		return
+	}
+
+	userID, email, err := s.users.ResolveOAuthUser(query.Get("login_hint"))
+	if err != nil {
+		s.log.WithError(err).WithField("clientID", clientID).Warn("Cannot find the user of the authorization request")
+		redirectError(w, r, redirectURI, state, errAccessDenied)
+
+		return
+	}
+
+	allowed, err := s.requestAuthorization(r.Context(), clientID, userID, email, scope)
+	if err != nil {
+		s.log.WithError(err).WithField("clientID", clientID).Warn("Refused authorization request")
+		redirectError(w, r, redirectURI, state, errTemporarilyUnavailable)
+
+		return
+	}
+
+	if !allowed {
+		redirectError(w, r, redirectURI, state, errAccessDenied)
+		return
+	}
+
+	code, err := s.newCode(authCode{
+		grant:         grant{id: uuid.NewString(), userID: userID, clientID: clientID, scope: scope},
+		redirectURI:   redirectURI.String(),
+		codeChallenge: challenge,
+	})
+	if err != nil {
+		s.log.WithError(err).Error("Failed to create authorization code")
+		http.Error(w, "internal error", http.StatusInternalServerError)
+
+		return
+	}
+
+	redirect(w, r, redirectURI, url.Values{"code": {code}, "state": {state}})
+}
+
+// handleToken implements the token endpoint for the authorization code and refresh token grants.
+// The clients are public clients: a client secret, if any, is ignored.
+func (s *Service) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: errInvalidRequest})
+		return
+	}
+
+	clientID := r.PostForm.Get("client_id")
+	if username, _, ok := r.BasicAuth(); ok && clientID == "" {
+		clientID = username
+	}
+
+	var granted grant
+
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		code, ok := s.takeCode(r.PostForm.Get("code"))
+		if !ok || code.clientID != clientID || code.redirectURI != r.PostForm.Get("redirect_uri") {
+			writeJSON(w, http.StatusBadRequest, errorResponse{Error: errInvalidGrant})
+			return
+		}
+
+		if !verifyCodeChallenge(code.codeChallenge, r.PostForm.Get("code_verifier")) {
+			writeJSON(w, http.StatusBadRequest, errorResponse{Error: errInvalidGrant, Description: "invalid code verifier"})
+			return
+		}
+
+		granted = code.grant
+
+	case "refresh_token":
+		grant, ok := s.takeRefreshToken(r.PostForm.Get("refresh_token"))
+		if !ok || grant.clientID != clientID {
+			writeJSON(w, http.StatusBadRequest, errorResponse{Error: errInvalidGrant})
+			return
+		}
+
+		granted = *grant
+
+	default:
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: errUnsupportedGrantType})
+		return
+	}
+
+	accessToken, refreshToken, err := s.issueTokens(granted)
+	if err != nil {
+		s.log.WithError(err).Error("Failed to issue tokens")
+		http.Error(w, "internal error", http.StatusInternalServerError)
+
+		return
+	}
+
+	s.log.WithField("clientID", clientID).Info("Issued tokens")
+
+	writeJSON(w, http.StatusOK, tokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(accessTokenLifetime.Seconds()),
+		RefreshToken: refreshToken,
+		Scope:        string(granted.scope),
+	})
+}
+
+// parseScope returns the scope of an authorization request, which is one of the scopes of the app passwords, all of
+// them if not given.
+func parseScope(value string) (vault.AppPasswordScope, bool) {
+	if value == "" {
+		return vault.AppPasswordScopeAll, true
+	}
+
+	scope := vault.AppPasswordScope(value)
+
+	return scope, scope.IsValid()
+}
+
+// parseRedirectURI returns the given redirect URI if it points to the loopback interface (RFC 8252 section 7.3).
+func parseRedirectURI(value string) (*url.URL, error) {
+	redirectURI, err := url.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if redirectURI.Scheme != "http" || !isLoopbackHost(redirectURI.Hostname()) || redirectURI.Fragment != "" {
+		return nil, errInvalidRedirectURI
+	}
+
+	return redirectURI, nil
+}
+
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// verifyCodeChallenge returns whether the verifier matches the S256 code challenge.
+func verifyCodeChallenge(challenge, verifier string) bool {
+	hash := sha256.Sum256([]byte(verifier))
+
+	return subtle.ConstantTimeCompare([]byte(challenge), []byte(base64.RawURLEncoding.EncodeToString(hash[:]))) == 1
+}
+
+func redirectError(w http.ResponseWriter, r *http.Request, redirectURI *url.URL, state, code string) {
+	redirect(w, r, redirectURI, url.Values{"error": {code}, "state": {state}})
+}
+
+func redirect(w http.ResponseWriter, r *http.Request, redirectURI *url.URL, params url.Values) {
+	query := redirectURI.Query()
+
+	for key, values := range params {
+		if values[0] != "" {
+			query.Set(key, values[0])
+		}
+	}
+
+	target := *redirectURI
+	target.RawQuery = query.Encode()
+
+	http.Redirect(w, r, target.String(), http.StatusFound)
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(value)
+}
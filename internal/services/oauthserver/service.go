@@ -0,0 +1,515 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package oauthserver implements a minimal OAuth2 authorization server listening on the loopback interface.
+// It lets the mail clients which only support OAuth2 authenticate to the IMAP and SMTP servers: the user confirms
+// each authorization request in bridge and the client receives short-lived tokens bound to the user.
+package oauthserver
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// authorizationTimeout is how long an authorization request waits for the user's decision.
+	authorizationTimeout = 2 * time.Minute
+	shutdownTimeout      = time.Second
+
+	// maxPendingRequests is how many authorization requests can wait for the user's decision at once; the others are
+	// refused until the user decided or the requests timed out.
+	maxPendingRequests = 8
+
+	codeLifetime         = time.Minute
+	accessTokenLifetime  = time.Hour
+	refreshTokenLifetime = 30 * 24 * time.Hour
+)
+
+var (
+	ErrNoSuchRequest = errors.New("no such authorization request")
+	ErrInvalidToken  = errors.New("invalid or expired token")
+
+	errTooManyRequests = errors.New("too many pending authorization requests")
+)
+
+// UserResolver finds the user an authorization request is for.
+type UserResolver interface {
+	// ResolveOAuthUser returns the ID and primary address of the user owning the given address,
+	// or of the only user if the address is empty.
+	ResolveOAuthUser(address string) (userID, email string, err error)
+}
+
+// Request is an authorization request waiting for the user's decision.
+type Request struct {
+	ID       string
+	ClientID string
+	UserID   string
+	Email    string
+
+	// Scope restricts the access of the client like the scope of an app password.
+	Scope vault.AppPasswordScope
+
+	CreatedAt time.Time
+}
+
+type pendingRequest struct {
+	Request
+
+	decisionCh chan bool
+}
+
+// grant is what an authorization code or a token gives access to. The tokens issued from the same authorization
+// share its ID.
+type grant struct {
+	id       string
+	userID   string
+	clientID string
+	scope    vault.AppPasswordScope
+
+	expiresAt time.Time
+}
+
+type authCode struct {
+	grant
+
+	redirectURI   string
+	codeChallenge string
+}
+
+// Service is the OAuth2 authorization server. The codes and tokens are only kept in memory and are only stored
+// hashed; they are all invalidated when the server is stopped.
+type Service struct {
+	users          UserResolver
+	eventPublisher events.EventPublisher
+	panicHandler   async.PanicHandler
+
+	server   *http.Server
+	listener net.Listener
+
+	requests      map[string]*pendingRequest
+	codes         map[[sha256.Size]byte]*authCode
+	accessTokens  map[[sha256.Size]byte]*grant
+	refreshTokens map[[sha256.Size]byte]*grant
+	lock          sync.Mutex
+
+	// loginKey starts the login passwords, see LoginPassword.
+	loginKey string
+
+	log *logrus.Entry
+	now func() time.Time
+}
+
+func NewService(users UserResolver, eventPublisher events.EventPublisher, panicHandler async.PanicHandler) *Service {
+	return &Service{
+		users:          users,
+		eventPublisher: eventPublisher,
+		panicHandler:   panicHandler,
+
+		requests:      make(map[string]*pendingRequest),
+		codes:         make(map[[sha256.Size]byte]*authCode),
+		accessTokens:  make(map[[sha256.Size]byte]*grant),
+		refreshTokens: make(map[[sha256.Size]byte]*grant),
+
+		log: logrus.WithField("service", "oauth"),
+		now: time.Now,
+	}
+}
+
+// Start serves the authorization and token endpoints on the given loopback port. It returns the port listened on.
+func (s *Service) Start(port int) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.listener != nil {
+		return 0, errors.New("OAuth server is already running")
+	}
+
+	loginKey, err := newRandomToken()
+	if err != nil {
+		return 0, err
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(constants.Host, strconv.Itoa(port)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to listen on OAuth port: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/authorize", s.handleAuthorize)
+	mux.HandleFunc("/token", s.handleToken)
+
+	server := &http.Server{
+		Handler:           s.checkHost(mux),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		defer async.HandlePanic(s.panicHandler)
+
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.log.WithError(err).Error("OAuth server stopped")
+		}
+	}()
+
+	s.server, s.listener, s.loginKey = server, listener, loginKey
+
+	s.log.WithField("port", s.port()).Info("OAuth server started")
+
+	return s.port(), nil
+}
+
+// Stop stops the server, denying the pending authorization requests and invalidating all the issued tokens.
+func (s *Service) Stop() {
+	s.lock.Lock()
+
+	server := s.server
+	if server == nil {
+		s.lock.Unlock()
+		return
+	}
+
+	s.server, s.listener, s.loginKey = nil, nil, ""
+
+	for _, request := range s.requests {
+		close(request.decisionCh)
+	}
+
+	s.requests = make(map[string]*pendingRequest)
+	s.codes = make(map[[sha256.Size]byte]*authCode)
+	s.accessTokens = make(map[[sha256.Size]byte]*grant)
+	s.refreshTokens = make(map[[sha256.Size]byte]*grant)
+
+	s.lock.Unlock()
+
+	// The denied requests are still answered before the server stops.
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(ctx); err != nil {
+		s.log.WithError(err).Warn("Failed to shut down OAuth server")
+		_ = server.Close()
+	}
+
+	s.log.Info("OAuth server stopped")
+}
+
+// Port returns the port the server listens on, or 0 if it is not running.
+func (s *Service) Port() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.port()
+}
+
+// Requests returns the authorization requests waiting for the user's decision, oldest first.
+func (s *Service) Requests() []Request {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	requests := xslices.Map(maps.Values(s.requests), func(request *pendingRequest) Request { return request.Request })
+
+	slices.SortFunc(requests, func(a, b Request) bool { return a.CreatedAt.Before(b.CreatedAt) })
+
+	return requests
+}
+
+// Resolve allows or denies the given authorization request.
+func (s *Service) Resolve(requestID string, allow bool) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	request, ok := s.requests[requestID]
+	if !ok {
+		return ErrNoSuchRequest
+	}
+
+	delete(s.requests, requestID)
+
+	request.decisionCh <- allow
+
+	s.log.WithField("requestID", requestID).WithField("allowed", allow).Info("Resolved authorization request")
+
+	return nil
+}
+
+// VerifyToken returns the ID of the user the given access token was issued to and the scope it was issued with.
+func (s *Service) VerifyToken(token string) (string, vault.AppPasswordScope, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	grant, err := s.verifyToken(token)
+	if err != nil {
+		return "", "", err
+	}
+
+	return grant.userID, grant.scope, nil
+}
+
+// LoginPassword returns the password the OAuth mechanisms of the IMAP and SMTP servers authenticate with in place of
+// the given access token. The mail clients can't make it from the token, which is thus not accepted as a password.
+func (s *Service) LoginPassword(token string) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.loginKey + token
+}
+
+// VerifyLoginPassword returns the ID of the user the access token of the given login password was issued to, the ID of
+// the authorization it was issued from, which the sessions authenticated with it are bound to, and its scope.
+func (s *Service) VerifyLoginPassword(password string) (string, string, vault.AppPasswordScope, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.loginKey == "" || len(password) < len(s.loginKey) {
+		return "", "", "", ErrInvalidToken
+	}
+
+	if subtle.ConstantTimeCompare([]byte(password[:len(s.loginKey)]), []byte(s.loginKey)) != 1 {
+		return "", "", "", ErrInvalidToken
+	}
+
+	grant, err := s.verifyToken(password[len(s.loginKey):])
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return grant.userID, grant.id, grant.scope, nil
+}
+
+func (s *Service) verifyToken(token string) (*grant, error) {
+	grant, ok := s.accessTokens[hashToken(token)]
+	if !ok || !s.now().Before(grant.expiresAt) {
+		return nil, ErrInvalidToken
+	}
+
+	return grant, nil
+}
+
+// RevokeUserTokens invalidates the codes and tokens issued to the given user. It returns the IDs of the authorizations
+// the revoked tokens were issued from, whose sessions are to be closed.
+func (s *Service) RevokeUserTokens(userID string) []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for hash, code := range s.codes {
+		if code.userID == userID {
+			delete(s.codes, hash)
+		}
+	}
+
+	var grantIDs []string
+
+	for _, tokens := range []map[[sha256.Size]byte]*grant{s.accessTokens, s.refreshTokens} {
+		for hash, grant := range tokens {
+			if grant.userID != userID {
+				continue
+			}
+
+			delete(tokens, hash)
+
+			if !slices.Contains(grantIDs, grant.id) {
+				grantIDs = append(grantIDs, grant.id)
+			}
+		}
+	}
+
+	return grantIDs
+}
+
+// requestAuthorization waits for the user to allow or deny the given request. It fails if too many requests are
+// already waiting.
+func (s *Service) requestAuthorization(ctx context.Context, clientID, userID, email string, scope vault.AppPasswordScope) (bool, error) {
+	request := &pendingRequest{
+		Request: Request{
+			ID:        uuid.NewString(),
+			ClientID:  clientID,
+			UserID:    userID,
+			Email:     email,
+			Scope:     scope,
+			CreatedAt: s.now(),
+		},
+		decisionCh: make(chan bool, 1),
+	}
+
+	s.lock.Lock()
+	if len(s.requests) >= maxPendingRequests {
+		s.lock.Unlock()
+		return false, errTooManyRequests
+	}
+
+	s.requests[request.ID] = request
+	s.lock.Unlock()
+
+	s.log.WithField("requestID", request.ID).WithField("clientID", clientID).Info("Waiting for authorization request decision")
+
+	s.eventPublisher.PublishEvent(ctx, events.OAuthAuthorizationRequested{
+		RequestID: request.ID,
+		ClientID:  clientID,
+		UserID:    userID,
+		Email:     email,
+		Scope:     string(scope),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, authorizationTimeout)
+	defer cancel()
+
+	select {
+	case allow := <-request.decisionCh:
+		return allow, nil
+
+	case <-ctx.Done():
+		s.lock.Lock()
+		delete(s.requests, request.ID)
+		s.lock.Unlock()
+
+		return false, nil
+	}
+}
+
+func (s *Service) newCode(code authCode) (string, error) {
+	raw, err := newRandomToken()
+	if err != nil {
+		return "", err
+	}
+
+	code.expiresAt = s.now().Add(codeLifetime)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.codes[hashToken(raw)] = &code
+
+	return raw, nil
+}
+
+// takeCode returns the given authorization code, which can only be used once.
+func (s *Service) takeCode(raw string) (*authCode, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	code, ok := s.codes[hashToken(raw)]
+	if !ok {
+		return nil, false
+	}
+
+	delete(s.codes, hashToken(raw))
+
+	return code, s.now().Before(code.expiresAt)
+}
+
+// takeRefreshToken returns the grant of the given refresh token; refresh tokens are rotated on every use.
+func (s *Service) takeRefreshToken(raw string) (*grant, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	grant, ok := s.refreshTokens[hashToken(raw)]
+	if !ok {
+		return nil, false
+	}
+
+	delete(s.refreshTokens, hashToken(raw))
+
+	return grant, s.now().Before(grant.expiresAt)
+}
+
+// issueTokens returns a new access token and refresh token for the given grant.
+func (s *Service) issueTokens(grant grant) (string, string, error) {
+	accessToken, err := newRandomToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	refreshToken, err := newRandomToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+
+	s.removeExpired(now)
+
+	access, refresh := grant, grant
+
+	access.expiresAt = now.Add(accessTokenLifetime)
+	refresh.expiresAt = now.Add(refreshTokenLifetime)
+
+	s.accessTokens[hashToken(accessToken)] = &access
+	s.refreshTokens[hashToken(refreshToken)] = &refresh
+
+	return accessToken, refreshToken, nil
+}
+
+func (s *Service) removeExpired(now time.Time) {
+	for hash, code := range s.codes {
+		if !now.Before(code.expiresAt) {
+			delete(s.codes, hash)
+		}
+	}
+
+	for _, tokens := range []map[[sha256.Size]byte]*grant{s.accessTokens, s.refreshTokens} {
+		for hash, grant := range tokens {
+			if !now.Before(grant.expiresAt) {
+				delete(tokens, hash)
+			}
+		}
+	}
+}
+
+func (s *Service) port() int {
+	if s.listener == nil {
+		return 0
+	}
+
+	return s.listener.Addr().(*net.TCPAddr).Port //nolint:forcetypeassert
+}
+
+func newRandomToken() (string, error) {
+	token := make([]byte, 32)
+
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+func hashToken(token string) [sha256.Size]byte {
+	return sha256.Sum256([]byte(token))
+}
@@ -0,0 +1,302 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package oauthserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/proton-bridge/v3/internal/events"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	redirectURI  = "http://127.0.0.1:4321/callback"
+	codeVerifier = "a-code-verifier-long-enough-for-the-test"
+)
+
+type fakeUsers struct{}
+
+func (fakeUsers) ResolveOAuthUser(address string) (string, string, error) {
+	switch address {
+	case "", "user@pm.me":
+		return "userID", "user@pm.me", nil
+
+	default:
+		return "", "", errors.New("no such user")
+	}
+}
+
+type fakePublisher struct {
+	requestCh chan events.OAuthAuthorizationRequested
+}
+
+func (p *fakePublisher) PublishEvent(_ context.Context, event events.Event) {
+	if event, ok := event.(events.OAuthAuthorizationRequested); ok {
+		p.requestCh <- event
+	}
+}
+
+func TestService_AuthorizationCodeFlow(t *testing.T) {
+	service, publisher := newTestService(t)
+
+	// The code is only returned once the user allowed the request.
+	locationCh := authorize(t, service, url.Values{"login_hint": {"user@pm.me"}})
+
+	request := <-publisher.requestCh
+	require.Equal(t, "client", request.ClientID)
+	require.Equal(t, "userID", request.UserID)
+	require.Equal(t, "all", request.Scope)
+	require.Len(t, service.Requests(), 1)
+
+	require.NoError(t, service.Resolve(request.RequestID, true))
+	require.ErrorIs(t, service.Resolve(request.RequestID, true), ErrNoSuchRequest)
+
+	location := <-locationCh
+	require.Equal(t, "state", location.Query().Get("state"))
+
+	code := location.Query().Get("code")
+	require.NotEmpty(t, code)
+
+	// The code verifier must match the challenge.
+	status, _ := token(t, service, url.Values{"grant_type": {"authorization_code"}, "code": {code}, "code_verifier": {"wrong"}})
+	require.Equal(t, http.StatusBadRequest, status)
+
+	// The code can only be used once.
+	locationCh = authorize(t, service, url.Values{"scope": {"imap-readonly"}})
+
+	request = <-publisher.requestCh
+	require.Equal(t, "imap-readonly", request.Scope)
+	require.NoError(t, service.Resolve(request.RequestID, true))
+	code = (<-locationCh).Query().Get("code")
+
+	status, tokens := token(t, service, url.Values{"grant_type": {"authorization_code"}, "code": {code}, "code_verifier": {codeVerifier}})
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "Bearer", tokens.TokenType)
+	require.Equal(t, "imap-readonly", tokens.Scope)
+
+	status, _ = token(t, service, url.Values{"grant_type": {"authorization_code"}, "code": {code}, "code_verifier": {codeVerifier}})
+	require.Equal(t, http.StatusBadRequest, status)
+
+	userID, scope, err := service.VerifyToken(tokens.AccessToken)
+	require.NoError(t, err)
+	require.Equal(t, "userID", userID)
+	require.Equal(t, vault.AppPasswordScopeIMAPReadOnly, scope)
+
+	// The token is only accepted as a login password once made one.
+	_, _, _, err = service.VerifyLoginPassword(tokens.AccessToken)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	userID, grantID, scope, err := service.VerifyLoginPassword(service.LoginPassword(tokens.AccessToken))
+	require.NoError(t, err)
+	require.Equal(t, "userID", userID)
+	require.NotEmpty(t, grantID)
+	require.Equal(t, vault.AppPasswordScopeIMAPReadOnly, scope)
+
+	// The refresh token is rotated, the scope is kept.
+	status, refreshed := token(t, service, url.Values{"grant_type": {"refresh_token"}, "refresh_token": {tokens.RefreshToken}})
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "imap-readonly", refreshed.Scope)
+
+	status, _ = token(t, service, url.Values{"grant_type": {"refresh_token"}, "refresh_token": {tokens.RefreshToken}})
+	require.Equal(t, http.StatusBadRequest, status)
+
+	// The access tokens expire.
+	service.now = func() time.Time { return time.Now().Add(accessTokenLifetime) }
+
+	_, _, err = service.VerifyToken(refreshed.AccessToken)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	// The tokens of a user can be revoked.
+	service.now = time.Now
+
+	_, _, err = service.VerifyToken(refreshed.AccessToken)
+	require.NoError(t, err)
+
+	// The refreshed tokens keep the authorization of the first ones, whose sessions are closed when revoked.
+	_, refreshedGrantID, _, err := service.VerifyLoginPassword(service.LoginPassword(refreshed.AccessToken))
+	require.NoError(t, err)
+	require.Equal(t, grantID, refreshedGrantID)
+
+	require.Equal(t, []string{grantID}, service.RevokeUserTokens("userID"))
+
+	_, _, err = service.VerifyToken(refreshed.AccessToken)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestService_AuthorizationDenied(t *testing.T) {
+	service, publisher := newTestService(t)
+
+	locationCh := authorize(t, service, url.Values{})
+	require.NoError(t, service.Resolve((<-publisher.requestCh).RequestID, false))
+	require.Equal(t, "access_denied", (<-locationCh).Query().Get("error"))
+
+	// PKCE is required, with the S256 method.
+	for _, query := range []url.Values{
+		{"code_challenge": {""}},
+		{"code_challenge_method": {"plain"}},
+		{"code_challenge": {codeVerifier}, "code_challenge_method": {"plain"}},
+	} {
+		locationCh = authorize(t, service, query)
+		require.Equal(t, "invalid_request", (<-locationCh).Query().Get("error"))
+	}
+
+	// Unknown users are denied without asking.
+	locationCh = authorize(t, service, url.Values{"login_hint": {"other@pm.me"}})
+	require.Equal(t, "access_denied", (<-locationCh).Query().Get("error"))
+
+	// The scope must be one of the app password scopes.
+	locationCh = authorize(t, service, url.Values{"scope": {"pop3"}})
+	require.Equal(t, "invalid_scope", (<-locationCh).Query().Get("error"))
+
+	// The pending requests are denied when the server stops.
+	locationCh = authorize(t, service, url.Values{})
+	<-publisher.requestCh
+
+	service.Stop()
+	require.Empty(t, service.Requests())
+	require.Zero(t, service.Port())
+
+	select {
+	case location := <-locationCh:
+		require.Equal(t, "access_denied", location.Query().Get("error"))
+
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "the request was not denied")
+	}
+}
+
+func TestService_TooManyRequests(t *testing.T) {
+	service, publisher := newTestService(t)
+
+	for i := 0; i < maxPendingRequests; i++ {
+		authorize(t, service, url.Values{})
+		<-publisher.requestCh
+	}
+
+	// The requests beyond the limit are refused without asking.
+	locationCh := authorize(t, service, url.Values{})
+	require.Equal(t, "temporarily_unavailable", (<-locationCh).Query().Get("error"))
+
+	// The user's decisions make room for new requests.
+	require.NoError(t, service.Resolve(service.Requests()[0].ID, false))
+
+	authorize(t, service, url.Values{})
+	<-publisher.requestCh
+
+	require.Len(t, service.Requests(), maxPendingRequests)
+}
+
+func TestService_InvalidRequests(t *testing.T) {
+	service, _ := newTestService(t)
+
+	for _, query := range []url.Values{
+		{"response_type": {"code"}, "redirect_uri": {redirectURI}},
+		{"response_type": {"code"}, "client_id": {"client"}, "redirect_uri": {"https://example.com/callback"}},
+	} {
+		res, err := http.Get(fmt.Sprintf("http://127.0.0.1:%v/authorize?%v", service.Port(), query.Encode())) //nolint:noctx
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	}
+
+	// Only the loopback host names are accepted.
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://127.0.0.1:%v/token", service.Port()), nil) //nolint:noctx
+	require.NoError(t, err)
+	req.Host = "attacker.example.com"
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, http.StatusForbidden, res.StatusCode)
+}
+
+func newTestService(t *testing.T) (*Service, *fakePublisher) {
+	publisher := &fakePublisher{requestCh: make(chan events.OAuthAuthorizationRequested, 1)}
+
+	service := NewService(fakeUsers{}, publisher, async.NoopPanicHandler{})
+
+	_, err := service.Start(0)
+	require.NoError(t, err)
+	t.Cleanup(service.Stop)
+
+	return service, publisher
+}
+
+// authorize sends an authorization request in the background and returns the redirection location. The request has
+// the S256 challenge of codeVerifier unless the query has its own.
+func authorize(t *testing.T, service *Service, query url.Values) <-chan *url.URL {
+	if !query.Has("code_challenge") && !query.Has("code_challenge_method") {
+		challenge := sha256.Sum256([]byte(codeVerifier))
+
+		query.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+		query.Set("code_challenge_method", "S256")
+	}
+
+	query.Set("response_type", "code")
+	query.Set("client_id", "client")
+	query.Set("redirect_uri", redirectURI)
+	query.Set("state", "state")
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+
+	locationCh := make(chan *url.URL, 1)
+
+	go func() {
+		res, err := client.Get(fmt.Sprintf("http://127.0.0.1:%v/authorize?%v", service.Port(), query.Encode())) //nolint:noctx
+		if err != nil {
+			locationCh <- &url.URL{RawQuery: url.Values{"error": {err.Error()}}.Encode()}
+			return
+		}
+		defer func() { _ = res.Body.Close() }()
+
+		location, err := res.Location()
+		if err != nil {
+			location = &url.URL{RawQuery: url.Values{"error": {err.Error()}}.Encode()}
+		}
+
+		locationCh <- location
+	}()
+
+	return locationCh
+}
+
+func token(t *testing.T, service *Service, form url.Values) (int, tokenResponse) {
+	form.Set("client_id", "client")
+	form.Set("redirect_uri", redirectURI)
+
+	res, err := http.PostForm(fmt.Sprintf("http://127.0.0.1:%v/token", service.Port()), form) //nolint:noctx
+	require.NoError(t, err)
+	defer func() { _ = res.Body.Close() }()
+
+	var tokens tokenResponse
+
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&tokens))
+
+	return res.StatusCode, tokens
+}
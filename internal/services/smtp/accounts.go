@@ -56,20 +56,22 @@ func (s *Accounts) RemoveAccount(account *Service) {
 	delete(s.accounts, account.UserID())
 }
 
-func (s *Accounts) CheckAuth(user string, password []byte) (string, string, error) {
+// CheckAuth returns the user ID and address ID of the account the client authenticated as,
+// and the ID of the app password it used, empty for the bridge password.
+func (s *Accounts) CheckAuth(user string, password []byte) (string, string, string, error) {
 	s.accountsLock.RLock()
 	defer s.accountsLock.RUnlock()
 
 	for id, account := range s.accounts {
-		addrID, err := account.service.checkAuth(context.Background(), user, password)
+		res, err := account.service.checkAuth(context.Background(), user, password)
 		if err != nil {
 			continue
 		}
 
-		return id, addrID, nil
+		return id, res.addrID, res.appPasswordID, nil
 	}
 
-	return "", "", ErrNoSuchUser
+	return "", "", "", ErrNoSuchUser
 }
 
 func (s *Accounts) SendMail(ctx context.Context, userID, addrID, from string, to []string, r io.Reader) error {
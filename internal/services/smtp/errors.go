@@ -20,6 +20,8 @@ package smtp
 import (
 	"errors"
 	"fmt"
+
+	"github.com/emersion/go-smtp"
 )
 
 var ErrInvalidRecipient = errors.New("invalid recipient")
@@ -27,6 +29,14 @@ var ErrInvalidReturnPath = errors.New("invalid return path")
 var ErrNoSuchUser = errors.New("no such user")
 var ErrTooManyErrors = errors.New("too many failed requests, please try again later")
 
+// ErrOverQuota is returned when the message doesn't fit in the space left in the account. It must not be wrapped, so
+// that the SMTP server replies with its code.
+var ErrOverQuota = &smtp.SMTPError{
+	Code:         552,
+	EnhancedCode: smtp.EnhancedCode{5, 2, 2},
+	Message:      "The account is over quota",
+}
+
 type ErrCannotSendFromAddress struct {
 	address string
 }
@@ -38,3 +48,18 @@ func NewErrCannotSendFromAddress(address string) *ErrCannotSendFromAddress {
 func (e ErrCannotSendFromAddress) Error() string {
 	return fmt.Sprintf("cannot send from address: %v", e.address)
 }
+
+// ErrSenderNotAuthenticated is returned in strict split mode when the message is sent from another address
+// than the one the SMTP session authenticated with.
+type ErrSenderNotAuthenticated struct {
+	address     string
+	authAddress string
+}
+
+func NewErrSenderNotAuthenticated(address, authAddress string) *ErrSenderNotAuthenticated {
+	return &ErrSenderNotAuthenticated{address: address, authAddress: authAddress}
+}
+
+func (e ErrSenderNotAuthenticated) Error() string {
+	return fmt.Sprintf("cannot send from address %v: the session is authenticated as %v", e.address, e.authAddress)
+}
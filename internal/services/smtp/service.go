@@ -67,6 +67,7 @@ type Service struct {
 	subscription *userevents.EventChanneledSubscriber
 
 	addressMode   usertypes.AddressMode
+	addressGroups usertypes.AddressGroups
 	serverManager ServerManager
 
 	observabilitySender observability.Sender
@@ -85,6 +86,7 @@ func NewService(
 	keyPassProvider useridentity.KeyPassProvider,
 	eventService userevents.Subscribable,
 	mode usertypes.AddressMode,
+	addressGroups usertypes.AddressGroups,
 	identityState *useridentity.State,
 	serverManager ServerManager,
 	observabilitySender observability.Sender,
@@ -113,6 +115,7 @@ func NewService(
 		subscription: userevents.NewEventSubscriber(subscriberName),
 
 		addressMode:   mode,
+		addressGroups: addressGroups,
 		serverManager: serverManager,
 
 		imapSessionCountProvider: imapSessionCountProvider,
@@ -138,6 +141,12 @@ func (s *Service) SetAddressMode(ctx context.Context, mode usertypes.AddressMode
 	return err
 }
 
+func (s *Service) SetAddressGroups(ctx context.Context, groups usertypes.AddressGroups) error {
+	_, err := s.cpc.Send(ctx, &setAddressGroupsReq{groups: groups})
+
+	return err
+}
+
 func (s *Service) Resync(ctx context.Context) error {
 	_, err := s.cpc.Send(ctx, &resyncReq{})
 
@@ -150,8 +159,9 @@ func (s *Service) OnLogout(ctx context.Context) error {
 	return err
 }
 
-func (s *Service) checkAuth(ctx context.Context, email string, password []byte) (string, error) {
-	return cpc.SendTyped[string](ctx, s.cpc, &checkAuthReq{
+// checkAuth returns the address ID the client authenticated as and the ID of the app password it used, if any.
+func (s *Service) checkAuth(ctx context.Context, email string, password []byte) (checkAuthRes, error) {
+	return cpc.SendTyped[checkAuthRes](ctx, s.cpc, &checkAuthReq{
 		email:    email,
 		password: password,
 	})
@@ -199,15 +209,23 @@ func (s *Service) HandleUserEvent(_ context.Context, user *proton.User) error {
 	return nil
 }
 
+func (s *Service) HandleUsedSpaceEvent(_ context.Context, newSpace int64) error {
+	s.log.Debug("Handling used space event")
+	s.identityState.OnUserSpaceChanged(uint64(newSpace)) //nolint:gosec // disable G115
+
+	return nil
+}
+
 func (s *Service) run(ctx context.Context) {
 	s.log.Info("Starting service main loop")
 	defer s.log.Info("Exiting service main loop")
 	defer s.cpc.Close()
 
 	eventHandler := userevents.EventHandler{
-		AddressHandler: s,
-		RefreshHandler: s,
-		UserHandler:    s,
+		AddressHandler:   s,
+		RefreshHandler:   s,
+		UserHandler:      s,
+		UsedSpaceHandler: s,
 	}
 
 	s.eventService.Subscribe(s.subscription)
@@ -233,10 +251,15 @@ func (s *Service) run(ctx context.Context) {
 				s.addressMode = r.mode
 				request.Reply(ctx, nil, nil)
 
+			case *setAddressGroupsReq:
+				s.log.Debugf("Set address groups %v", len(r.groups))
+				s.addressGroups = r.groups
+				request.Reply(ctx, nil, nil)
+
 			case *checkAuthReq:
 				s.log.WithField("email", bridgelogging.Sensitive(r.email)).Debug("Checking authentication")
-				addrID, err := s.identityState.CheckAuth(r.email, r.password, s.bridgePassProvider)
-				request.Reply(ctx, addrID, err)
+				addrID, appPasswordID, _, err := s.identityState.CheckAuth(r.email, r.password, useridentity.ProtocolSMTP, s.bridgePassProvider)
+				request.Reply(ctx, checkAuthRes{addrID: addrID, appPasswordID: appPasswordID}, err)
 
 			case *resyncReq:
 				err := s.identityState.OnRefreshEvent(ctx)
@@ -313,11 +336,20 @@ type setAddressModeReq struct {
 	mode usertypes.AddressMode
 }
 
+type setAddressGroupsReq struct {
+	groups usertypes.AddressGroups
+}
+
 type checkAuthReq struct {
 	email    string
 	password []byte
 }
 
+type checkAuthRes struct {
+	addrID        string
+	appPasswordID string
+}
+
 type resyncReq struct{}
 
 type onLogoutReq struct{}
@@ -64,6 +64,11 @@ func (s *Service) smtpSendMail(ctx context.Context, authID string, from string,
 		return fmt.Errorf("failed to read message: %w", err)
 	}
 
+	// The sent message is stored in the account, so it must fit in the space left.
+	if user := s.identityState.User; user.MaxSpace > 0 && user.UsedSpace+uint64(len(b)) > user.MaxSpace {
+		return ErrOverQuota
+	}
+
 	// If running a QA build, dump to disk.
 	if err := debugDumpToDisk(b); err != nil {
 		s.log.WithError(err).Warn("Failed to dump message to disk")
@@ -108,6 +113,16 @@ func (s *Service) smtpSendMail(ctx context.Context, authID string, from string,
 		return &ErrCannotSendFromAddress{address: fromAddr.Email}
 	}
 
+	// In strict split mode, each mailbox is a separate identity: the session can only send as the addresses sharing the
+	// mailbox of the address it authenticated with. The Sent copy then only lands in that mailbox.
+	if s.addressMode == usertypes.AddressModeSplit && s.bridgePassProvider.StrictSplitMode() &&
+		s.addressGroups.Key(fromAddr.ID) != s.addressGroups.Key(authID) {
+		authAddr, _ := s.identityState.GetAddrByID(authID)
+		s.log.Errorf("Cannot send emails from address %v, the session is authenticated as %v", fromAddr.Email, authAddr.Email)
+		s.recorder.RemoveOnFail(hash, srID)
+		return NewErrSenderNotAuthenticated(fromAddr.Email, authAddr.Email)
+	}
+
 	// Load the user's mail settings.
 	settings, err := s.client.GetMailSettings(ctx)
 	if err != nil {
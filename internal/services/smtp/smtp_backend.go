@@ -46,8 +46,9 @@ type smtpSession struct {
 	accounts  *Accounts
 	userAgent identifier.UserAgentUpdater
 
-	userID string
-	authID string
+	userID        string
+	authID        string
+	appPasswordID string
 
 	from string
 	to   []string
@@ -58,7 +59,7 @@ func (be *Backend) NewSession(*smtp.Conn) (smtp.Session, error) {
 }
 
 func (s *smtpSession) AuthPlain(username, password string) error {
-	userID, authID, err := s.accounts.CheckAuth(username, []byte(password))
+	userID, authID, appPasswordID, err := s.accounts.CheckAuth(username, []byte(password))
 	if err != nil {
 		if !errors.Is(err, ErrNoSuchUser) {
 			return fmt.Errorf("unknown error")
@@ -73,6 +74,7 @@ func (s *smtpSession) AuthPlain(username, password string) error {
 
 	s.userID = userID
 	s.authID = authID
+	s.appPasswordID = appPasswordID
 
 	if strings.Contains(s.userAgent.GetUserAgent(), useragent.DefaultUserAgent) {
 		s.userAgent.SetUserAgent(useragent.UnknownClient, useragent.DefaultVersion)
@@ -81,6 +83,11 @@ func (s *smtpSession) AuthPlain(username, password string) error {
 	return nil
 }
 
+// AppPasswordID returns the ID of the app password the session authenticated with, empty for the bridge password.
+func (s *smtpSession) AppPasswordID() string {
+	return s.appPasswordID
+}
+
 func (s *smtpSession) Reset() {
 	s.from = ""
 	s.to = nil
@@ -41,7 +41,7 @@ type ApplyStage struct {
 }
 
 func NewApplyStage(input ApplyStageInput) *ApplyStage {
-	return &ApplyStage{input: input, log: logrus.WithField("sync-stage", "apply")}
+	return &ApplyStage{input: input, log: logrus.WithFields(logrus.Fields{"service": "sync", "sync-stage": "apply"})}
 }
 
 func (a *ApplyStage) Run(group *async.Group) {
@@ -68,7 +68,7 @@ func NewBuildStage(
 		input:               input,
 		output:              output,
 		maxBuildMem:         maxBuildMem,
-		log:                 logrus.WithField("sync-stage", "build"),
+		log:                 logrus.WithFields(logrus.Fields{"service": "sync", "sync-stage": "build"}),
 		panicHandler:        panicHandler,
 		observabilitySender: observabilitySender,
 	}
@@ -17,12 +17,63 @@
 
 package useridentity
 
+import "github.com/ProtonMail/proton-bridge/v3/internal/vault"
+
+// Protocol is the protocol over which a mail client authenticates.
+type Protocol int
+
+const (
+	ProtocolIMAP Protocol = iota
+	ProtocolSMTP
+)
+
+func (protocol Protocol) String() string {
+	switch protocol {
+	case ProtocolIMAP:
+		return "IMAP"
+
+	case ProtocolSMTP:
+		return "SMTP"
+
+	default:
+		return "unknown"
+	}
+}
+
+// isAllowedBy returns whether the given app password scope grants access over the protocol.
+func (protocol Protocol) isAllowedBy(scope vault.AppPasswordScope) bool {
+	switch protocol {
+	case ProtocolIMAP:
+		return scope.AllowsIMAP()
+
+	case ProtocolSMTP:
+		return scope.AllowsSMTP()
+
+	default:
+		return false
+	}
+}
+
 type KeyPassProvider interface {
 	KeyPass() []byte
 }
 
 type BridgePassProvider interface {
 	BridgePass() []byte
+
+	// UseAppPassword returns the app password matching the given raw token, if any, and records its use.
+	UseAppPassword(token []byte) (vault.AppPassword, bool, error)
+
+	// OAuthPasswordScope returns the scope of the OAuth access token the given password stands for and the ID of the
+	// authorization it was issued from, if it was issued to the user. Only the OAuth mechanisms authenticate with such
+	// passwords; the tokens themselves are not passwords.
+	OAuthPasswordScope(password []byte) (vault.AppPasswordScope, string, bool)
+
+	// ReadOnly returns whether the mail clients can only read the mailbox, whatever the password.
+	ReadOnly() bool
+
+	// StrictSplitMode returns whether, in split mode, an SMTP session can only send from the address it authenticated with.
+	StrictSplitMode() bool
 }
 
 type FixedBridgePassProvider struct {
@@ -33,6 +84,22 @@ func (f FixedBridgePassProvider) BridgePass() []byte {
 	return f.pass
 }
 
+func (f FixedBridgePassProvider) UseAppPassword([]byte) (vault.AppPassword, bool, error) {
+	return vault.AppPassword{}, false, nil
+}
+
+func (f FixedBridgePassProvider) OAuthPasswordScope([]byte) (vault.AppPasswordScope, string, bool) {
+	return "", "", false
+}
+
+func (f FixedBridgePassProvider) ReadOnly() bool {
+	return false
+}
+
+func (f FixedBridgePassProvider) StrictSplitMode() bool {
+	return false
+}
+
 func NewFixedBridgePassProvider(pass []byte) *FixedBridgePassProvider {
 	return &FixedBridgePassProvider{pass: pass}
 }
@@ -92,10 +92,11 @@ func (s *Service) GetAddresses(ctx context.Context) (map[string]proton.Address,
 	return cpc.SendTyped[map[string]proton.Address](ctx, s.cpc, &getAddressesReq{})
 }
 
-func (s *Service) CheckAuth(ctx context.Context, email string, password []byte) (string, error) {
+func (s *Service) CheckAuth(ctx context.Context, email string, password []byte, protocol Protocol) (string, error) {
 	return cpc.SendTyped[string](ctx, s.cpc, &checkAuthReq{
 		email:    email,
 		password: password,
+		protocol: protocol,
 	})
 }
 
@@ -246,7 +247,7 @@ func (s *Service) run(ctx context.Context) {
 				r.Reply(ctx, maps.Clone(s.identity.Addresses), nil)
 
 			case *checkAuthReq:
-				id, err := s.identity.CheckAuth(req.email, req.password, s.bridgePassProvider)
+				id, _, _, err := s.identity.CheckAuth(req.email, req.password, req.protocol, s.bridgePassProvider)
 				r.Reply(ctx, id, err)
 
 			default:
@@ -291,6 +292,7 @@ type getUserReq struct{}
 type checkAuthReq struct {
 	email    string
 	password []byte
+	protocol Protocol
 }
 
 type getAddressesReq struct{}
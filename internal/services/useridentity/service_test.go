@@ -26,6 +26,8 @@ import (
 	mocks2 "github.com/ProtonMail/proton-bridge/v3/internal/events/mocks"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/userevents"
 	"github.com/ProtonMail/proton-bridge/v3/internal/services/useridentity/mocks"
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/algo"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -356,6 +358,48 @@ func TestService_OnAddressDeletedUnknownDoesNotProduceEvent(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// testOAuthPassProvider accepts the "login-token" password of an OAuth access token with its scope next to the "hello"
+// bridge password.
+type testOAuthPassProvider struct {
+	FixedBridgePassProvider
+
+	scope vault.AppPasswordScope
+}
+
+func (p testOAuthPassProvider) OAuthPasswordScope(password []byte) (vault.AppPasswordScope, string, bool) {
+	return p.scope, "grantID", string(password) == "login-token"
+}
+
+func TestState_CheckAuthOAuthToken(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	state := NewState(*newTestUser(), newTestAddresses(), mocks.NewMockIdentityProvider(mockCtrl))
+
+	password := func(pass string) []byte {
+		return algo.B64RawEncode([]byte(pass))
+	}
+
+	provider := testOAuthPassProvider{FixedBridgePassProvider: FixedBridgePassProvider{pass: []byte("hello")}, scope: vault.AppPasswordScopeIMAPReadOnly}
+
+	// The login password of the token authenticates like an app password of its scope, as is.
+	addrID, appPasswordID, readOnly, err := state.CheckAuth("foo@bar.com", []byte("login-token"), ProtocolIMAP, provider)
+	require.NoError(t, err)
+	require.Equal(t, "Address1", addrID)
+	require.Equal(t, "grantID", appPasswordID)
+	require.True(t, readOnly)
+
+	_, _, _, err = state.CheckAuth("foo@bar.com", []byte("login-token"), ProtocolSMTP, provider)
+	require.Error(t, err)
+
+	// The password is not decoded to be checked.
+	_, _, _, err = state.CheckAuth("foo@bar.com", password("login-token"), ProtocolIMAP, provider)
+	require.Error(t, err)
+
+	// The bridge password is not scoped.
+	_, _, readOnly, err = state.CheckAuth("foo@bar.com", password("hello"), ProtocolSMTP, provider)
+	require.NoError(t, err)
+	require.False(t, readOnly)
+}
+
 func newTestService(_ *testing.T, mockCtrl *gomock.Controller) (*Service, *mocks2.MockEventPublisher, *mocks.MockIdentityProvider) {
 	subscribable := &userevents.NoOpSubscribable{}
 	eventPublisher := mocks2.NewMockEventPublisher(mockCtrl)
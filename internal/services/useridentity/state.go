@@ -27,6 +27,7 @@ import (
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
 	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/algo"
+	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
 )
 
@@ -224,20 +225,58 @@ func (s *State) Clone() *State {
 	}
 }
 
-// CheckAuth returns whether the given email and password can be used to authenticate over IMAP or SMTP with this user.
-// It returns the address ID of the authenticated address.
-func (s *State) CheckAuth(email string, password []byte, bridgePassProvider BridgePassProvider) (string, error) {
+// CheckAuth returns whether the given email and password can be used to authenticate over the given protocol with
+// this user. The password is either the bridge password, or an app password or the login password of an OAuth token
+// whose scope grants access over the protocol.
+// It returns the address ID of the authenticated address, the ID of the app password or of the authorization of the
+// OAuth token, empty for the bridge password, and whether the client can only read the mailbox. Read-only users can't
+// authenticate over SMTP.
+func (s *State) CheckAuth(
+	email string,
+	password []byte,
+	protocol Protocol,
+	bridgePassProvider BridgePassProvider,
+) (string, string, bool, error) {
 	if email == "crash@bandicoot" {
 		panic("your wish is my command.. I crash")
 	}
 
-	dec, err := algo.B64RawDecode(password)
-	if err != nil {
-		return "", fmt.Errorf("failed to decode password: %w", err)
+	readOnly := bridgePassProvider.ReadOnly()
+
+	// The OAuth mechanisms authenticate with a password standing for the token, scoped like an app password, and the
+	// sessions are bound to the authorization of the token like to an app password.
+	scope, appPasswordID, scoped := bridgePassProvider.OAuthPasswordScope(password)
+	if !scoped {
+		dec, err := algo.B64RawDecode(password)
+		if err != nil {
+			return "", "", false, fmt.Errorf("failed to decode password: %w", err)
+		}
+
+		// The bridge password is not scoped.
+		if subtle.ConstantTimeCompare(bridgePassProvider.BridgePass(), dec) != 1 {
+			appPassword, ok, err := bridgePassProvider.UseAppPassword(dec)
+			if err != nil {
+				logrus.WithError(err).Warn("Failed to record app password use")
+			}
+
+			if !ok {
+				return "", "", false, fmt.Errorf("invalid password")
+			}
+
+			appPasswordID, scope, scoped = appPassword.ID, appPassword.Scope, true
+		}
+	}
+
+	if scoped {
+		if !protocol.isAllowedBy(scope) {
+			return "", "", false, fmt.Errorf("password scope not allowed over %v", protocol)
+		}
+
+		readOnly = readOnly || scope.IsReadOnly()
 	}
 
-	if subtle.ConstantTimeCompare(bridgePassProvider.BridgePass(), dec) != 1 {
-		return "", fmt.Errorf("invalid password")
+	if readOnly && protocol == ProtocolSMTP {
+		return "", "", false, fmt.Errorf("read-only user not allowed over %v", protocol)
 	}
 
 	for _, addr := range s.AddressesSorted {
@@ -246,11 +285,11 @@ func (s *State) CheckAuth(email string, password []byte, bridgePassProvider Brid
 		}
 
 		if strings.EqualFold(addr.Email, email) {
-			return addr.ID, nil
+			return addr.ID, appPasswordID, readOnly, nil
 		}
 	}
 
-	return "", fmt.Errorf("invalid email")
+	return "", "", false, fmt.Errorf("invalid email")
 }
 
 func (s *State) WithAddrKR(addrID string, keyPass []byte, fn func(userKR, addrKR *crypto.KeyRing) error) error {
@@ -24,6 +24,7 @@ import (
 	"path/filepath"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
 	"github.com/ProtonMail/proton-bridge/v3/internal/versioner"
 	"github.com/ProtonMail/proton-bridge/v3/pkg/tar"
 	"github.com/pkg/errors"
@@ -62,6 +63,11 @@ func (i *InstallerDarwin) InstallUpdate(_ *semver.Version, r io.Reader) error {
 	return syncFolders(oldBundle, newBundle)
 }
 
+// VerifyInstalled does nothing: the update package replaced the app bundle, whose signature is checked by the system.
+func (i *InstallerDarwin) VerifyInstalled(_ *semver.Version, _ *crypto.KeyRing) error {
+	return nil
+}
+
 func (i *InstallerDarwin) IsAlreadyInstalled(_ *semver.Version) bool {
 	return false
 }
@@ -21,11 +21,14 @@
 package updater
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
 	"github.com/ProtonMail/proton-bridge/v3/internal/versioner"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
 )
 
 type InstallerDefault struct {
@@ -42,6 +45,29 @@ func (i *InstallerDefault) InstallUpdate(version *semver.Version, r io.Reader) e
 	return i.versioner.InstallNewVersion(version, r)
 }
 
+// VerifyInstalled verifies the files of an installed version, which is removed if they can't be verified.
+func (i *InstallerDefault) VerifyInstalled(version *semver.Version, kr *crypto.KeyRing) error {
+	versions, err := i.versioner.ListVersions()
+	if err != nil {
+		return err
+	}
+
+	idx := slices.IndexFunc(versions, func(v *versioner.Version) bool { return v.Equal(version) })
+	if idx < 0 {
+		return fmt.Errorf("version %v is not installed", version)
+	}
+
+	if err := versions[idx].VerifyFiles(kr); err != nil {
+		if rmErr := versions[idx].Remove(); rmErr != nil {
+			logrus.WithField("version", version).WithError(rmErr).Error("Failed to remove unverified version")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
 func (i *InstallerDefault) IsAlreadyInstalled(version *semver.Version) bool {
 	versions, err := i.versioner.ListVersions()
 	if err != nil {
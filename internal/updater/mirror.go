@@ -0,0 +1,56 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package updater
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+)
+
+// mirrorDownloader downloads the files of the update host from a mirror with the same layout.
+type mirrorDownloader struct {
+	downloader Downloader
+	mirror     string
+}
+
+// NewMirrorDownloader returns a downloader fetching from the given mirror the files of the update host,
+// such as the version file and the update packages. The given downloader is returned as is if there is no mirror.
+func NewMirrorDownloader(downloader Downloader, mirror string) Downloader {
+	if mirror == "" {
+		return downloader
+	}
+
+	return &mirrorDownloader{
+		downloader: downloader,
+		mirror:     strings.TrimSuffix(mirror, "/"),
+	}
+}
+
+func (d *mirrorDownloader) DownloadAndVerify(ctx context.Context, kr *crypto.KeyRing, url, sig string) ([]byte, error) {
+	return d.downloader.DownloadAndVerify(ctx, kr, d.getMirrorURL(url), d.getMirrorURL(sig))
+}
+
+func (d *mirrorDownloader) getMirrorURL(url string) string {
+	if path, ok := strings.CutPrefix(url, Host); ok {
+		return d.mirror + path
+	}
+
+	return url
+}
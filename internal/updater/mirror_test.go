@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package updater
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+type testDownloader struct {
+	urls []string
+}
+
+func (d *testDownloader) DownloadAndVerify(_ context.Context, _ *crypto.KeyRing, url, sig string) ([]byte, error) {
+	d.urls = append(d.urls, url, sig)
+
+	return nil, nil
+}
+
+func TestMirrorDownloader(t *testing.T) {
+	downloader := &testDownloader{}
+
+	// Without mirror, the downloader is used as is.
+	require.Equal(t, downloader, NewMirrorDownloader(downloader, ""))
+
+	mirror := NewMirrorDownloader(downloader, "https://mirror.example.com/download/")
+
+	_, err := mirror.DownloadAndVerify(context.Background(), nil, Host+"/bridge/version.json", Host+"/bridge/version.json.sig")
+	require.NoError(t, err)
+
+	// The files of other hosts are not mirrored.
+	_, err = mirror.DownloadAndVerify(context.Background(), nil, "https://other.example.com/file", "https://other.example.com/file.sig")
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"https://mirror.example.com/download/bridge/version.json",
+		"https://mirror.example.com/download/bridge/version.json.sig",
+		"https://other.example.com/file",
+		"https://other.example.com/file.sig",
+	}, downloader.urls)
+}
@@ -102,3 +102,17 @@ func (mr *MockInstallerMockRecorder) IsAlreadyInstalled(arg0 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAlreadyInstalled", reflect.TypeOf((*MockInstaller)(nil).IsAlreadyInstalled), arg0)
 }
+
+// VerifyInstalled mocks base method.
+func (m *MockInstaller) VerifyInstalled(arg0 *semver.Version, arg1 *crypto.KeyRing) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyInstalled", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyInstalled indicates an expected call of VerifyInstalled.
+func (mr *MockInstallerMockRecorder) VerifyInstalled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyInstalled", reflect.TypeOf((*MockInstaller)(nil).VerifyInstalled), arg0, arg1)
+}
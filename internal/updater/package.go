@@ -0,0 +1,121 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package updater
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"debug/buildinfo"
+	"debug/macho"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/pkg/errors"
+)
+
+var ErrPackageVersion = errors.New("the version of the update package could not be determined")
+
+// ldflagsVersionRegexp matches the version the executables are built with, set by the makefile with
+// -X github.com/ProtonMail/proton-bridge/v3/internal/constants.Version=<version>.
+var ldflagsVersionRegexp = regexp.MustCompile(`proton-bridge/v3/internal/constants\.Version=([^\s"]+)`) //nolint:gochecknoglobals
+
+// GetPackageVersion returns the version of an update package, read from the build information of its executables,
+// which must all have the same version. Once the package signature is verified, the version is thus signed as well.
+func GetPackageVersion(pkg []byte) (*semver.Version, error) {
+	gr, err := gzip.NewReader(bytes.NewReader(pkg))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrPackageVersion, err)
+	}
+
+	var version *semver.Version
+
+	tr := tar.NewReader(gr)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrPackageVersion, err)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrPackageVersion, err)
+		}
+
+		exeVersion, ok := getExecutableVersion(b)
+		if !ok {
+			continue
+		}
+
+		if version != nil && !version.Equal(exeVersion) {
+			return nil, fmt.Errorf("%w: %v has version %v rather than %v", ErrPackageVersion, header.Name, exeVersion, version)
+		}
+
+		version = exeVersion
+	}
+
+	if version == nil {
+		return nil, fmt.Errorf("%w: it has no executable built with a version", ErrPackageVersion)
+	}
+
+	return version, nil
+}
+
+// getExecutableVersion returns the version a Go executable is built with, if it is one.
+func getExecutableVersion(b []byte) (*semver.Version, bool) {
+	var r io.ReaderAt = bytes.NewReader(b)
+
+	// The macOS executables are universal binaries, which hold the same executable for several architectures.
+	if fat, err := macho.NewFatFile(r); err == nil && len(fat.Arches) > 0 {
+		r = io.NewSectionReader(r, int64(fat.Arches[0].Offset), int64(fat.Arches[0].Size))
+	}
+
+	info, err := buildinfo.Read(r)
+	if err != nil {
+		return nil, false
+	}
+
+	for _, setting := range info.Settings {
+		if setting.Key != "-ldflags" {
+			continue
+		}
+
+		match := ldflagsVersionRegexp.FindStringSubmatch(setting.Value)
+		if match == nil {
+			return nil, false
+		}
+
+		version, err := semver.NewVersion(match[1])
+		if err != nil {
+			return nil, false
+		}
+
+		return version, true
+	}
+
+	return nil, false
+}
@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
@@ -40,6 +41,8 @@ var (
 	ErrUpdateAlreadyInstalled      = errors.New("update is already installed")
 	ErrVersionFileDownloadOrVerify = errors.New("failed to download or verify the version file")
 	ErrReleaseUpdatePackageMissing = errors.New("release update package is missing")
+	ErrPackageVerify               = errors.New("failed to verify the update package")
+	ErrNoRollbackVersion           = errors.New("there is no previous version to roll back to")
 )
 
 type Downloader interface {
@@ -49,6 +52,7 @@ type Downloader interface {
 type Installer interface {
 	IsAlreadyInstalled(*semver.Version) bool
 	InstallUpdate(*semver.Version, io.Reader) error
+	VerifyInstalled(*semver.Version, *crypto.KeyRing) error
 }
 
 type Updater struct {
@@ -176,6 +180,105 @@ func (u *Updater) InstallUpdate(ctx context.Context, downloader Downloader, rele
 	return nil
 }
 
+// InstallUpdateFromFile installs an update package supplied locally rather than downloaded, for machines which
+// cannot reach the update host. The package must be signed like the downloaded ones, and so must be its files.
+// Its version is read from the signed package, and checked with canInstall before installing it.
+func (u *Updater) InstallUpdateFromFile(pkg, sig []byte, canInstall func(*semver.Version) error) (*semver.Version, error) {
+	if err := u.verifier.VerifyDetached(
+		crypto.NewPlainMessage(pkg),
+		crypto.NewPGPSignature(sig),
+		crypto.GetUnixTime(),
+	); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrPackageVerify, err)
+	}
+
+	version, err := GetPackageVersion(pkg)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrPackageVerify, err)
+	}
+
+	if err := canInstall(version); err != nil {
+		return version, err
+	}
+
+	if u.installer.IsAlreadyInstalled(version) {
+		return version, ErrUpdateAlreadyInstalled
+	}
+
+	if err := u.installer.InstallUpdate(version, bytes.NewReader(pkg)); err != nil {
+		logrus.WithError(err).Error("Failed to install update")
+		return version, ErrInstall
+	}
+
+	if err := u.installer.VerifyInstalled(version, u.verifier); err != nil {
+		return version, fmt.Errorf("%w: %w", ErrPackageVerify, err)
+	}
+
+	return version, nil
+}
+
+// GetPinnedVersion returns the version the app is pinned to, or nil if it isn't.
+func (u *Updater) GetPinnedVersion() (*semver.Version, error) {
+	return u.versioner.GetPinnedVersion()
+}
+
+// SetPinnedVersion pins the app to the given version, or removes the pin if nil. The launcher doesn't start
+// newer versions, which are not installed either.
+func (u *Updater) SetPinnedVersion(version *semver.Version) error {
+	return u.versioner.SetPinnedVersion(version)
+}
+
+// GetRollbackCrashes returns the number of consecutive startup crashes after which the app rolls back, 0 meaning never.
+func (u *Updater) GetRollbackCrashes() (int, error) {
+	return u.versioner.GetRollbackCrashes()
+}
+
+// SetRollbackCrashes sets the number of consecutive startup crashes after which the app rolls back, 0 meaning never.
+func (u *Updater) SetRollbackCrashes(crashes int) error {
+	return u.versioner.SetRollbackCrashes(crashes)
+}
+
+// IsVersionAllowed returns whether the given version may be installed, i.e. it is not bad nor newer than the pin.
+// No version is allowed if the state of the versions can't be read.
+func (u *Updater) IsVersionAllowed(version *semver.Version) bool {
+	allowed, err := u.versioner.IsAllowed(version)
+	if err != nil {
+		logrus.WithError(err).WithField("version", version).Error("Failed to check whether the version is allowed")
+		return false
+	}
+
+	return allowed
+}
+
+// Rollback marks the given running version as bad, so that the launcher starts the previous version instead
+// when restarted. This is the base installation if the running version is an update, otherwise an older update.
+func (u *Updater) Rollback(current *semver.Version) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	if !u.versioner.Contains(exe) && !u.hasPreviousVersion(current) {
+		return ErrNoRollbackVersion
+	}
+
+	return u.versioner.MarkBad(current)
+}
+
+// hasPreviousVersion returns whether an allowed and verified version older than the given one is installed.
+func (u *Updater) hasPreviousVersion(current *semver.Version) bool {
+	versions, err := u.versioner.ListVersions()
+	if err != nil {
+		return false
+	}
+
+	return slices.ContainsFunc(versions, func(version *versioner.Version) bool {
+		return version.SemVer().LessThan(current) &&
+			u.IsVersionAllowed(version.SemVer()) &&
+			version.VerifyFiles(u.verifier) == nil
+	})
+}
+
 func (u *Updater) RemoveOldUpdates() error {
 	return u.versioner.RemoveOldVersions()
 }
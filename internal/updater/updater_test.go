@@ -0,0 +1,150 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package updater
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/internal/updater/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdater_InstallUpdateFromFile(t *testing.T) {
+	ctl := gomock.NewController(t)
+	installer := mocks.NewMockInstaller(ctl)
+
+	key, err := crypto.GenerateKey("test", "test@example.com", "x25519", 0)
+	require.NoError(t, err)
+
+	kr, err := crypto.NewKeyRing(key)
+	require.NoError(t, err)
+
+	u := &Updater{installer: installer, verifier: kr}
+
+	version := semver.MustParse("3.15.0")
+	pkg := newTestPackage(t, "3.15.0")
+
+	sig, err := kr.SignDetached(crypto.NewPlainMessage(pkg))
+	require.NoError(t, err)
+
+	otherSig, err := kr.SignDetached(crypto.NewPlainMessage([]byte("other package")))
+	require.NoError(t, err)
+
+	canInstall := func(*semver.Version) error { return nil }
+
+	// A package with a wrong signature is not installed.
+	_, err = u.InstallUpdateFromFile(pkg, otherSig.GetBinary(), canInstall)
+	require.ErrorIs(t, err, ErrPackageVerify)
+
+	// Nor is a package whose files can't be verified once unpacked.
+	installer.EXPECT().IsAlreadyInstalled(version).Return(false)
+	installer.EXPECT().InstallUpdate(version, gomock.Any()).Return(nil)
+	installer.EXPECT().VerifyInstalled(version, kr).Return(errors.New("sum mismatch"))
+	_, err = u.InstallUpdateFromFile(pkg, sig.GetBinary(), canInstall)
+	require.ErrorIs(t, err, ErrPackageVerify)
+
+	// Nor is a package refused by the caller, which gets the version of the package.
+	errRefused := errors.New("refused")
+
+	_, err = u.InstallUpdateFromFile(pkg, sig.GetBinary(), func(got *semver.Version) error {
+		require.Equal(t, version, got)
+		return errRefused
+	})
+	require.ErrorIs(t, err, errRefused)
+
+	installer.EXPECT().IsAlreadyInstalled(version).Return(false)
+	installer.EXPECT().InstallUpdate(version, gomock.Any()).Return(nil)
+	installer.EXPECT().VerifyInstalled(version, kr).Return(nil)
+	got, err := u.InstallUpdateFromFile(pkg, sig.GetBinary(), canInstall)
+	require.NoError(t, err)
+	require.Equal(t, version, got)
+
+	installer.EXPECT().IsAlreadyInstalled(version).Return(true)
+	_, err = u.InstallUpdateFromFile(pkg, sig.GetBinary(), canInstall)
+	require.ErrorIs(t, err, ErrUpdateAlreadyInstalled)
+}
+
+func TestGetPackageVersion(t *testing.T) {
+	version, err := GetPackageVersion(newTestPackage(t, "3.16.1-beta.2"))
+	require.NoError(t, err)
+	require.Equal(t, "3.16.1-beta.2", version.Original())
+
+	// The executables of a package must have the same version.
+	_, err = GetPackageVersion(newTestPackage(t, "3.15.0", "3.16.0"))
+	require.ErrorIs(t, err, ErrPackageVersion)
+
+	// A package without executables has no version.
+	_, err = GetPackageVersion(newTestPackage(t))
+	require.ErrorIs(t, err, ErrPackageVersion)
+
+	_, err = GetPackageVersion([]byte("bridge_3.15.0_linux.tgz"))
+	require.ErrorIs(t, err, ErrPackageVersion)
+}
+
+// newTestPackage returns an update package holding a license and an executable built with each of the given versions.
+func newTestPackage(t *testing.T, versions ...string) []byte {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/app\n\ngo 1.24\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0o600))
+
+	var buf bytes.Buffer
+
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+
+	addFile := func(name string, b []byte) {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o700, Size: int64(len(b)), Typeflag: tar.TypeReg}))
+
+		_, err := tw.Write(b)
+		require.NoError(t, err)
+	}
+
+	addFile("LICENSE", []byte("license"))
+
+	for idx, version := range versions {
+		exe := filepath.Join(dir, fmt.Sprintf("app%v", idx))
+
+		cmd := exec.Command("go", "build", "-o", exe, "-ldflags", "-X github.com/ProtonMail/proton-bridge/v3/internal/constants.Version="+version, ".") //nolint:gosec
+		cmd.Dir = dir
+
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+
+		b, err := os.ReadFile(exe) //nolint:gosec
+		require.NoError(t, err)
+
+		addFile(filepath.Base(exe), b)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+
+	return buf.Bytes()
+}
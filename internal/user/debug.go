@@ -36,6 +36,7 @@ import (
 	"github.com/ProtonMail/gopenpgp/v2/constants"
 	"github.com/ProtonMail/gopenpgp/v2/crypto"
 	imapservice "github.com/ProtonMail/proton-bridge/v3/internal/services/imapservice"
+	"github.com/ProtonMail/proton-bridge/v3/internal/services/syncservice"
 	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	bmessage "github.com/ProtonMail/proton-bridge/v3/pkg/message"
@@ -168,6 +169,16 @@ func (user *User) GetDiagnosticMetadata(ctx context.Context) (DiagnosticMetadata
 	}, nil
 }
 
+// GetSyncStatus returns the current state of the user's IMAP sync.
+func (user *User) GetSyncStatus(ctx context.Context) (syncservice.Status, error) {
+	return user.imapService.GetSyncStatus(ctx)
+}
+
+// RepairMessages fixes the IMAP state of the given messages without resyncing the whole account.
+func (user *User) RepairMessages(ctx context.Context, req imapservice.RepairMessagesReq) (imapservice.RepairMessagesResult, error) {
+	return user.imapService.RepairMessages(ctx, req)
+}
+
 func (user *User) DebugDownloadMessages(
 	ctx context.Context,
 	path string,
@@ -22,4 +22,10 @@ import "errors"
 var (
 	ErrNoSuchAddress  = errors.New("no such address")
 	ErrMissingAddrKey = errors.New("missing address key")
+
+	ErrInvalidImportMailbox = errors.New("invalid import mailbox, must be a system mailbox, Folders/<path> or Labels/<name>")
+
+	ErrInvalidExportFormat  = errors.New("invalid export format, must be maildir or mbox")
+	ErrExportFormatMismatch = errors.New("the destination holds an export in another format")
+	ErrExportInProgress     = errors.New("an export is already in progress")
 )
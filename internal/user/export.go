@@ -0,0 +1,674 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/internal/localmail"
+	imapservice "github.com/ProtonMail/proton-bridge/v3/internal/services/imapservice"
+	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
+	bmessage "github.com/ProtonMail/proton-bridge/v3/pkg/message"
+	"github.com/bradenaw/juniper/parallel"
+	"github.com/bradenaw/juniper/xslices"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// exportBatchSize is the number of messages downloaded before they are written and recorded in the export state.
+	exportBatchSize = 100
+
+	// exportBatchMaxSize bounds the memory used by the messages downloaded before being written.
+	exportBatchMaxSize = 64 * 1024 * 1024
+
+	// exportWorkers is the number of messages downloaded in parallel.
+	exportWorkers = 4
+
+	// exportStateName is the name of the file, in the destination, recording the messages already exported.
+	exportStateName = ".proton-bridge-export.json"
+)
+
+// ExportFormat is the format messages are exported in.
+type ExportFormat string
+
+const (
+	// ExportFormatMaildir exports each mailbox into a Maildir directory, e.g. `Folders/Work/cur/<message>`.
+	ExportFormatMaildir ExportFormat = "maildir"
+
+	// ExportFormatMbox exports each mailbox into an mbox file, e.g. `Folders/Work.mbox`.
+	ExportFormatMbox ExportFormat = "mbox"
+)
+
+// IsValid returns whether the format is one of the known formats.
+func (format ExportFormat) IsValid() bool {
+	return format == ExportFormatMaildir || format == ExportFormatMbox
+}
+
+// ExportOptions are the options of an export of the messages into a local folder.
+type ExportOptions struct {
+	Format ExportFormat
+
+	// Dest is the folder the mailboxes are exported into, which is created if needed.
+	Dest string
+}
+
+// ExportProgress is the progress of an export.
+type ExportProgress struct {
+	// Total is the number of messages of the account.
+	Total    int
+	Exported int
+	Skipped  int
+	Failed   int
+}
+
+// ExportFailure is a message which could not be exported.
+type ExportFailure struct {
+	MessageID string
+	Subject   string
+	Error     string
+}
+
+// ExportReport is the result of an export.
+type ExportReport struct {
+	// Exported is the number of messages exported, Skipped the number of those exported before.
+	Exported int
+	Skipped  int
+
+	Failures []ExportFailure
+}
+
+// Export exports the decrypted messages of the account into the destination folder, one Maildir directory or mbox file
+// per mailbox, named as in the mail clients, except All Mail. The export state recorded in the destination lists
+// the messages already exported, which are skipped, so that each export only adds the new messages. The flags of
+// the messages exported into Maildir directories are kept up to date; those of mbox files are set once.
+// The messages which cannot be exported are reported without stopping the export, and exported by the next one.
+func (user *User) Export(ctx context.Context, opts ExportOptions, progressCB func(ExportProgress)) (ExportReport, error) {
+	if !opts.Format.IsValid() {
+		return ExportReport{}, ErrInvalidExportFormat
+	}
+
+	if !user.exportLock.TryLock() {
+		return ExportReport{}, ErrExportInProgress
+	}
+	defer user.exportLock.Unlock()
+
+	if err := os.MkdirAll(opts.Dest, 0o700); err != nil {
+		return ExportReport{}, fmt.Errorf("failed to create export folder: %w", err)
+	}
+
+	state, err := loadExportState(opts.Dest, opts.Format)
+	if err != nil {
+		return ExportReport{}, err
+	}
+
+	mailboxes, err := user.getExportMailboxes(ctx)
+	if err != nil {
+		return ExportReport{}, err
+	}
+
+	metadata, err := user.getAllMessageMetadata(ctx)
+	if err != nil {
+		return ExportReport{}, err
+	}
+
+	apiUser, err := user.identityService.GetAPIUser(ctx)
+	if err != nil {
+		return ExportReport{}, fmt.Errorf("failed to get api user: %w", err)
+	}
+
+	apiAddrs, err := user.identityService.GetAddresses(ctx)
+	if err != nil {
+		return ExportReport{}, fmt.Errorf("failed to get addresses: %w", err)
+	}
+
+	user.log.WithFields(logrus.Fields{
+		"format": opts.Format,
+		"dest":   opts.Dest,
+	}).Info("Exporting messages")
+
+	exp := &exporter{
+		client:       user.client,
+		log:          user.log,
+		panicHandler: user.panicHandler,
+		dest:         opts.Dest,
+		state:        state,
+		mailboxes:    mailboxes,
+		total:        len(metadata),
+		progressCB:   progressCB,
+	}
+
+	if err := usertypes.WithAddrKRs(apiUser, apiAddrs, user.vault.KeyPass(), func(_ *crypto.KeyRing, addrKRs map[string]*crypto.KeyRing) error {
+		exp.addrKRs = addrKRs
+
+		// The mbox files are truncated to the size recorded in the state, to drop the messages of an interrupted export.
+		if err := exp.truncateMboxes(); err != nil {
+			return err
+		}
+
+		for _, meta := range metadata {
+			if err := exp.add(ctx, meta); err != nil {
+				return err
+			}
+		}
+
+		if err := exp.flush(ctx); err != nil {
+			return err
+		}
+
+		// The state also records the flags updated in Maildir directories.
+		return exp.state.save()
+	}); err != nil {
+		return exp.report, err
+	}
+
+	exp.progress()
+
+	user.log.WithFields(logrus.Fields{
+		"exported": exp.report.Exported,
+		"skipped":  exp.report.Skipped,
+		"failed":   len(exp.report.Failures),
+	}).Info("Export finished")
+
+	return exp.report, nil
+}
+
+// getExportMailboxes returns the path of the mailbox of each label, as named in the mail clients, except All Mail.
+func (user *User) getExportMailboxes(ctx context.Context) (map[string][]string, error) {
+	labels, err := user.client.GetLabels(ctx, proton.LabelTypeSystem, proton.LabelTypeFolder, proton.LabelTypeLabel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get labels: %w", err)
+	}
+
+	mailboxes := make(map[string][]string, len(labels))
+
+	for _, label := range labels {
+		if !imapservice.WantLabel(label) || label.ID == proton.AllMailLabel {
+			continue
+		}
+
+		mailboxes[label.ID] = imapservice.GetMailboxName(label)
+	}
+
+	return mailboxes, nil
+}
+
+// getAllMessageMetadata returns the metadata of all the messages of the account, from the oldest to the newest.
+func (user *User) getAllMessageMetadata(ctx context.Context) ([]proton.MessageMetadata, error) {
+	messageIDs, err := user.client.GetAllMessageIDs(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message IDs: %w", err)
+	}
+
+	metadata := make([]proton.MessageMetadata, 0, len(messageIDs))
+
+	for _, chunk := range xslices.Chunk(messageIDs, 100) {
+		page, err := user.client.GetMessageMetadataPage(ctx, 0, len(chunk), proton.MessageFilter{ID: chunk})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get message metadata: %w", err)
+		}
+
+		metadata = append(metadata, page...)
+	}
+
+	sort.SliceStable(metadata, func(i, j int) bool {
+		return metadata[i].Time < metadata[j].Time
+	})
+
+	return metadata, nil
+}
+
+type exporter struct {
+	client       *proton.Client
+	log          *logrus.Entry
+	panicHandler async.PanicHandler
+	addrKRs      map[string]*crypto.KeyRing
+
+	dest      string
+	state     *exportState
+	mailboxes map[string][]string
+
+	pending     []pendingExport
+	pendingSize int
+	total       int
+	report      ExportReport
+	progressCB  func(ExportProgress)
+}
+
+// pendingExport is a message to download and write into the given mailboxes.
+type pendingExport struct {
+	meta      proton.MessageMetadata
+	mailboxes []string
+}
+
+// add queues the message for its mailboxes it was not exported into, and updates its flags in the others.
+func (exp *exporter) add(ctx context.Context, meta proton.MessageMetadata) error {
+	var mailboxes []string
+
+	for _, labelID := range meta.LabelIDs {
+		path, ok := exp.mailboxes[labelID]
+		if !ok {
+			continue
+		}
+
+		name := strings.Join(path, "/")
+
+		mailbox, ok := exp.state.Mailboxes[name]
+		if !ok {
+			mailboxes = append(mailboxes, name)
+			continue
+		}
+
+		fileName, ok := mailbox.Messages[meta.ID]
+		if !ok {
+			mailboxes = append(mailboxes, name)
+			continue
+		}
+
+		if exp.state.Format != ExportFormatMaildir {
+			continue
+		}
+
+		newFileName, err := localmail.SetMaildirFlags(exp.getMailboxPath(path), fileName, newExportMessage(meta))
+		if err != nil {
+			// The file was removed or renamed by another program, it is not exported again.
+			exp.log.WithError(err).WithField("messageID", meta.ID).Warn("Failed to update the flags of an exported message")
+			continue
+		}
+
+		mailbox.Messages[meta.ID] = newFileName
+	}
+
+	if len(mailboxes) == 0 {
+		exp.report.Skipped++
+
+		if exp.report.Skipped%exportBatchSize == 0 {
+			exp.progress()
+		}
+
+		return nil
+	}
+
+	exp.pending = append(exp.pending, pendingExport{meta: meta, mailboxes: mailboxes})
+	exp.pendingSize += meta.Size
+
+	if len(exp.pending) < exportBatchSize && exp.pendingSize < exportBatchMaxSize {
+		return nil
+	}
+
+	return exp.flush(ctx)
+}
+
+// flush downloads the pending messages, writes them into their mailboxes and records them in the export state.
+func (exp *exporter) flush(ctx context.Context) error {
+	if len(exp.pending) == 0 {
+		return nil
+	}
+
+	literals, errs := make([][]byte, len(exp.pending)), make([]error, len(exp.pending))
+
+	if err := parallel.DoContext(ctx, exportWorkers, len(exp.pending), func(ctx context.Context, idx int) error {
+		literals[idx], errs[idx] = exp.download(ctx, exp.pending[idx].meta)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// The messages whose download was interrupted are exported by the next export.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// The new mailboxes are recorded before writing into them, so that their mbox files can be truncated to their
+	// current size if the export is interrupted.
+	if err := exp.addMailboxes(); err != nil {
+		return err
+	}
+
+	writer := newExportWriter(exp.dest, exp.state)
+	defer writer.close()
+
+	for idx, pending := range exp.pending {
+		if errs[idx] != nil {
+			exp.fail(pending.meta, errs[idx])
+			continue
+		}
+
+		msg := newExportMessage(pending.meta)
+		msg.Literal = literals[idx]
+
+		for _, name := range pending.mailboxes {
+			if err := writer.write(exp.getMailboxPath(strings.Split(name, "/")), name, pending.meta.ID, msg); err != nil {
+				return fmt.Errorf("failed to write message: %w", err)
+			}
+		}
+
+		exp.report.Exported++
+	}
+
+	if err := writer.sync(); err != nil {
+		return fmt.Errorf("failed to write messages: %w", err)
+	}
+
+	if err := exp.state.save(); err != nil {
+		return err
+	}
+
+	exp.pending, exp.pendingSize = nil, 0
+	exp.progress()
+
+	return nil
+}
+
+// download returns the decrypted literal of the message.
+func (exp *exporter) download(ctx context.Context, meta proton.MessageMetadata) ([]byte, error) {
+	addrKR, ok := exp.addrKRs[meta.AddressID]
+	if !ok {
+		return nil, ErrMissingAddrKey
+	}
+
+	full, err := exp.client.GetFullMessage(ctx, meta.ID, usertypes.NewProtonAPIScheduler(exp.panicHandler), proton.NewDefaultAttachmentAllocator())
+	if err != nil {
+		return nil, fmt.Errorf("failed to download message: %w", err)
+	}
+
+	return bmessage.DecryptAndBuildRFC822(addrKR, full.Message, full.AttData, defaultMessageJobOpts())
+}
+
+// addMailboxes records the mailboxes of the pending messages which are not in the export state yet.
+func (exp *exporter) addMailboxes() error {
+	var added bool
+
+	for _, pending := range exp.pending {
+		for _, name := range pending.mailboxes {
+			if _, ok := exp.state.Mailboxes[name]; ok {
+				continue
+			}
+
+			mailbox := &exportedMailbox{Messages: make(map[string]string)}
+
+			if exp.state.Format == ExportFormatMbox {
+				info, err := os.Stat(exp.getMailboxPath(strings.Split(name, "/")))
+				if err != nil && !errors.Is(err, fs.ErrNotExist) {
+					return err
+				} else if err == nil {
+					mailbox.Size = info.Size()
+				}
+			}
+
+			exp.state.Mailboxes[name], added = mailbox, true
+		}
+	}
+
+	if !added {
+		return nil
+	}
+
+	return exp.state.save()
+}
+
+// truncateMboxes truncates the mbox files to the size recorded in the export state.
+func (exp *exporter) truncateMboxes() error {
+	if exp.state.Format != ExportFormatMbox {
+		return nil
+	}
+
+	for name, mailbox := range exp.state.Mailboxes {
+		mboxPath := exp.getMailboxPath(strings.Split(name, "/"))
+
+		info, err := os.Stat(mboxPath)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		// A file smaller than recorded was changed by another program, the new messages are appended to it.
+		if info.Size() < mailbox.Size {
+			mailbox.Size = info.Size()
+		} else if info.Size() > mailbox.Size {
+			if err := os.Truncate(mboxPath, mailbox.Size); err != nil {
+				return fmt.Errorf("failed to truncate mbox file: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getMailboxPath returns the path of the Maildir directory or mbox file of the mailbox with the given path.
+func (exp *exporter) getMailboxPath(path []string) string {
+	names := make([]string, 0, len(path)+1)
+	names = append(names, exp.dest)
+
+	for _, name := range path {
+		names = append(names, sanitizeExportName(name, exp.state.Format))
+	}
+
+	if exp.state.Format == ExportFormatMbox {
+		return filepath.Join(names...) + ".mbox"
+	}
+
+	return filepath.Join(names...)
+}
+
+func (exp *exporter) fail(meta proton.MessageMetadata, err error) {
+	exp.log.WithError(err).WithField("messageID", meta.ID).Warn("Failed to export message")
+
+	exp.report.Failures = append(exp.report.Failures, ExportFailure{MessageID: meta.ID, Subject: meta.Subject, Error: err.Error()})
+}
+
+func (exp *exporter) progress() {
+	if exp.progressCB == nil {
+		return
+	}
+
+	exp.progressCB(ExportProgress{
+		Total:    exp.total,
+		Exported: exp.report.Exported,
+		Skipped:  exp.report.Skipped,
+		Failed:   len(exp.report.Failures),
+	})
+}
+
+// newExportMessage returns the message to write for the given metadata, without its literal. Its ID is the unique
+// name of its Maildir file, made of its date and the hash of its ID.
+func newExportMessage(meta proton.MessageMetadata) localmail.Message {
+	hash := sha256.Sum256([]byte(meta.ID))
+
+	return localmail.Message{
+		ID:       fmt.Sprintf("%d.%s.proton-bridge", meta.Time, hex.EncodeToString(hash[:8])),
+		Seen:     meta.Seen(),
+		Answered: bool(meta.IsReplied) || bool(meta.IsRepliedAll),
+		Flagged:  meta.Starred(),
+		Draft:    meta.IsDraft(),
+		Date:     time.Unix(meta.Time, 0),
+	}
+}
+
+// sanitizeExportName returns the name of the file or directory of a mailbox, which must be a valid and visible file
+// name, and must not be mistaken for the subdirectories of a Maildir directory.
+func sanitizeExportName(name string, format ExportFormat) string {
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || strings.ContainsRune(`<>:"/\|?*`, r) {
+			return '_'
+		}
+
+		return r
+	}, strings.TrimRight(name, ". "))
+
+	if name == "" || strings.HasPrefix(name, ".") {
+		return "_" + name
+	}
+
+	if format == ExportFormatMaildir && (name == "cur" || name == "new" || name == "tmp") {
+		return "_" + name
+	}
+
+	return name
+}
+
+// exportWriter writes the messages into the Maildir directories or mbox files of their mailboxes, and records them in
+// the export state.
+type exportWriter struct {
+	dest  string
+	state *exportState
+	files map[string]*os.File
+}
+
+func newExportWriter(dest string, state *exportState) *exportWriter {
+	return &exportWriter{dest: dest, state: state, files: make(map[string]*os.File)}
+}
+
+func (writer *exportWriter) write(mailboxPath, name, messageID string, msg localmail.Message) error {
+	mailbox := writer.state.Mailboxes[name]
+
+	if writer.state.Format == ExportFormatMaildir {
+		fileName, err := localmail.WriteMaildir(mailboxPath, msg)
+		if err != nil {
+			return err
+		}
+
+		mailbox.Messages[messageID] = fileName
+
+		return nil
+	}
+
+	file, ok := writer.files[name]
+	if !ok {
+		if err := os.MkdirAll(filepath.Dir(mailboxPath), 0o700); err != nil {
+			return err
+		}
+
+		newFile, err := os.OpenFile(mailboxPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600) //nolint:gosec
+		if err != nil {
+			return err
+		}
+
+		writer.files[name], file = newFile, newFile
+	}
+
+	if err := localmail.WriteMbox(file, msg); err != nil {
+		return err
+	}
+
+	mailbox.Messages[messageID] = ""
+
+	return nil
+}
+
+// sync flushes the mbox files to disk and records their size in the export state.
+func (writer *exportWriter) sync() error {
+	for name, file := range writer.files {
+		if err := file.Sync(); err != nil {
+			return err
+		}
+
+		info, err := file.Stat()
+		if err != nil {
+			return err
+		}
+
+		writer.state.Mailboxes[name].Size = info.Size()
+	}
+
+	return nil
+}
+
+func (writer *exportWriter) close() {
+	for _, file := range writer.files {
+		_ = file.Close()
+	}
+}
+
+// exportState records the messages exported into a destination.
+type exportState struct {
+	path string
+
+	Format ExportFormat
+
+	// Mailboxes are the exported mailboxes, by name, e.g. `Folders/Work`.
+	Mailboxes map[string]*exportedMailbox
+}
+
+type exportedMailbox struct {
+	// Size is the size of the mbox file once its messages were exported.
+	Size int64 `json:",omitempty"`
+
+	// Messages maps the IDs of the exported messages to the names of their Maildir files, empty for mbox files.
+	Messages map[string]string
+}
+
+// loadExportState loads the export state of the destination, which is empty if nothing was exported into it.
+func loadExportState(dest string, format ExportFormat) (*exportState, error) {
+	state := &exportState{
+		path:      filepath.Join(dest, exportStateName),
+		Format:    format,
+		Mailboxes: make(map[string]*exportedMailbox),
+	}
+
+	data, err := os.ReadFile(state.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return state, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read export state: %w", err)
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse export state: %w", err)
+	}
+
+	if state.Format != format {
+		return nil, fmt.Errorf("%w: %v", ErrExportFormatMismatch, state.Format)
+	}
+
+	return state, nil
+}
+
+// save writes the export state, replacing the previous one at once.
+func (state *exportState) save() error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := state.path + ".tmp"
+
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write export state: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, state.path); err != nil {
+		return fmt.Errorf("failed to write export state: %w", err)
+	}
+
+	return nil
+}
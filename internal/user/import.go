@@ -0,0 +1,762 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/gluon/rfc5322"
+	"github.com/ProtonMail/gluon/rfc822"
+	"github.com/ProtonMail/go-proton-api"
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/internal/localmail"
+	"github.com/ProtonMail/proton-bridge/v3/internal/usertypes"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/message/parser"
+	"github.com/bradenaw/juniper/parallel"
+	"github.com/bradenaw/juniper/stream"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// importBatchSize is the number of messages imported before they are recorded in the journal. At most this many
+	// messages are imported again when resuming an interrupted import.
+	importBatchSize = 100
+
+	// importBatchMaxSize bounds the memory used by the messages waiting to be imported.
+	importBatchMaxSize = 64 * 1024 * 1024
+
+	// importWorkers is the number of messages imported in parallel. They are imported one per request, so that the
+	// failure of one does not leave the others of the request in an unknown state.
+	importWorkers = 4
+)
+
+// ImportOptions are the options of an import of local messages into the account.
+type ImportOptions struct {
+	// Mapping maps source folders, e.g. `Work/Projects`, to mailboxes named as in the mail clients,
+	// e.g. `Folders/Projects`, `Labels/Work` or `Archive`.
+	Mapping map[string]string
+
+	// AsLabels imports the folders which are neither mapped nor system mailboxes as labels rather than folders.
+	AsLabels bool
+
+	// Address is the address the messages are imported into. By default, it is the address they were sent to, or
+	// sent from for the sent messages, and the primary address if none is.
+	Address string
+
+	// JournalPath is the file recording the messages already imported, which are skipped.
+	JournalPath string
+}
+
+// ImportProgress is the progress of an import.
+type ImportProgress struct {
+	Imported int
+	Skipped  int
+	Failed   int
+}
+
+// ImportFailure is a message which could not be imported.
+type ImportFailure struct {
+	// ID identifies the message in the source, e.g. `Inbox.mbox:42` for the 43rd message of an mbox file.
+	ID     string
+	Folder string
+	Error  string
+}
+
+// ImportReport is the result of an import.
+type ImportReport struct {
+	// Imported is the number of messages imported, Skipped the number of those imported before.
+	Imported int
+	Skipped  int
+
+	Failures []ImportFailure
+}
+
+// Import imports the messages of the given mbox file, Maildir directory or directory of .eml files (see
+// localmail.Walk), mapping their folders to the mailboxes of the account, which are created if needed.
+// The messages recorded in the journal are skipped, and those imported are recorded in it, so that an interrupted
+// import resumes where it stopped. The messages which cannot be imported are reported without stopping the import.
+func (user *User) Import(
+	ctx context.Context,
+	source string,
+	opts ImportOptions,
+	progressCB func(ImportProgress),
+) (ImportReport, error) {
+	mailboxes, err := newImportMailboxes(user.client, opts.Mapping, opts.AsLabels)
+	if err != nil {
+		return ImportReport{}, err
+	}
+
+	apiUser, err := user.identityService.GetAPIUser(ctx)
+	if err != nil {
+		return ImportReport{}, fmt.Errorf("failed to get api user: %w", err)
+	}
+
+	apiAddrs, err := user.identityService.GetAddresses(ctx)
+	if err != nil {
+		return ImportReport{}, fmt.Errorf("failed to get addresses: %w", err)
+	}
+
+	addresses := user.protonAddresses()
+	if len(addresses) == 0 {
+		return ImportReport{}, ErrNoSuchAddress
+	}
+
+	var addrID string
+
+	if opts.Address != "" {
+		idx := slices.IndexFunc(addresses, func(addr proton.Address) bool {
+			return strings.EqualFold(addr.Email, opts.Address)
+		})
+		if idx < 0 {
+			return ImportReport{}, fmt.Errorf("%w: %v", ErrNoSuchAddress, opts.Address)
+		}
+
+		addrID = addresses[idx].ID
+	}
+
+	journal, err := openImportJournal(opts.JournalPath)
+	if err != nil {
+		return ImportReport{}, fmt.Errorf("failed to open import journal: %w", err)
+	}
+	defer journal.close()
+
+	user.log.WithField("source", source).Info("Importing messages")
+
+	imp := &importer{
+		client:     user.client,
+		log:        user.log,
+		mailboxes:  mailboxes,
+		journal:    journal,
+		addresses:  addresses,
+		addrID:     addrID,
+		progressCB: progressCB,
+	}
+
+	if err := usertypes.WithAddrKRs(apiUser, apiAddrs, user.vault.KeyPass(), func(_ *crypto.KeyRing, addrKRs map[string]*crypto.KeyRing) error {
+		imp.addrKRs = addrKRs
+
+		if _, ok := addrKRs[imp.getDefaultAddrID()]; !ok {
+			return ErrMissingAddrKey
+		}
+
+		if err := localmail.Walk(source, func(msg localmail.Message) error {
+			return imp.add(ctx, msg)
+		}); err != nil {
+			return err
+		}
+
+		return imp.flush(ctx)
+	}); err != nil {
+		return imp.report, err
+	}
+
+	user.log.WithFields(logrus.Fields{
+		"imported": imp.report.Imported,
+		"skipped":  imp.report.Skipped,
+		"failed":   len(imp.report.Failures),
+	}).Info("Import finished")
+
+	return imp.report, nil
+}
+
+type importer struct {
+	client    *proton.Client
+	log       *logrus.Entry
+	mailboxes *importMailboxes
+	journal   *importJournal
+
+	// addresses are the addresses the messages can be imported into, the primary one first.
+	addresses []proton.Address
+	addrKRs   map[string]*crypto.KeyRing
+
+	// addrID is the address all the messages are imported into, if given.
+	addrID string
+
+	pending     []pendingImport
+	pendingSize int
+	report      ImportReport
+	progressCB  func(ImportProgress)
+}
+
+type pendingImport struct {
+	id      string
+	folder  string
+	req     proton.ImportReq
+	starred bool
+}
+
+// add prepares the import of a message, the pending messages being imported once there are enough of them.
+func (imp *importer) add(ctx context.Context, msg localmail.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if imp.journal.has(msg.ID) {
+		if imp.report.Skipped++; imp.report.Skipped%importBatchSize == 0 {
+			imp.progress()
+		}
+
+		return nil
+	}
+
+	folder := strings.Join(msg.Folder, "/")
+
+	req, starred, err := imp.newImportReq(ctx, msg)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		imp.fail(msg.ID, folder, err)
+
+		return nil
+	}
+
+	imp.pending = append(imp.pending, pendingImport{id: msg.ID, folder: folder, req: req, starred: starred})
+	imp.pendingSize += len(req.Message)
+
+	if len(imp.pending) < importBatchSize && imp.pendingSize < importBatchMaxSize {
+		return nil
+	}
+
+	return imp.flush(ctx)
+}
+
+// flush imports the pending messages and records those imported in the journal.
+func (imp *importer) flush(ctx context.Context) error {
+	if len(imp.pending) == 0 {
+		return nil
+	}
+
+	messageIDs, errs := make([]string, len(imp.pending)), make([]error, len(imp.pending))
+
+	doErr := parallel.DoContext(ctx, importWorkers, len(imp.pending), func(ctx context.Context, idx int) error {
+		req := imp.pending[idx].req
+
+		res, err := imp.client.ImportMessages(ctx, imp.addrKRs[req.Metadata.AddressID], 1, 1, req)
+		if err != nil {
+			errs[idx] = err
+			return nil
+		}
+
+		if res, err := stream.Collect(ctx, res); err != nil {
+			errs[idx] = err
+		} else {
+			messageIDs[idx] = res[0].MessageID
+		}
+
+		return nil
+	})
+
+	var imported, starredIDs []string
+
+	for idx, pending := range imp.pending {
+		if errs[idx] != nil || messageIDs[idx] == "" {
+			continue
+		}
+
+		if pending.starred {
+			starredIDs = append(starredIDs, messageIDs[idx])
+		}
+
+		imported = append(imported, pending.id)
+	}
+
+	// The messages imported are recorded even if the import was interrupted, so that they aren't imported again.
+	if err := imp.journal.add(imported...); err != nil {
+		return fmt.Errorf("failed to record imported messages: %w", err)
+	}
+
+	imp.report.Imported += len(imported)
+
+	if len(starredIDs) > 0 {
+		// The messages are starred even if the import was interrupted, as they aren't imported again when resuming.
+		if err := imp.client.LabelMessages(context.WithoutCancel(ctx), starredIDs, proton.StarredLabel); err != nil {
+			imp.log.WithError(err).Warn("Failed to star imported messages")
+		}
+	}
+
+	if doErr != nil {
+		return doErr
+	}
+
+	// The messages whose import was interrupted are imported again when resuming.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for idx, pending := range imp.pending {
+		if errs[idx] != nil {
+			imp.fail(pending.id, pending.folder, errs[idx])
+		}
+	}
+
+	imp.pending, imp.pendingSize = nil, 0
+	imp.progress()
+
+	return nil
+}
+
+func (imp *importer) fail(id, folder string, err error) {
+	imp.log.WithError(err).WithField("id", id).Warn("Failed to import message")
+
+	imp.report.Failures = append(imp.report.Failures, ImportFailure{ID: id, Folder: folder, Error: err.Error()})
+}
+
+func (imp *importer) progress() {
+	if imp.progressCB == nil {
+		return
+	}
+
+	imp.progressCB(ImportProgress{
+		Imported: imp.report.Imported,
+		Skipped:  imp.report.Skipped,
+		Failed:   len(imp.report.Failures),
+	})
+}
+
+// newImportReq returns the import request of the message, in the mailbox its folder is mapped to.
+// The flags and the sent or received state of the message are preserved, as is its date, which is added to its
+// header from its delivery date if it has none.
+func (imp *importer) newImportReq(ctx context.Context, msg localmail.Message) (proton.ImportReq, bool, error) {
+	labelIDs, err := imp.mailboxes.resolve(ctx, msg.Folder)
+	if err != nil {
+		return proton.ImportReq{}, false, err
+	}
+
+	// The messages are starred once imported, as not all backends accept importing them starred.
+	starred := msg.Flagged
+	if idx := slices.Index(labelIDs, proton.StarredLabel); idx >= 0 {
+		labelIDs, starred = slices.Delete(labelIDs, idx, idx+1), true
+	}
+
+	literal := msg.Literal
+
+	header, err := rfc822.Parse(literal).ParseHeader()
+	if err != nil {
+		return proton.ImportReq{}, false, fmt.Errorf("failed to parse header: %w", err)
+	}
+
+	if !header.Has("Date") && !msg.Date.IsZero() {
+		literal = append([]byte("Date: "+msg.Date.Format(time.RFC1123Z)+"\r\n"), literal...)
+	}
+
+	p, err := parser.New(bytes.NewReader(literal))
+	if err != nil {
+		return proton.ImportReq{}, false, fmt.Errorf("failed to parse message: %w", err)
+	}
+
+	// A multipart body requires at least one text part to be encrypted.
+	if p.AttachEmptyTextPartIfNoneExists() {
+		buf := new(bytes.Buffer)
+
+		if err := p.NewWriter().Write(buf); err != nil {
+			return proton.ImportReq{}, false, fmt.Errorf("failed to build message: %w", err)
+		}
+
+		literal = buf.Bytes()
+	}
+
+	var flags proton.MessageFlag
+
+	switch {
+	case slices.Contains(labelIDs, proton.DraftsLabel):
+		msg.Seen = true
+
+	case slices.Contains(labelIDs, proton.InboxLabel):
+		flags = proton.MessageFlagReceived
+
+	case slices.Contains(labelIDs, proton.SentLabel):
+		flags = proton.MessageFlagSent
+
+	case header.Has("Received"):
+		flags = proton.MessageFlagReceived
+
+	default:
+		flags = proton.MessageFlagSent
+	}
+
+	if msg.Answered {
+		flags = flags.Add(proton.MessageFlagReplied)
+	}
+
+	return proton.ImportReq{
+		Metadata: proton.ImportMetadata{
+			AddressID: imp.getAddrID(header, flags.Has(proton.MessageFlagSent)),
+			LabelIDs:  labelIDs,
+			Unread:    proton.Bool(!msg.Seen),
+			Flags:     flags,
+		},
+		Message: literal,
+	}, starred, nil
+}
+
+// getAddrID returns the address the message is imported into: the address it was sent to, or sent from if it is a
+// sent message, the primary address otherwise.
+func (imp *importer) getAddrID(header *rfc822.Header, sent bool) string {
+	if imp.addrID != "" {
+		return imp.addrID
+	}
+
+	fields := []string{"To", "Cc", "Delivered-To", "X-Original-To"}
+	if sent {
+		fields = []string{"From", "Sender"}
+	}
+
+	for _, field := range fields {
+		value, ok := header.GetChecked(field)
+		if !ok {
+			continue
+		}
+
+		list, err := rfc5322.ParseAddressList(value)
+		if err != nil {
+			continue
+		}
+
+		for _, recipient := range list {
+			for _, addr := range imp.addresses {
+				if _, ok := imp.addrKRs[addr.ID]; ok && strings.EqualFold(addr.Email, recipient.Address) {
+					return addr.ID
+				}
+			}
+		}
+	}
+
+	return imp.getDefaultAddrID()
+}
+
+// getDefaultAddrID returns the address the messages are imported into when none of their addresses matches: the one
+// given, or the first address which can be used.
+func (imp *importer) getDefaultAddrID() string {
+	if imp.addrID != "" {
+		return imp.addrID
+	}
+
+	for _, addr := range imp.addresses {
+		if _, ok := imp.addrKRs[addr.ID]; ok {
+			return addr.ID
+		}
+	}
+
+	return ""
+}
+
+// importSystemMailboxes are the system mailboxes the messages can be imported into, by name.
+var importSystemMailboxes = map[string]string{ //nolint:gochecknoglobals
+	"inbox":   proton.InboxLabel,
+	"drafts":  proton.DraftsLabel,
+	"sent":    proton.SentLabel,
+	"starred": proton.StarredLabel,
+	"archive": proton.ArchiveLabel,
+	"spam":    proton.SpamLabel,
+	"trash":   proton.TrashLabel,
+}
+
+// importFolderAliases are the names other mail clients give to the system mailboxes.
+var importFolderAliases = map[string]string{ //nolint:gochecknoglobals
+	"sent items":       proton.SentLabel,
+	"sent mail":        proton.SentLabel,
+	"sent messages":    proton.SentLabel,
+	"draft":            proton.DraftsLabel,
+	"archives":         proton.ArchiveLabel,
+	"deleted items":    proton.TrashLabel,
+	"deleted messages": proton.TrashLabel,
+	"bin":              proton.TrashLabel,
+	"junk":             proton.SpamLabel,
+	"junk e-mail":      proton.SpamLabel,
+	"junk email":       proton.SpamLabel,
+	"junk mail":        proton.SpamLabel,
+	"bulk mail":        proton.SpamLabel,
+}
+
+// importTarget is the mailbox a source folder is imported into: a system mailbox, a folder or a label.
+type importTarget struct {
+	systemID string
+	folder   []string
+	label    string
+}
+
+// parseImportTarget parses a mailbox named as in the mail clients, e.g. `Inbox`, `Folders/Work/Projects` or
+// `Labels/Work`.
+func parseImportTarget(name string) (importTarget, error) {
+	parts := strings.Split(strings.Trim(name, "/"), "/")
+
+	switch {
+	case len(parts) == 1:
+		if systemID, ok := importSystemMailboxes[strings.ToLower(parts[0])]; ok {
+			return importTarget{systemID: systemID}, nil
+		}
+
+	case strings.EqualFold(parts[0], "Folders"):
+		return importTarget{folder: parts[1:]}, nil
+
+	case strings.EqualFold(parts[0], "Labels"):
+		return importTarget{label: strings.Join(parts[1:], "/")}, nil
+	}
+
+	return importTarget{}, fmt.Errorf("%w: %q", ErrInvalidImportMailbox, name)
+}
+
+// importMailboxes maps the source folders to the mailboxes of the account, creating the folders and labels needed.
+type importMailboxes struct {
+	client   *proton.Client
+	mapping  map[string]importTarget
+	asLabels bool
+
+	labels   []proton.Label
+	resolved map[string][]string
+}
+
+func newImportMailboxes(client *proton.Client, mapping map[string]string, asLabels bool) (*importMailboxes, error) {
+	mailboxes := &importMailboxes{
+		client:   client,
+		mapping:  make(map[string]importTarget, len(mapping)),
+		asLabels: asLabels,
+		resolved: make(map[string][]string),
+	}
+
+	for folder, name := range mapping {
+		target, err := parseImportTarget(name)
+		if err != nil {
+			return nil, err
+		}
+
+		mailboxes.mapping[strings.ToLower(strings.Trim(folder, "/"))] = target
+	}
+
+	return mailboxes, nil
+}
+
+// resolve returns the labels of the messages of the given source folder. The messages imported with a label only
+// are also put in the archive, as each message must be in a folder.
+func (mailboxes *importMailboxes) resolve(ctx context.Context, folder []string) ([]string, error) {
+	key := strings.ToLower(strings.Join(folder, "/"))
+
+	if labelIDs, ok := mailboxes.resolved[key]; ok {
+		return slices.Clone(labelIDs), nil
+	}
+
+	target, ok := mailboxes.mapping[key]
+	if !ok {
+		target = mailboxes.getDefaultTarget(folder)
+	}
+
+	if mailboxes.labels == nil {
+		labels, err := mailboxes.client.GetLabels(ctx, proton.LabelTypeFolder, proton.LabelTypeLabel)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get labels: %w", err)
+		}
+
+		mailboxes.labels = labels
+	}
+
+	var labelIDs []string
+
+	switch {
+	case target.systemID == proton.StarredLabel:
+		labelIDs = []string{proton.ArchiveLabel, proton.StarredLabel}
+
+	case target.systemID != "":
+		labelIDs = []string{target.systemID}
+
+	case target.label != "":
+		labelID, err := mailboxes.getLabel(ctx, target.label)
+		if err != nil {
+			return nil, err
+		}
+
+		labelIDs = []string{proton.ArchiveLabel, labelID}
+
+	default:
+		labelID, err := mailboxes.getFolder(ctx, target.folder)
+		if err != nil {
+			return nil, err
+		}
+
+		labelIDs = []string{labelID}
+	}
+
+	mailboxes.resolved[key] = labelIDs
+
+	return slices.Clone(labelIDs), nil
+}
+
+// getDefaultTarget returns the mailbox of the folders which are not mapped: the system mailbox of the same name,
+// a label or a folder of the same path otherwise.
+func (mailboxes *importMailboxes) getDefaultTarget(folder []string) importTarget {
+	if len(folder) == 1 {
+		name := strings.ToLower(folder[0])
+
+		if systemID, ok := importSystemMailboxes[name]; ok {
+			return importTarget{systemID: systemID}
+		}
+
+		if systemID, ok := importFolderAliases[name]; ok {
+			return importTarget{systemID: systemID}
+		}
+	}
+
+	if mailboxes.asLabels {
+		return importTarget{label: strings.Join(folder, "/")}
+	}
+
+	return importTarget{folder: folder}
+}
+
+// getFolder returns the folder with the given path, creating it and its parents if needed.
+func (mailboxes *importMailboxes) getFolder(ctx context.Context, path []string) (string, error) {
+	var parentID string
+
+	for idx := range path {
+		labelIdx := slices.IndexFunc(mailboxes.labels, func(label proton.Label) bool {
+			return label.Type == proton.LabelTypeFolder && equalFoldPath(label.Path, path[:idx+1])
+		})
+
+		if labelIdx >= 0 {
+			parentID = mailboxes.labels[labelIdx].ID
+			continue
+		}
+
+		label, err := mailboxes.client.CreateLabel(ctx, proton.CreateLabelReq{
+			Name:     path[idx],
+			Color:    "#f66",
+			Type:     proton.LabelTypeFolder,
+			ParentID: parentID,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to create folder %q: %w", strings.Join(path[:idx+1], "/"), err)
+		}
+
+		label.Path = slices.Clone(path[:idx+1])
+		mailboxes.labels = append(mailboxes.labels, label)
+		parentID = label.ID
+	}
+
+	return parentID, nil
+}
+
+// getLabel returns the label with the given name, creating it if needed.
+func (mailboxes *importMailboxes) getLabel(ctx context.Context, name string) (string, error) {
+	if idx := slices.IndexFunc(mailboxes.labels, func(label proton.Label) bool {
+		return label.Type == proton.LabelTypeLabel && strings.EqualFold(label.Name, name)
+	}); idx >= 0 {
+		return mailboxes.labels[idx].ID, nil
+	}
+
+	label, err := mailboxes.client.CreateLabel(ctx, proton.CreateLabelReq{
+		Name:  name,
+		Color: "#f66",
+		Type:  proton.LabelTypeLabel,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create label %q: %w", name, err)
+	}
+
+	mailboxes.labels = append(mailboxes.labels, label)
+
+	return label.ID, nil
+}
+
+func equalFoldPath(a, b []string) bool {
+	return slices.EqualFunc(a, b, strings.EqualFold)
+}
+
+// importJournal records the messages of a source which were imported, by the hash of their ID.
+type importJournal struct {
+	file *os.File
+	done map[string]struct{}
+}
+
+// openImportJournal opens the journal at the given path, creating it if needed. Without a path, nothing is recorded.
+func openImportJournal(path string) (*importJournal, error) {
+	journal := &importJournal{done: make(map[string]struct{})}
+
+	if path == "" {
+		return journal, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o600) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		journal.done[scanner.Text()] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	journal.file = file
+
+	return journal, nil
+}
+
+func (journal *importJournal) has(id string) bool {
+	_, ok := journal.done[hashImportID(id)]
+
+	return ok
+}
+
+// add records the given messages as imported, the journal being synced to disk before returning.
+func (journal *importJournal) add(ids ...string) error {
+	var buf bytes.Buffer
+
+	for _, id := range ids {
+		hash := hashImportID(id)
+
+		journal.done[hash] = struct{}{}
+		buf.WriteString(hash + "\n")
+	}
+
+	if journal.file == nil || buf.Len() == 0 {
+		return nil
+	}
+
+	if _, err := journal.file.Write(buf.Bytes()); err != nil {
+		return err
+	}
+
+	return journal.file.Sync()
+}
+
+func (journal *importJournal) close() {
+	if journal.file != nil {
+		_ = journal.file.Close()
+	}
+}
+
+func hashImportID(id string) string {
+	hash := sha256.Sum256([]byte(id))
+
+	return hex.EncodeToString(hash[:16])
+}
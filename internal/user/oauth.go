@@ -0,0 +1,47 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+)
+
+// OAuthTokenVerifier verifies the login passwords the OAUTHBEARER and XOAUTH2 mechanisms authenticate with in place of
+// the OAuth access tokens of the mail clients.
+type OAuthTokenVerifier interface {
+	// VerifyOAuthUserPassword returns the scope of the access token of the given login password and the ID of the
+	// authorization it was issued from, if it was issued to the given user.
+	VerifyOAuthUserPassword(userID, password string) (vault.AppPasswordScope, string, bool)
+}
+
+// bridgePassProvider checks the passwords of the user's mail clients: the bridge password and the app passwords
+// from the vault, and the OAuth access tokens issued to the user.
+type bridgePassProvider struct {
+	*vault.User
+
+	userID   string
+	verifier OAuthTokenVerifier
+}
+
+func (provider *bridgePassProvider) OAuthPasswordScope(password []byte) (vault.AppPasswordScope, string, bool) {
+	if provider.verifier == nil {
+		return "", "", false
+	}
+
+	return provider.verifier.VerifyOAuthUserPassword(provider.userID, string(password))
+}
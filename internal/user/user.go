@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ProtonMail/gluon/async"
@@ -90,6 +91,8 @@ type User struct {
 	observabilityService *observability.Service
 
 	serviceGroup *orderedtasks.OrderedCancelGroup
+
+	exportLock sync.Mutex // Held while the messages are exported, so that two exports never run at once.
 }
 
 func New(
@@ -100,6 +103,7 @@ func New(
 	apiUser proton.User,
 	crashHandler async.PanicHandler,
 	showAllMail bool,
+	systemViews []string,
 	maxSyncMemory uint64,
 	telemetryManager telemetry.Availability,
 	imapServerManager imapservice.IMAPServerManager,
@@ -111,6 +115,7 @@ func New(
 	isNew bool,
 	notificationStore *notifications.Store,
 	featureFlagValueProvider unleash.FeatureFlagValueProvider,
+	oauthVerifier OAuthTokenVerifier,
 ) (*User, error) {
 	user, err := newImpl(
 		ctx,
@@ -120,6 +125,7 @@ func New(
 		apiUser,
 		crashHandler,
 		showAllMail,
+		systemViews,
 		maxSyncMemory,
 		telemetryManager,
 		imapServerManager,
@@ -131,6 +137,7 @@ func New(
 		isNew,
 		notificationStore,
 		featureFlagValueProvider,
+		oauthVerifier,
 	)
 	if err != nil {
 		// Cleanup any pending resources on error
@@ -153,6 +160,7 @@ func newImpl(
 	apiUser proton.User,
 	crashHandler async.PanicHandler,
 	showAllMail bool,
+	systemViews []string,
 	maxSyncMemory uint64,
 	telemetryManager telemetry.Availability,
 	imapServerManager imapservice.IMAPServerManager,
@@ -164,6 +172,7 @@ func newImpl(
 	isNew bool,
 	notificationStore *notifications.Store,
 	featureFlagValueProvider unleash.FeatureFlagValueProvider,
+	oauthVerifier OAuthTokenVerifier,
 ) (*User, error) {
 	logrus.WithField("userID", apiUser.ID).Info("Creating new user")
 
@@ -246,7 +255,9 @@ func newImpl(
 
 	addressMode := usertypes.VaultToAddressMode(encVault.AddressMode())
 
-	user.identityService = useridentity.NewService(user.eventService, user, identityState, encVault)
+	passProvider := &bridgePassProvider{User: encVault, userID: apiUser.ID, verifier: oauthVerifier}
+
+	user.identityService = useridentity.NewService(user.eventService, user, identityState, passProvider)
 
 	user.telemetryService = telemetryservice.NewService(apiUser.ID, client, user.eventService)
 
@@ -256,10 +267,11 @@ func newImpl(
 		sendRecorder,
 		crashHandler,
 		reporter,
-		encVault,
+		passProvider,
 		encVault,
 		user.eventService,
 		addressMode,
+		usertypes.VaultToAddressGroups(encVault.AddressGroups()),
 		identityState.Clone(),
 		smtpServerManager,
 		observabilityService,
@@ -267,23 +279,30 @@ func newImpl(
 		featureFlagValueProvider,
 	)
 
+	// The conversation IDs of the messages are recorded from the responses of the API to be added to their literal.
+	conversationIDs := imapservice.NewConversationIDs()
+	client.AddPostRequestHook(conversationIDs.RecordResponse)
+
 	user.imapService = imapservice.NewService(
 		client,
+		conversationIDs,
 		identityState.Clone(),
 		user,
 		user.eventService,
 		imapServerManager,
 		user,
-		encVault,
+		passProvider,
 		encVault,
 		crashHandler,
 		sendRecorder,
 		reporter,
 		addressMode,
+		usertypes.VaultToAddressGroups(encVault.AddressGroups()),
 		eventSubscription,
 		syncConfigDir,
 		user.maxSyncMemory,
 		showAllMail,
+		systemViews,
 		observabilityService,
 		featureFlagValueProvider,
 	)
@@ -427,6 +446,17 @@ func (user *User) Emails() []string {
 	})
 }
 
+// AddressEmails returns the email addresses of the user's active addresses, by address ID.
+func (user *User) AddressEmails() map[string]string {
+	result := make(map[string]string)
+
+	for _, address := range user.protonAddresses() {
+		result[address.ID] = address.Email
+	}
+
+	return result
+}
+
 // GetAddressMode returns the user's current address mode.
 func (user *User) GetAddressMode() vault.AddressMode {
 	return user.vault.AddressMode()
@@ -456,6 +486,31 @@ func (user *User) SetAddressMode(ctx context.Context, mode vault.AddressMode) er
 	return nil
 }
 
+// AddressGroups returns the addresses sharing a mailbox in split mode.
+func (user *User) AddressGroups() []vault.AddressGroup {
+	return user.vault.AddressGroups()
+}
+
+// SetAddressGroups sets the addresses sharing a mailbox in split mode.
+// Only the mailboxes of the addresses whose group changed are resynced.
+func (user *User) SetAddressGroups(ctx context.Context, groups []vault.AddressGroup) error {
+	user.log.WithField("groups", len(groups)).Info("Setting address groups")
+
+	if err := user.vault.SetAddressGroups(groups); err != nil {
+		return fmt.Errorf("failed to set address groups: %w", err)
+	}
+
+	if err := user.imapService.SetAddressGroups(ctx, usertypes.VaultToAddressGroups(groups)); err != nil {
+		return fmt.Errorf("failed to set imap address groups: %w", err)
+	}
+
+	if err := user.smtpService.SetAddressGroups(ctx, usertypes.VaultToAddressGroups(groups)); err != nil {
+		return fmt.Errorf("failed to set smtp address groups: %w", err)
+	}
+
+	return nil
+}
+
 // BadEventFeedbackResync sends user feedback whether should do message re-sync.
 func (user *User) BadEventFeedbackResync(ctx context.Context) error {
 	if err := user.imapService.OnBadEventResync(ctx); err != nil {
@@ -497,6 +552,18 @@ func (user *User) SetShowAllMail(show bool) {
 	}
 }
 
+// SetSystemViews sets the IDs of the system views which are shown, e.g. Starred or Snoozed.
+func (user *User) SetSystemViews(labelIDs []string) {
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(time.Minute))
+	defer cancel()
+
+	user.log.WithField("views", labelIDs).Info("Setting system views")
+
+	if err := user.imapService.SetSystemViews(ctx, labelIDs); err != nil {
+		user.log.WithError(err).Error("Failed to set system views")
+	}
+}
+
 // GetGluonIDs returns the users gluon IDs.
 func (user *User) GetGluonIDs() map[string]string {
 	return user.vault.GetGluonIDs()
@@ -555,6 +622,57 @@ func (user *User) BridgePass() []byte {
 	return algo.B64RawEncode(user.vault.BridgePass())
 }
 
+// AppPasswords returns the user's app passwords.
+func (user *User) AppPasswords() []vault.AppPassword {
+	return user.vault.AppPasswords()
+}
+
+// AddAppPassword creates an app password with the given name and scope.
+// It returns the app password along with the password used for authentication, which can't be retrieved later.
+func (user *User) AddAppPassword(name string, scope vault.AppPasswordScope) (vault.AppPassword, []byte, error) {
+	appPassword, token, err := user.vault.AddAppPassword(name, scope)
+	if err != nil {
+		return vault.AppPassword{}, nil, err
+	}
+
+	return appPassword, algo.B64RawEncode(token), nil
+}
+
+// RemoveAppPassword removes the app password with the given ID and returns whether it existed.
+func (user *User) RemoveAppPassword(id string) (bool, error) {
+	return user.vault.RemoveAppPassword(id)
+}
+
+// ReadOnly returns whether the mail clients can only read the user's mailbox.
+func (user *User) ReadOnly() bool {
+	return user.vault.ReadOnly()
+}
+
+// SetReadOnly sets whether the mail clients can only read the user's mailbox.
+func (user *User) SetReadOnly(readOnly bool) error {
+	return user.vault.SetReadOnly(readOnly)
+}
+
+// StrictSplitMode returns whether, in split mode, an SMTP session can only send from the address it authenticated with.
+func (user *User) StrictSplitMode() bool {
+	return user.vault.StrictSplitMode()
+}
+
+// SetStrictSplitMode sets whether, in split mode, an SMTP session can only send from the address it authenticated with.
+func (user *User) SetStrictSplitMode(strict bool) error {
+	return user.vault.SetStrictSplitMode(strict)
+}
+
+// ExportSchedule returns the periodic export of the user's messages.
+func (user *User) ExportSchedule() vault.ExportSchedule {
+	return user.vault.ExportSchedule()
+}
+
+// SetExportSchedule sets the periodic export of the user's messages.
+func (user *User) SetExportSchedule(schedule vault.ExportSchedule) error {
+	return user.vault.SetExportSchedule(schedule)
+}
+
 // UsedSpace returns the total space used by the user on the API.
 func (user *User) UsedSpace() uint64 {
 	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(time.Minute))
@@ -586,13 +704,13 @@ func (user *User) GetEventCh() <-chan events.Event {
 	return user.eventCh.GetChannel()
 }
 
-// CheckAuth returns whether the given email and password can be used to authenticate over IMAP or SMTP with this user.
-// It returns the address ID of the authenticated address.
-func (user *User) CheckAuth(email string, password []byte) (string, error) {
+// CheckAuth returns whether the given email and password can be used to authenticate over the given protocol with
+// this user. It returns the address ID of the authenticated address.
+func (user *User) CheckAuth(email string, password []byte, protocol useridentity.Protocol) (string, error) {
 	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(time.Minute))
 	defer cancel()
 
-	return user.identityService.CheckAuth(ctx, email, password)
+	return user.identityService.CheckAuth(ctx, email, password, protocol)
 }
 
 // Logout logs the user out from the API.
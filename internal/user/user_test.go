@@ -162,6 +162,7 @@ func withUser(tb testing.TB, ctx context.Context, _ *server.Server, m *proton.Ma
 		apiUser,
 		nil,
 		true,
+		nil,
 		vault.DefaultMaxSyncMemory,
 		manager,
 		nullIMAPServerManager,
@@ -175,6 +176,7 @@ func withUser(tb testing.TB, ctx context.Context, _ *server.Server, m *proton.Ma
 			return "", nil
 		}),
 		nullUnleashService,
+		nil,
 	)
 	require.NoError(tb, err)
 	defer user.Close()
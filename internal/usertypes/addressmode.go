@@ -17,7 +17,11 @@
 
 package usertypes
 
-import "github.com/ProtonMail/proton-bridge/v3/internal/vault"
+import (
+	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
+	"github.com/bradenaw/juniper/xslices"
+	"golang.org/x/exp/slices"
+)
 
 type AddressMode int
 
@@ -45,3 +49,61 @@ func AddressModeToVault(mode AddressMode) vault.AddressMode {
 
 	return vault.SplitMode
 }
+
+// AddressGroups lists, by their IDs, the addresses which share a mailbox in split mode.
+// The addresses which are not part of a group have their own mailbox.
+type AddressGroups [][]string
+
+func VaultToAddressGroups(groups []vault.AddressGroup) AddressGroups {
+	return xslices.Map(groups, func(group vault.AddressGroup) []string {
+		return slices.Clone(group.AddrIDs)
+	})
+}
+
+// Key returns the ID of the address the mailbox of the given address is stored under: the first address of its group,
+// or the address itself if it is not part of a group.
+func (groups AddressGroups) Key(addrID string) string {
+	for _, group := range groups {
+		if slices.Contains(group, addrID) {
+			return group[0]
+		}
+	}
+
+	return addrID
+}
+
+// Members returns the IDs of the addresses sharing the mailbox stored under the given key.
+func (groups AddressGroups) Members(key string) []string {
+	for _, group := range groups {
+		if len(group) > 0 && group[0] == key {
+			return group
+		}
+	}
+
+	return []string{key}
+}
+
+// ChangedKeys returns the keys of the mailboxes affected by replacing the groups with the given ones, before and
+// after the change, considering only the given addresses.
+func (groups AddressGroups) ChangedKeys(newGroups AddressGroups, addrIDs []string) ([]string, []string) {
+	var oldKeys, newKeys []string
+
+	for _, addrID := range addrIDs {
+		oldKey, newKey := groups.Key(addrID), newGroups.Key(addrID)
+
+		// The mailbox also changes if other addresses joined or left it.
+		if oldKey == newKey && slices.Equal(groups.Members(oldKey), newGroups.Members(newKey)) {
+			continue
+		}
+
+		if !slices.Contains(oldKeys, oldKey) {
+			oldKeys = append(oldKeys, oldKey)
+		}
+
+		if !slices.Contains(newKeys, newKey) {
+			newKeys = append(newKeys, newKey)
+		}
+	}
+
+	return oldKeys, newKeys
+}
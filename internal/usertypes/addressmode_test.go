@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package usertypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressGroups_Key(t *testing.T) {
+	groups := AddressGroups{{"addr1", "addr2"}, {"addr3", "addr4", "addr5"}}
+
+	// The grouped addresses share the mailbox of the first address of their group.
+	require.Equal(t, "addr1", groups.Key("addr1"))
+	require.Equal(t, "addr1", groups.Key("addr2"))
+	require.Equal(t, "addr3", groups.Key("addr5"))
+	require.Equal(t, []string{"addr3", "addr4", "addr5"}, groups.Members("addr3"))
+
+	// The other ones have their own.
+	require.Equal(t, "addr6", groups.Key("addr6"))
+	require.Equal(t, []string{"addr6"}, groups.Members("addr6"))
+}
+
+func TestAddressGroups_ChangedKeys(t *testing.T) {
+	groups := AddressGroups{{"addr1", "addr2"}, {"addr3", "addr4"}}
+	addrIDs := []string{"addr1", "addr2", "addr3", "addr4", "addr5", "addr6"}
+
+	// Nothing changes with the same groups.
+	oldKeys, newKeys := groups.ChangedKeys(AddressGroups{{"addr1", "addr2"}, {"addr3", "addr4"}}, addrIDs)
+	require.Empty(t, oldKeys)
+	require.Empty(t, newKeys)
+
+	// An address joining a group affects the group and the mailbox it had.
+	oldKeys, newKeys = groups.ChangedKeys(AddressGroups{{"addr1", "addr2", "addr5"}, {"addr3", "addr4"}}, addrIDs)
+	require.ElementsMatch(t, []string{"addr1", "addr5"}, oldKeys)
+	require.Equal(t, []string{"addr1"}, newKeys)
+
+	// Removing a group gives its addresses their own mailbox again.
+	oldKeys, newKeys = groups.ChangedKeys(AddressGroups{{"addr1", "addr2"}}, addrIDs)
+	require.Equal(t, []string{"addr3"}, oldKeys)
+	require.Equal(t, []string{"addr3", "addr4"}, newKeys)
+
+	// Only the given addresses are considered.
+	oldKeys, newKeys = groups.ChangedKeys(AddressGroups{{"addr1", "addr2"}, {"addr3", "addr4", "addr7"}}, addrIDs[:2])
+	require.Empty(t, oldKeys)
+	require.Empty(t, newKeys)
+}
@@ -94,6 +94,25 @@ func SetVaultKey(kc *keychain.Keychain, key []byte) error {
 	return kc.Put(vaultSecretName, base64.StdEncoding.EncodeToString(key))
 }
 
+// MigrateVaultKey copies the vault key from one keychain to another, so that the vault can still be decrypted once
+// the other keychain is used. There is nothing to migrate if the first keychain has no vault key.
+func MigrateVaultKey(from, to *keychain.Keychain) error {
+	key, err := GetVaultKey(from)
+	if err != nil {
+		if keychain.IsErrKeychainNoItem(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if err := SetVaultKey(to, key); err != nil {
+		return fmt.Errorf("could not put keychain item: %w", err)
+	}
+
+	return nil
+}
+
 func NewVaultKey(kc *keychain.Keychain) ([]byte, error) {
 	tok, err := crypto.RandomToken(32)
 	if err != nil {
@@ -20,6 +20,8 @@ package vault
 import (
 	"testing"
 
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	"github.com/docker/docker-credential-helpers/credentials"
 	"github.com/stretchr/testify/require"
 )
 
@@ -44,3 +46,27 @@ func TestHelperAccessors(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "keychain", helper)
 }
+
+func TestMigrateVaultKey(t *testing.T) {
+	fromHelper, toHelper := keychain.NewTestHelper(), keychain.NewTestHelper()
+
+	helpers := keychain.Helpers{
+		"from": func(string) (credentials.Helper, error) { return fromHelper, nil },
+		"to":   func(string) (credentials.Helper, error) { return toHelper, nil },
+	}
+
+	from, _, err := keychain.NewKeychain("from", "bridge-test", helpers, "from")
+	require.NoError(t, err)
+
+	to, _, err := keychain.NewKeychain("to", "bridge-test", helpers, "from")
+	require.NoError(t, err)
+
+	key, err := NewVaultKey(from)
+	require.NoError(t, err)
+
+	require.NoError(t, MigrateVaultKey(from, to))
+
+	migrated, err := GetVaultKey(to)
+	require.NoError(t, err)
+	require.Equal(t, key, migrated)
+}
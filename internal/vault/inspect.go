@@ -0,0 +1,289 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ProtonMail/proton-bridge/v3/pkg/ports"
+	"github.com/bradenaw/juniper/xslices"
+	"golang.org/x/exp/maps"
+)
+
+// The fixes of the issues found by Validate.
+const (
+	FixClearSync     = "clear-sync"
+	FixClearGluonIDs = "clear-gluon-ids"
+	FixRemoveUser    = "remove-user"
+	FixResetPorts    = "reset-ports"
+)
+
+const redacted = "<redacted>"
+
+// redactedFields are the names of the vault fields holding secrets, which are not dumped.
+var redactedFields = map[string]struct{}{ //nolint:gochecknoglobals
+	"GluonKey":     {},
+	"NextGluonKey": {},
+	"BridgePass":   {},
+	"AuthUID":      {},
+	"AuthRef":      {},
+	"KeyPass":      {},
+	"Hash":         {},
+	"Cookies":      {},
+	"Cert":         {},
+	"Key":          {},
+	"Archive":      {},
+}
+
+// Issue is an inconsistency found in the vault, which can be solved by the given fix.
+type Issue struct {
+	UserID string `json:"userID,omitempty"`
+
+	// UserIndex is the position of the user in the vault, given for the users without ID, which can only be selected
+	// by their position.
+	UserIndex *int `json:"userIndex,omitempty"`
+
+	Problem string `json:"problem"`
+	Fix     string `json:"fix"`
+}
+
+// DumpRedacted returns the vault data as indented JSON. The secrets are replaced by a placeholder when they are set.
+func (vault *Vault) DumpRedacted() ([]byte, error) {
+	dec, err := json.Marshal(vault.getSafe())
+	if err != nil {
+		return nil, err
+	}
+
+	var data any
+
+	if err := json.Unmarshal(dec, &data); err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(redact(data)); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+func redact(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, field := range value {
+			if _, ok := redactedFields[key]; ok {
+				if !isEmptyJSON(field) {
+					value[key] = redacted
+				}
+			} else {
+				value[key] = redact(field)
+			}
+		}
+
+	case []any:
+		for idx, elem := range value {
+			value[idx] = redact(elem)
+		}
+	}
+
+	return value
+}
+
+func isEmptyJSON(value any) bool {
+	switch value := value.(type) {
+	case nil:
+		return true
+
+	case string:
+		return value == ""
+
+	case map[string]any:
+		return len(value) == 0
+
+	case []any:
+		return len(value) == 0
+
+	default:
+		return false
+	}
+}
+
+// Validate returns the inconsistencies found in the vault.
+func (vault *Vault) Validate() []Issue {
+	data := vault.getSafe()
+
+	issues := validatePorts(data.Settings)
+
+	seen := make(map[string]struct{})
+
+	for idx, user := range data.Users {
+		// The other fixes select the user by ID, so it can only be removed.
+		if user.UserID == "" {
+			issues = append(issues, Issue{UserIndex: &idx, Problem: "the user has no ID", Fix: FixRemoveUser})
+			continue
+		}
+
+		if _, ok := seen[user.UserID]; ok {
+			issues = append(issues, Issue{UserID: user.UserID, Problem: "the user is in the vault more than once", Fix: FixRemoveUser})
+			continue
+		}
+
+		seen[user.UserID] = struct{}{}
+
+		issues = append(issues, validateUser(user)...)
+	}
+
+	return issues
+}
+
+func validatePorts(settings Settings) []Issue {
+	var problems []string
+
+	if !isValidPort(settings.IMAPPort) {
+		problems = append(problems, fmt.Sprintf("the IMAP port %v is invalid", settings.IMAPPort))
+	}
+
+	if !isValidPort(settings.SMTPPort) {
+		problems = append(problems, fmt.Sprintf("the SMTP port %v is invalid", settings.SMTPPort))
+	}
+
+	if settings.OAuthPort != 0 && !isValidPort(settings.OAuthPort) {
+		problems = append(problems, fmt.Sprintf("the OAuth port %v is invalid", settings.OAuthPort))
+	}
+
+	if settings.IMAPPort == settings.SMTPPort {
+		problems = append(problems, fmt.Sprintf("the IMAP and SMTP ports are both %v", settings.IMAPPort))
+	}
+
+	if settings.OAuthPort != 0 && (settings.OAuthPort == settings.IMAPPort || settings.OAuthPort == settings.SMTPPort) {
+		problems = append(problems, fmt.Sprintf("the OAuth port %v is also used by IMAP or SMTP", settings.OAuthPort))
+	}
+
+	return xslices.Map(problems, func(problem string) Issue {
+		return Issue{Problem: problem, Fix: FixResetPorts}
+	})
+}
+
+func isValidPort(port int) bool {
+	return 0 < port && port < 65536
+}
+
+func validateUser(user UserData) []Issue {
+	var issues []Issue
+
+	addIssue := func(problem, fix string) {
+		issues = append(issues, Issue{UserID: user.UserID, Problem: problem, Fix: fix})
+	}
+
+	if len(user.GluonKey) == 0 {
+		addIssue("the user has no gluon key", FixRemoveUser)
+	}
+
+	if len(user.BridgePass) == 0 {
+		addIssue("the user has no bridge password", FixRemoveUser)
+	}
+
+	// Each address has its own gluon user in split mode, they all share the same one in combined mode.
+	gluonIDs := maps.Values(user.GluonIDs)
+
+	if _, ok := user.GluonIDs[""]; ok || xslices.Any(gluonIDs, func(gluonID string) bool { return gluonID == "" }) {
+		addIssue("the gluon IDs have an empty address or gluon ID", FixClearGluonIDs)
+	}
+
+	switch distinct := len(xslices.Unique(gluonIDs)); user.AddressMode {
+	case CombinedMode:
+		if distinct > 1 {
+			addIssue("the addresses have different gluon IDs in combined mode", FixClearGluonIDs)
+		}
+
+	case SplitMode:
+		if distinct != len(gluonIDs) {
+			addIssue("several addresses share a gluon ID in split mode", FixClearGluonIDs)
+		}
+
+	default:
+		addIssue(fmt.Sprintf("the address mode %v is unknown", user.AddressMode), FixClearGluonIDs)
+	}
+
+	status := user.SyncStatus
+
+	if status.HasMessages && !status.HasLabels {
+		addIssue("the messages are synced but not the labels", FixClearSync)
+	}
+
+	if status.LastMessageID != "" && !status.HasLabels {
+		addIssue("the messages are being synced but not the labels", FixClearSync)
+	}
+
+	if len(xslices.Unique(status.FailedMessageIDs)) != len(status.FailedMessageIDs) {
+		addIssue("the failed messages are listed more than once", FixClearSync)
+	}
+
+	return issues
+}
+
+// ClearUserSyncStatus clears the sync status of the user, which is fully synced again when next loaded.
+func (vault *Vault) ClearUserSyncStatus(userID string) error {
+	return vault.modUserChecked(userID, func(data *UserData) {
+		data.SyncStatus = SyncStatus{}
+		data.ShouldResync = true
+	})
+}
+
+// ClearUserGluonIDs forgets the gluon users of the user, so that new ones are created and fully synced
+// when the user is next loaded.
+func (vault *Vault) ClearUserGluonIDs(userID string) error {
+	return vault.modUserChecked(userID, func(data *UserData) {
+		data.GluonIDs = make(map[string]string)
+		data.SyncStatus = SyncStatus{}
+		data.ShouldResync = true
+	})
+}
+
+// ResetPorts sets the IMAP and SMTP ports back to the first free ports from their defaults,
+// and the OAuth port back to its default.
+func (vault *Vault) ResetPorts() error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.IMAPPort = ports.FindFreePortFrom(1143)
+		data.Settings.SMTPPort = ports.FindFreePortFrom(1025, data.Settings.IMAPPort)
+		data.Settings.OAuthPort = 0
+	})
+}
+
+// modUserChecked is modUser for a user that might not exist.
+func (vault *Vault) modUserChecked(userID string, fn func(userData *UserData)) error {
+	vault.lock.Lock()
+	defer vault.lock.Unlock()
+
+	if idx := xslices.IndexFunc(vault.getUnsafe().Users, func(user UserData) bool {
+		return user.UserID == userID
+	}); idx < 0 {
+		return errors.New("no such user")
+	}
+
+	return vault.modUserUnsafe(userID, fn)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVault_DumpRedacted(t *testing.T) {
+	vault, _, err := New(t.TempDir(), t.TempDir(), []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+
+	user, err := vault.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+	require.NoError(t, user.Close())
+
+	require.NoError(t, vault.SetCookies([]byte("cookies")))
+
+	dump, err := vault.DumpRedacted()
+	require.NoError(t, err)
+
+	for _, secret := range []string{"authUID", "authRef", "keyPass", "cookies", "PRIVATE KEY"} {
+		require.NotContains(t, string(dump), secret)
+	}
+
+	var data struct {
+		Users []struct {
+			UserID   string
+			Username string
+			GluonKey string
+			AuthUID  string
+			KeyPass  string
+		}
+	}
+
+	require.NoError(t, json.Unmarshal(dump, &data))
+	require.Len(t, data.Users, 1)
+	require.Equal(t, "userID", data.Users[0].UserID)
+	require.Equal(t, "username", data.Users[0].Username)
+	require.Equal(t, redacted, data.Users[0].GluonKey)
+	require.Equal(t, redacted, data.Users[0].AuthUID)
+	require.Equal(t, redacted, data.Users[0].KeyPass)
+}
+
+func TestVault_Validate(t *testing.T) {
+	vault, _, err := New(t.TempDir(), t.TempDir(), []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+
+	for _, userID := range []string{"user1", "user2", "user3"} {
+		user, err := vault.AddUser(userID, userID, userID+"@pm.me", "authUID", "authRef", []byte("keyPass"))
+		require.NoError(t, err)
+		require.NoError(t, user.Close())
+	}
+
+	require.Empty(t, vault.Validate())
+
+	// Break the vault in every possible way.
+	require.NoError(t, vault.modSafe(func(data *Data) {
+		data.Settings.SMTPPort = data.Settings.IMAPPort
+
+		data.Users[0].SyncStatus = SyncStatus{HasMessages: true, LastMessageID: "messageID"}
+		data.Users[1].GluonIDs = map[string]string{"addrID1": "gluonID1", "addrID2": "gluonID2"}
+		data.Users[2].BridgePass = nil
+		data.Users = append(data.Users, data.Users[2])
+		data.Users = append(data.Users, UserData{})
+	}))
+
+	noIDIndex := 4
+
+	require.ElementsMatch(t, []Issue{
+		{Problem: "the IMAP and SMTP ports are both " + strconv.Itoa(vault.GetIMAPPort()), Fix: FixResetPorts},
+		{UserID: "user1", Problem: "the messages are synced but not the labels", Fix: FixClearSync},
+		{UserID: "user1", Problem: "the messages are being synced but not the labels", Fix: FixClearSync},
+		{UserID: "user2", Problem: "the addresses have different gluon IDs in combined mode", Fix: FixClearGluonIDs},
+		{UserID: "user3", Problem: "the user has no bridge password", Fix: FixRemoveUser},
+		{UserID: "user3", Problem: "the user is in the vault more than once", Fix: FixRemoveUser},
+		{UserIndex: &noIDIndex, Problem: "the user has no ID", Fix: FixRemoveUser},
+	}, vault.Validate())
+
+	// Apply the fixes.
+	require.Error(t, vault.DeleteUserAt(5))
+	require.NoError(t, vault.DeleteUserAt(noIDIndex))
+	require.NoError(t, vault.ResetPorts())
+	require.NoError(t, vault.ClearUserSyncStatus("user1"))
+	require.NoError(t, vault.ClearUserGluonIDs("user2"))
+	require.NoError(t, vault.DeleteUser("user3"))
+	require.NoError(t, vault.DeleteUser("user3"))
+	require.Error(t, vault.ClearUserSyncStatus("user3"))
+
+	require.Empty(t, vault.Validate())
+
+	// The users are fully synced again.
+	require.True(t, vault.getUser("user1").ShouldResync)
+	require.True(t, vault.getUser("user2").ShouldResync)
+	require.Empty(t, vault.getUser("user2").GluonIDs)
+	require.Equal(t, []string{"user1", "user2"}, vault.GetUserIDs())
+}
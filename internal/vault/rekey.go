@@ -0,0 +1,278 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/ProtonMail/gluon/store"
+	"github.com/ProtonMail/gluon/store/fallback_v0"
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+)
+
+// vaultNextSecretName is the keychain entry holding the new vault key while the vault is being re-keyed.
+const vaultNextSecretName = "bridge-vault-key-next"
+
+// vaultBackupSuffix is appended to the vault file to name the copy encrypted with the previous key,
+// which is kept until the new key is stored in the keychain.
+const vaultBackupSuffix = ".bak"
+
+// Check returns an error if the vault in the given directory can't be decrypted with the given key.
+// Unlike New, it never resets the vault.
+func Check(vaultDir string, key []byte) error {
+	enc, err := os.ReadFile(filepath.Join(vaultDir, vaultFileName)) //nolint:gosec
+	if err != nil {
+		return err
+	}
+
+	gcm, err := newCipher(key)
+	if err != nil {
+		return err
+	}
+
+	return unmarshalFile(gcm, enc, new(Data))
+}
+
+// Rekey encrypts the vault with a new random key, which replaces the vault key in the keychain.
+//
+// The new key is first stored in the keychain next to the current one, then the vault is re-encrypted, and only then
+// does the new key replace the current one. If this is interrupted, RecoverRekey finds which of the two keys
+// the vault is encrypted with when the vault is next opened.
+func Rekey(vault *Vault, kc *keychain.Keychain) error {
+	key, err := crypto.RandomToken(32)
+	if err != nil {
+		return fmt.Errorf("could not generate random token: %w", err)
+	}
+
+	if err := kc.Put(vaultNextSecretName, base64.StdEncoding.EncodeToString(key)); err != nil {
+		return fmt.Errorf("could not put keychain item: %w", err)
+	}
+
+	if err := vault.rekey(key); err != nil {
+		if err := kc.Delete(vaultNextSecretName); err != nil {
+			logrus.WithError(err).Error("Failed to remove the new vault key from the keychain")
+		}
+
+		return fmt.Errorf("could not re-encrypt vault: %w", err)
+	}
+
+	return finishRekey(vault.path, kc, key)
+}
+
+// RecoverRekey completes a re-keying of the vault in the given directory that was interrupted.
+// It must be called before the vault is opened.
+func RecoverRekey(vaultDir string, kc *keychain.Keychain) error {
+	_, keyEnc, err := kc.Get(vaultNextSecretName)
+	if err != nil {
+		if keychain.IsErrKeychainNoItem(err) {
+			return nil
+		}
+
+		return fmt.Errorf("could not get keychain item: %w", err)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(keyEnc)
+	if err != nil {
+		return fmt.Errorf("could not decode keychain item: %w", err)
+	}
+
+	path := filepath.Join(vaultDir, vaultFileName)
+
+	// If the vault isn't encrypted with the new key yet, the re-keying is abandoned.
+	if err := Check(vaultDir, key); err != nil {
+		logrus.WithError(err).Warn("Vault was not re-encrypted, abandoning the new vault key")
+
+		if err := kc.Delete(vaultNextSecretName); err != nil {
+			return fmt.Errorf("could not delete keychain item: %w", err)
+		}
+
+		return removeBackup(path)
+	}
+
+	logrus.Info("Vault was re-encrypted, completing the vault key rotation")
+
+	return finishRekey(path, kc, key)
+}
+
+// finishRekey replaces the vault key by the new one the vault is now encrypted with.
+func finishRekey(path string, kc *keychain.Keychain, key []byte) error {
+	if err := SetVaultKey(kc, key); err != nil {
+		return fmt.Errorf("could not put keychain item: %w", err)
+	}
+
+	if err := kc.Delete(vaultNextSecretName); err != nil {
+		return fmt.Errorf("could not delete keychain item: %w", err)
+	}
+
+	return removeBackup(path)
+}
+
+func removeBackup(path string) error {
+	if err := os.Remove(path + vaultBackupSuffix); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("could not remove vault backup: %w", err)
+	}
+
+	return nil
+}
+
+// rekey re-encrypts the vault with the given key, keeping a backup of the vault encrypted with the previous key.
+func (vault *Vault) rekey(key []byte) error {
+	gcm, err := newCipher(key)
+	if err != nil {
+		return err
+	}
+
+	vault.lock.Lock()
+	defer vault.lock.Unlock()
+
+	enc, err := marshalFile(gcm, vault.getUnsafe())
+	if err != nil {
+		return err
+	}
+
+	if err := writeFile(vault.path+vaultBackupSuffix, vault.enc); err != nil {
+		return fmt.Errorf("could not back up vault: %w", err)
+	}
+
+	if err := writeFile(vault.path, enc); err != nil {
+		return err
+	}
+
+	vault.gcm, vault.enc = gcm, enc
+
+	return nil
+}
+
+// RotateGluonKey replaces the key of the user's gluon stores, found in storeDir, by a new one and re-encrypts them.
+// Gluon must not be running.
+//
+// The new key is saved in the vault before re-encrypting the messages one at a time, each replacing its previous
+// version atomically, so that an interrupted rotation can be completed by calling it again.
+func (user *User) RotateGluonKey(storeDir string) error {
+	data := user.vault.getUser(user.userID)
+
+	nextKey := data.NextGluonKey
+	if nextKey == nil {
+		nextKey = newRandomToken(32)
+
+		if err := user.vault.modUser(user.userID, func(data *UserData) {
+			data.NextGluonKey = nextKey
+		}); err != nil {
+			return err
+		}
+	}
+
+	// In combined mode, all the addresses share the same gluon user.
+	gluonIDs := make(map[string]struct{})
+
+	for _, gluonID := range data.GluonIDs {
+		gluonIDs[gluonID] = struct{}{}
+	}
+
+	for _, gluonID := range maps.Keys(gluonIDs) {
+		if err := rekeyGluonStore(filepath.Join(storeDir, gluonID), data.GluonKey, nextKey); err != nil {
+			return fmt.Errorf("could not re-encrypt gluon store %v: %w", gluonID, err)
+		}
+	}
+
+	logrus.WithField("userID", user.userID).Info("Gluon key rotated")
+
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.GluonKey = nextKey
+		data.NextGluonKey = nil
+	})
+}
+
+// ResumeGluonKeyRotations completes the gluon key rotations that were interrupted. Gluon must not be running.
+func (vault *Vault) ResumeGluonKeyRotations(storeDir string) error {
+	return vault.ForUser(1, func(user *User) error {
+		if user.vault.getUser(user.userID).NextGluonKey == nil {
+			return nil
+		}
+
+		logrus.WithField("userID", user.userID).Warn("Resuming interrupted gluon key rotation")
+
+		return user.RotateGluonKey(storeDir)
+	})
+}
+
+// rekeyGluonStore re-encrypts the messages of a gluon store with a new key. The messages already encrypted with it
+// are skipped.
+func rekeyGluonStore(dir string, key, nextKey []byte) error {
+	if _, err := os.Stat(dir); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	// The messages are re-encrypted in a separate directory, then moved over their previous version.
+	tmpDir := dir + ".rekey"
+
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	prevStore, err := store.NewOnDiskStore(dir, key, store.WithFallback(fallback_v0.NewOnDiskStoreV0WithCompressor(&fallback_v0.GZipCompressor{})))
+	if err != nil {
+		return err
+	}
+
+	nextStore, err := store.NewOnDiskStore(dir, nextKey)
+	if err != nil {
+		return err
+	}
+
+	tmpStore, err := store.NewOnDiskStore(tmpDir, nextKey)
+	if err != nil {
+		return err
+	}
+
+	messageIDs, err := prevStore.List()
+	if err != nil {
+		return err
+	}
+
+	for _, messageID := range messageIDs {
+		if _, err := nextStore.Get(messageID); err == nil {
+			continue
+		}
+
+		literal, err := prevStore.Get(messageID)
+		if err != nil {
+			return fmt.Errorf("could not read message %v: %w", messageID, err)
+		}
+
+		if err := tmpStore.Set(messageID, bytes.NewReader(literal)); err != nil {
+			return fmt.Errorf("could not write message %v: %w", messageID, err)
+		}
+
+		if err := os.Rename(filepath.Join(tmpDir, messageID.String()), filepath.Join(dir, messageID.String())); err != nil {
+			return fmt.Errorf("could not replace message %v: %w", messageID, err)
+		}
+	}
+
+	return nil
+}
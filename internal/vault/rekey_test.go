@@ -0,0 +1,209 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package vault
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProtonMail/gluon/async"
+	"github.com/ProtonMail/gluon/imap"
+	"github.com/ProtonMail/gluon/store"
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain"
+	"github.com/docker/docker-credential-helpers/credentials"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRekey(t *testing.T) {
+	vaultDir, kc := t.TempDir(), newTestKeychain(t)
+
+	key, err := NewVaultKey(kc)
+	require.NoError(t, err)
+
+	vault, corrupt, err := New(vaultDir, t.TempDir(), key, async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.NoError(t, corrupt)
+
+	_, err = vault.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	require.NoError(t, Rekey(vault, kc))
+
+	// The vault is still usable.
+	require.True(t, vault.HasUser("userID"))
+	require.NoError(t, vault.SetMigrated())
+
+	// It is only encrypted with the new key, which replaced the previous one in the keychain.
+	newKey, err := GetVaultKey(kc)
+	require.NoError(t, err)
+	require.NotEqual(t, key, newKey)
+
+	require.NoError(t, Check(vaultDir, newKey))
+	require.ErrorIs(t, Check(vaultDir, key), ErrDecryptFailed)
+
+	require.NoFileExists(t, filepath.Join(vaultDir, vaultFileName+vaultBackupSuffix))
+
+	_, _, err = kc.Get(vaultNextSecretName)
+	require.True(t, keychain.IsErrKeychainNoItem(err))
+
+	vault, corrupt, err = New(vaultDir, t.TempDir(), newKey, async.NoopPanicHandler{})
+	require.NoError(t, err)
+	require.NoError(t, corrupt)
+	require.True(t, vault.HasUser("userID"))
+	require.True(t, vault.Migrated())
+}
+
+func TestRecoverRekey(t *testing.T) {
+	for name, reencrypted := range map[string]bool{
+		"interrupted before re-encrypting the vault": false,
+		"interrupted after re-encrypting the vault":  true,
+	} {
+		t.Run(name, func(t *testing.T) {
+			vaultDir, kc := t.TempDir(), newTestKeychain(t)
+
+			key, err := NewVaultKey(kc)
+			require.NoError(t, err)
+
+			vault, _, err := New(vaultDir, t.TempDir(), key, async.NoopPanicHandler{})
+			require.NoError(t, err)
+
+			// Do the first steps of Rekey only.
+			newKey, err := crypto.RandomToken(32)
+			require.NoError(t, err)
+			require.NoError(t, kc.Put(vaultNextSecretName, base64.StdEncoding.EncodeToString(newKey)))
+
+			if reencrypted {
+				require.NoError(t, vault.rekey(newKey))
+				require.FileExists(t, filepath.Join(vaultDir, vaultFileName+vaultBackupSuffix))
+			}
+
+			require.NoError(t, RecoverRekey(vaultDir, kc))
+
+			// The keychain holds the key the vault is encrypted with.
+			vaultKey, err := GetVaultKey(kc)
+			require.NoError(t, err)
+			require.NoError(t, Check(vaultDir, vaultKey))
+
+			if reencrypted {
+				require.Equal(t, newKey, vaultKey)
+			} else {
+				require.Equal(t, key, vaultKey)
+			}
+
+			require.NoFileExists(t, filepath.Join(vaultDir, vaultFileName+vaultBackupSuffix))
+
+			_, _, err = kc.Get(vaultNextSecretName)
+			require.True(t, keychain.IsErrKeychainNoItem(err))
+
+			// There is nothing left to recover.
+			require.NoError(t, RecoverRekey(vaultDir, kc))
+		})
+	}
+}
+
+func TestRotateGluonKey(t *testing.T) {
+	RandomToken = crypto.RandomToken
+
+	storeDir := t.TempDir()
+
+	vault, _, err := New(t.TempDir(), t.TempDir(), []byte("my secret key"), async.NoopPanicHandler{})
+	require.NoError(t, err)
+
+	user, err := vault.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, user.Close()) }()
+
+	// In combined mode, both addresses share the gluon user.
+	require.NoError(t, user.SetGluonID("addrID1", "gluonID"))
+	require.NoError(t, user.SetGluonID("addrID2", "gluonID"))
+
+	messages := map[imap.InternalMessageID][]byte{
+		imap.NewInternalMessageID(): []byte("message 1"),
+		imap.NewInternalMessageID(): []byte("message 2"),
+		imap.NewInternalMessageID(): []byte("message 3"),
+	}
+
+	writeGluonStore(t, filepath.Join(storeDir, "gluonID"), user.GluonKey(), messages)
+
+	key := user.GluonKey()
+
+	require.NoError(t, user.RotateGluonKey(storeDir))
+	require.NotEqual(t, key, user.GluonKey())
+	require.Nil(t, vault.getUser("userID").NextGluonKey)
+
+	requireGluonStore(t, filepath.Join(storeDir, "gluonID"), user.GluonKey(), messages)
+	require.NoDirExists(t, filepath.Join(storeDir, "gluonID.rekey"))
+
+	// Interrupt a rotation after re-encrypting one of the messages.
+	nextKey, err := crypto.RandomToken(32)
+	require.NoError(t, err)
+
+	require.NoError(t, vault.modUser("userID", func(data *UserData) {
+		data.NextGluonKey = nextKey
+	}))
+
+	for messageID, literal := range messages {
+		writeGluonStore(t, filepath.Join(storeDir, "gluonID"), nextKey, map[imap.InternalMessageID][]byte{messageID: literal})
+		break
+	}
+
+	require.NoError(t, vault.ResumeGluonKeyRotations(storeDir))
+	require.Equal(t, nextKey, user.GluonKey())
+	require.Nil(t, vault.getUser("userID").NextGluonKey)
+
+	requireGluonStore(t, filepath.Join(storeDir, "gluonID"), nextKey, messages)
+}
+
+func newTestKeychain(t *testing.T) *keychain.Keychain {
+	helper := keychain.NewTestHelper()
+
+	kc, _, err := keychain.NewKeychain("mock", "bridge-test", keychain.Helpers{
+		"mock": func(string) (credentials.Helper, error) { return helper, nil },
+	}, "mock")
+	require.NoError(t, err)
+
+	return kc
+}
+
+func writeGluonStore(t *testing.T, dir string, key []byte, messages map[imap.InternalMessageID][]byte) {
+	s, err := store.NewOnDiskStore(dir, key)
+	require.NoError(t, err)
+
+	for messageID, literal := range messages {
+		require.NoError(t, s.Set(messageID, bytes.NewReader(literal)))
+	}
+}
+
+func requireGluonStore(t *testing.T, dir string, key []byte, messages map[imap.InternalMessageID][]byte) {
+	s, err := store.NewOnDiskStore(dir, key)
+	require.NoError(t, err)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, len(messages))
+
+	for messageID, literal := range messages {
+		b, err := s.Get(messageID)
+		require.NoError(t, err)
+		require.Equal(t, literal, b)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"slices"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -106,6 +107,18 @@ func (vault *Vault) SetUpdateChannel(channel updater.Channel) error {
 	})
 }
 
+// GetUpdateMirrorURL returns the URL of the mirror the updates are downloaded from, empty for the update host.
+func (vault *Vault) GetUpdateMirrorURL() string {
+	return vault.getSafe().Settings.UpdateMirrorURL
+}
+
+// SetUpdateMirrorURL sets the URL of the mirror the updates are downloaded from.
+func (vault *Vault) SetUpdateMirrorURL(url string) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.UpdateMirrorURL = url
+	})
+}
+
 // GetUpdateRollout sets the update rollout.
 func (vault *Vault) GetUpdateRollout() float64 {
 	// The rollout value 0.6046602879796196 is forbidden. The RNG was not seeded when it was picked (GODT-2319).
@@ -200,6 +213,102 @@ func (vault *Vault) SetTelemetryDisabled(telemetryDisabled bool) error {
 	})
 }
 
+// GetBindAddress returns the address the IMAP and SMTP servers listen on, empty meaning the loopback address.
+func (vault *Vault) GetBindAddress() string {
+	return vault.getSafe().Settings.BindAddress
+}
+
+// SetBindAddress sets the address the IMAP and SMTP servers listen on.
+func (vault *Vault) SetBindAddress(address string) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.BindAddress = address
+	})
+}
+
+// GetAllowedNetworks returns the networks from which clients are accepted, nil meaning the private networks.
+func (vault *Vault) GetAllowedNetworks() []string {
+	return slices.Clone(vault.getSafe().Settings.AllowedNetworks)
+}
+
+// SetAllowedNetworks sets the networks from which clients are accepted.
+func (vault *Vault) SetAllowedNetworks(networks []string) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.AllowedNetworks = slices.Clone(networks)
+	})
+}
+
+// GetUnixSockets returns whether the IMAP and SMTP Unix sockets are enabled.
+func (vault *Vault) GetUnixSockets() bool {
+	return vault.getSafe().Settings.UnixSockets
+}
+
+// SetUnixSockets sets whether the IMAP and SMTP Unix sockets are enabled.
+func (vault *Vault) SetUnixSockets(enabled bool) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.UnixSockets = enabled
+	})
+}
+
+// GetDesktopNotifications returns whether desktop notifications are enabled.
+func (vault *Vault) GetDesktopNotifications() bool {
+	return vault.getSafe().Settings.DesktopNotifications
+}
+
+// SetDesktopNotifications sets whether desktop notifications are enabled.
+func (vault *Vault) SetDesktopNotifications(enabled bool) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.DesktopNotifications = enabled
+	})
+}
+
+// GetDesktopNotificationEvents returns the events raising a desktop notification, nil meaning all of them.
+func (vault *Vault) GetDesktopNotificationEvents() []string {
+	return slices.Clone(vault.getSafe().Settings.DesktopNotificationEvents)
+}
+
+// SetDesktopNotificationEvents sets the events raising a desktop notification, nil meaning all of them.
+func (vault *Vault) SetDesktopNotificationEvents(events []string) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.DesktopNotificationEvents = slices.Clone(events)
+	})
+}
+
+// GetSystemViews returns the system views shown to the mail clients, nil meaning the default ones.
+func (vault *Vault) GetSystemViews() []string {
+	return slices.Clone(vault.getSafe().Settings.SystemViews)
+}
+
+// SetSystemViews sets the system views shown to the mail clients, nil meaning the default ones.
+func (vault *Vault) SetSystemViews(views []string) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.SystemViews = slices.Clone(views)
+	})
+}
+
+// GetOAuthEnabled returns whether the local OAuth server is enabled.
+func (vault *Vault) GetOAuthEnabled() bool {
+	return vault.getSafe().Settings.OAuthEnabled
+}
+
+// SetOAuthEnabled sets whether the local OAuth server is enabled.
+func (vault *Vault) SetOAuthEnabled(enabled bool) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.OAuthEnabled = enabled
+	})
+}
+
+// GetOAuthPort returns the port of the local OAuth server, 0 meaning the default port.
+func (vault *Vault) GetOAuthPort() int {
+	return vault.getSafe().Settings.OAuthPort
+}
+
+// SetOAuthPort sets the port of the local OAuth server.
+func (vault *Vault) SetOAuthPort(port int) error {
+	return vault.modSafe(func(data *Data) {
+		data.Settings.OAuthPort = port
+	})
+}
+
 // GetLastVersion returns the last version of the bridge that was run.
 func (vault *Vault) GetLastVersion() *semver.Version {
 	lastVersion := vault.getSafe().Settings.LastVersion
@@ -279,3 +388,12 @@ func (vault *Vault) SetLastHeartbeatSent(timestamp time.Time) error {
 		data.Settings.LastHeartbeatSent = timestamp
 	})
 }
+
+// GetRedactedSettings returns a copy of the settings from which secrets have been removed, for use in diagnostics.
+func (vault *Vault) GetRedactedSettings() Settings {
+	settings := vault.getSafe().Settings
+
+	settings.PasswordArchive = PasswordArchive{}
+
+	return settings
+}
@@ -94,6 +94,20 @@ func TestVault_Settings_UpdateChannel(t *testing.T) {
 	require.Equal(t, updater.EarlyChannel, s.GetUpdateChannel())
 }
 
+func TestVault_Settings_UpdateMirrorURL(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Check the default update mirror URL.
+	require.Empty(t, s.GetUpdateMirrorURL())
+
+	// Modify the update mirror URL.
+	require.NoError(t, s.SetUpdateMirrorURL("https://mirror.example.com/download"))
+
+	// Check the new update mirror URL.
+	require.Equal(t, "https://mirror.example.com/download", s.GetUpdateMirrorURL())
+}
+
 func TestVault_Settings_UpdateRollout(t *testing.T) {
 	// create a new test vault.
 	s := newVault(t)
@@ -169,6 +183,85 @@ func TestVault_Settings_TelemetryDisabled(t *testing.T) {
 	require.Equal(t, true, s.GetTelemetryDisabled())
 }
 
+func TestVault_Settings_BindAddress(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// By default, the servers listen on the loopback address and accept the private networks.
+	require.Equal(t, "", s.GetBindAddress())
+	require.Nil(t, s.GetAllowedNetworks())
+
+	// Modify the bind address and allowed networks.
+	require.NoError(t, s.SetBindAddress("0.0.0.0"))
+	require.NoError(t, s.SetAllowedNetworks([]string{"192.168.1.0/24"}))
+
+	// Check the new settings.
+	require.Equal(t, "0.0.0.0", s.GetBindAddress())
+	require.Equal(t, []string{"192.168.1.0/24"}, s.GetAllowedNetworks())
+}
+
+func TestVault_Settings_UnixSockets(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Unix sockets are disabled by default.
+	require.False(t, s.GetUnixSockets())
+
+	// Enable them.
+	require.NoError(t, s.SetUnixSockets(true))
+
+	// Check the new setting.
+	require.True(t, s.GetUnixSockets())
+}
+
+func TestVault_Settings_OAuth(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// The OAuth server is disabled by default, on the default port.
+	require.False(t, s.GetOAuthEnabled())
+	require.Zero(t, s.GetOAuthPort())
+
+	// Modify the OAuth settings.
+	require.NoError(t, s.SetOAuthEnabled(true))
+	require.NoError(t, s.SetOAuthPort(1180))
+
+	// Check the new settings.
+	require.True(t, s.GetOAuthEnabled())
+	require.Equal(t, 1180, s.GetOAuthPort())
+}
+
+func TestVault_Settings_DesktopNotifications(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// Desktop notifications are disabled by default, for all events.
+	require.False(t, s.GetDesktopNotifications())
+	require.Nil(t, s.GetDesktopNotificationEvents())
+
+	// Modify the desktop notifications settings.
+	require.NoError(t, s.SetDesktopNotifications(true))
+	require.NoError(t, s.SetDesktopNotificationEvents([]string{"sync-failed"}))
+
+	// Check the new desktop notifications settings.
+	require.True(t, s.GetDesktopNotifications())
+	require.Equal(t, []string{"sync-failed"}, s.GetDesktopNotificationEvents())
+}
+
+func TestVault_Settings_SystemViews(t *testing.T) {
+	// create a new test vault.
+	s := newVault(t)
+
+	// The default system views are shown by default.
+	require.Nil(t, s.GetSystemViews())
+
+	// Modify the system views.
+	require.NoError(t, s.SetSystemViews([]string{"snoozed"}))
+
+	// Check the new system views.
+	require.Equal(t, []string{"snoozed"}, s.GetSystemViews())
+}
+
 func TestVault_Settings_Autostart(t *testing.T) {
 	// create a new test vault.
 	s := newVault(t)
@@ -267,3 +360,20 @@ func Test_Settings_PasswordArchive(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, user.BridgePass(), bridgePass)
 }
+
+func TestVault_Settings_Redacted(t *testing.T) {
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Add and remove a user so that its password gets archived.
+	user, err := s.AddUser("userID1", "username1", "username1@pm.me", "authUID1", "authRef1", []byte("keyPass1"))
+	require.NoError(t, err)
+	require.NoError(t, user.Close())
+	require.NoError(t, s.DeleteUser("userID1"))
+
+	// The redacted settings do not contain the archived password but keep the other values.
+	settings := s.GetRedactedSettings()
+	require.Empty(t, settings.PasswordArchive.Archive)
+	require.Equal(t, s.GetIMAPPort(), settings.IMAPPort)
+	require.Equal(t, s.GetGluonCacheDir(), settings.GluonDir)
+}
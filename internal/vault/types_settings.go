@@ -38,6 +38,9 @@ type Settings struct {
 	UpdateChannel updater.Channel
 	UpdateRollout float64
 
+	// UpdateMirrorURL, if set, replaces the update host when downloading the version file and the update packages.
+	UpdateMirrorURL string
+
 	ColorScheme       string
 	ProxyAllowed      bool
 	ShowAllMail       bool
@@ -56,6 +59,26 @@ type Settings struct {
 
 	PasswordArchive PasswordArchive
 
+	// BindAddress is the address the IMAP and SMTP servers listen on, empty meaning the loopback address.
+	// AllowedNetworks restricts the clients accepted from other hosts, nil meaning the private networks.
+	BindAddress     string
+	AllowedNetworks []string
+
+	// UnixSockets enables the IMAP and SMTP Unix sockets, in addition to the TCP ports.
+	UnixSockets bool
+
+	// DesktopNotifications enables desktop notifications for DesktopNotificationEvents, nil meaning all events.
+	DesktopNotifications      bool
+	DesktopNotificationEvents []string
+
+	// SystemViews are the system views shown to the mail clients, e.g. Starred or Snoozed, nil meaning the default ones.
+	SystemViews []string
+
+	// OAuthEnabled enables the local OAuth server and the OAUTHBEARER and XOAUTH2 authentication mechanisms.
+	// OAuthPort is the port of the OAuth server, 0 meaning the default port.
+	OAuthEnabled bool
+	OAuthPort    int
+
 	// **WARNING**: These entry can't be removed until they vault has proper migration support.
 	SyncWorkers int
 	SyncAttPool int
@@ -109,5 +132,18 @@ func newDefaultSettings(gluonDir string) Settings {
 		LastHeartbeatSent: time.Time{},
 
 		PasswordArchive: PasswordArchive{},
+
+		BindAddress:     "",
+		AllowedNetworks: nil,
+
+		UnixSockets: false,
+
+		DesktopNotifications:      false,
+		DesktopNotificationEvents: nil,
+
+		SystemViews: nil,
+
+		OAuthEnabled: false,
+		OAuthPort:    0,
 	}
 }
@@ -17,7 +17,11 @@
 
 package vault
 
-import "github.com/ProtonMail/gluon/imap"
+import (
+	"time"
+
+	"github.com/ProtonMail/gluon/imap"
+)
 
 // UserData holds information about a single bridge user.
 // The user may or may not be logged in.
@@ -42,6 +46,40 @@ type UserData struct {
 	UIDValidity map[string]imap.UID
 
 	ShouldResync bool // Whether user should re-sync on log-in (this is triggered by the `repair` button)
+
+	AppPasswords []AppPassword
+
+	ReadOnly bool // Whether the mail clients can only read the mailbox, whatever the password they authenticate with.
+
+	StrictSplitMode bool // Whether, in split mode, an SMTP session can only send from the address it authenticated with.
+
+	NextGluonKey []byte // The key the gluon stores are being re-encrypted with, if a rotation is in progress.
+
+	AddressGroups []AddressGroup // The addresses sharing a mailbox in split mode, the others having their own.
+
+	ExportSchedule ExportSchedule // The periodic export of the messages into a local folder, if any.
+}
+
+// ExportSchedule is a periodic export of the messages of a user into a local folder, disabled if Interval is zero.
+type ExportSchedule struct {
+	Format   string // The format of the export, `maildir` or `mbox`.
+	Dest     string
+	Interval time.Duration
+
+	LastRun   time.Time
+	LastError string // The error of the last run, empty if it succeeded.
+}
+
+// IsEnabled returns whether the messages are exported periodically.
+func (schedule ExportSchedule) IsEnabled() bool {
+	return schedule.Interval > 0
+}
+
+// AddressGroup is a set of addresses which, in split mode, share a single mailbox as they would in combined mode.
+// The mailbox is stored under the gluon ID of the first address of the group.
+type AddressGroup struct {
+	Name    string
+	AddrIDs []string
 }
 
 type AddressMode int
@@ -64,6 +102,53 @@ func (mode AddressMode) String() string {
 	}
 }
 
+// AppPasswordScope restricts what a client authenticating with an app password can do.
+type AppPasswordScope string
+
+const (
+	AppPasswordScopeAll          AppPasswordScope = "all"
+	AppPasswordScopeIMAP         AppPasswordScope = "imap"
+	AppPasswordScopeSMTP         AppPasswordScope = "smtp"
+	AppPasswordScopeIMAPReadOnly AppPasswordScope = "imap-readonly"
+)
+
+// IsValid returns whether the scope is one of the known scopes.
+func (scope AppPasswordScope) IsValid() bool {
+	switch scope {
+	case AppPasswordScopeAll, AppPasswordScopeIMAP, AppPasswordScopeSMTP, AppPasswordScopeIMAPReadOnly:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// AllowsIMAP returns whether the scope grants access over IMAP.
+func (scope AppPasswordScope) AllowsIMAP() bool {
+	return scope == AppPasswordScopeAll || scope == AppPasswordScopeIMAP || scope == AppPasswordScopeIMAPReadOnly
+}
+
+// AllowsSMTP returns whether the scope grants access over SMTP.
+func (scope AppPasswordScope) AllowsSMTP() bool {
+	return scope == AppPasswordScopeAll || scope == AppPasswordScopeSMTP
+}
+
+// IsReadOnly returns whether the scope forbids modifying the mailbox.
+func (scope AppPasswordScope) IsReadOnly() bool {
+	return scope == AppPasswordScopeIMAPReadOnly
+}
+
+// AppPassword is a password given to a single mail client in addition to the bridge password.
+// Only the hash of the password is stored, the password itself is shown once when it is created.
+type AppPassword struct {
+	ID         string
+	Name       string
+	Scope      AppPasswordScope
+	Hash       []byte // SHA-256 of the raw token
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+}
+
 type SyncStatus struct {
 	HasLabels        bool
 	HasMessages      bool
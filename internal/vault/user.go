@@ -18,10 +18,14 @@
 package vault
 
 import (
+	"crypto/sha256"
+	"crypto/subtle"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/bradenaw/juniper/xslices"
+	"github.com/google/uuid"
 	"golang.org/x/exp/slices"
 )
 
@@ -97,6 +101,54 @@ func (user *User) SetAddressMode(mode AddressMode) error {
 	})
 }
 
+// ReadOnly returns whether the mail clients can only read the user's mailbox.
+func (user *User) ReadOnly() bool {
+	return user.vault.getUser(user.userID).ReadOnly
+}
+
+// SetReadOnly sets whether the mail clients can only read the user's mailbox.
+func (user *User) SetReadOnly(readOnly bool) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.ReadOnly = readOnly
+	})
+}
+
+// AddressGroups returns the addresses sharing a mailbox in split mode.
+func (user *User) AddressGroups() []AddressGroup {
+	return user.vault.getUser(user.userID).AddressGroups
+}
+
+// SetAddressGroups sets the addresses sharing a mailbox in split mode.
+func (user *User) SetAddressGroups(groups []AddressGroup) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.AddressGroups = groups
+	})
+}
+
+// StrictSplitMode returns whether, in split mode, an SMTP session can only send from the address it authenticated with.
+func (user *User) StrictSplitMode() bool {
+	return user.vault.getUser(user.userID).StrictSplitMode
+}
+
+// SetStrictSplitMode sets whether, in split mode, an SMTP session can only send from the address it authenticated with.
+func (user *User) SetStrictSplitMode(strict bool) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.StrictSplitMode = strict
+	})
+}
+
+// ExportSchedule returns the periodic export of the user's messages.
+func (user *User) ExportSchedule() ExportSchedule {
+	return user.vault.getUser(user.userID).ExportSchedule
+}
+
+// SetExportSchedule sets the periodic export of the user's messages.
+func (user *User) SetExportSchedule(schedule ExportSchedule) error {
+	return user.vault.modUser(user.userID, func(data *UserData) {
+		data.ExportSchedule = schedule
+	})
+}
+
 // BridgePass returns the user's bridge password as raw token bytes (unencoded).
 func (user *User) BridgePass() []byte {
 	return user.vault.getUser(user.userID).BridgePass
@@ -109,6 +161,101 @@ func (user *User) SetBridgePass(newPass []byte) error {
 	})
 }
 
+// appPasswordUseInterval is the precision of the last use time of app passwords; it spares a vault write per login.
+const appPasswordUseInterval = time.Minute
+
+// AppPasswords returns the user's app passwords.
+func (user *User) AppPasswords() []AppPassword {
+	return slices.Clone(user.vault.getUser(user.userID).AppPasswords)
+}
+
+// AddAppPassword creates an app password with the given name and scope, failing with ErrAppPasswordNameTaken if
+// another one has the same name, case-insensitively.
+// It returns the app password along with its raw token, which is not stored.
+func (user *User) AddAppPassword(name string, scope AppPasswordScope) (AppPassword, []byte, error) {
+	token := newRandomToken(16)
+	hash := sha256.Sum256(token)
+
+	appPassword := AppPassword{
+		ID:        uuid.NewString(),
+		Name:      name,
+		Scope:     scope,
+		Hash:      hash[:],
+		CreatedAt: time.Now(),
+	}
+
+	var taken bool
+
+	// The name is checked in the same update as the app password is added, so that concurrent adds can't share it.
+	if err := user.vault.modUser(user.userID, func(data *UserData) {
+		if taken = xslices.IndexFunc(data.AppPasswords, func(other AppPassword) bool {
+			return strings.EqualFold(other.Name, name)
+		}) >= 0; taken {
+			return
+		}
+
+		data.AppPasswords = append(data.AppPasswords, appPassword)
+	}); err != nil {
+		return AppPassword{}, nil, err
+	}
+
+	if taken {
+		return AppPassword{}, nil, ErrAppPasswordNameTaken
+	}
+
+	return appPassword, token, nil
+}
+
+// RemoveAppPassword removes the app password with the given ID and returns whether it existed.
+func (user *User) RemoveAppPassword(id string) (bool, error) {
+	var removed bool
+
+	if err := user.vault.modUser(user.userID, func(data *UserData) {
+		data.AppPasswords = xslices.Filter(data.AppPasswords, func(appPassword AppPassword) bool {
+			if appPassword.ID == id {
+				removed = true
+				return false
+			}
+
+			return true
+		})
+	}); err != nil {
+		return false, err
+	}
+
+	return removed, nil
+}
+
+// UseAppPassword returns the app password matching the given raw token, if any, and records its use.
+// The app password is returned even if its use could not be recorded.
+func (user *User) UseAppPassword(token []byte) (AppPassword, bool, error) {
+	hash := sha256.Sum256(token)
+
+	for _, appPassword := range user.AppPasswords() {
+		if subtle.ConstantTimeCompare(appPassword.Hash, hash[:]) != 1 {
+			continue
+		}
+
+		now := time.Now()
+
+		if now.Sub(appPassword.LastUsedAt) < appPasswordUseInterval {
+			return appPassword, true, nil
+		}
+
+		appPassword.LastUsedAt = now
+
+		return appPassword, true, user.vault.modUser(user.userID, func(data *UserData) {
+			for idx := range data.AppPasswords {
+				if data.AppPasswords[idx].ID == appPassword.ID {
+					data.AppPasswords[idx].LastUsedAt = now
+				}
+			}
+		})
+	}
+
+	return AppPassword{}, false, nil
+}
+
 // AuthUID returns the user's auth UID.
 func (user *User) AuthUID() string {
 	return user.vault.getUser(user.userID).AuthUID
@@ -20,6 +20,7 @@ package vault_test
 import (
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/ProtonMail/proton-bridge/v3/internal/vault"
 	"github.com/stretchr/testify/require"
@@ -271,3 +272,173 @@ func TestUser_ShouldResync(t *testing.T) {
 	// Check whether it matches the correct value
 	require.True(t, user.GetShouldResync())
 }
+
+func TestUser_AppPasswords(t *testing.T) {
+	// Replace the token generator with one returning distinct tokens.
+	var count byte
+
+	vault.RandomToken = func(_ int) ([]byte, error) {
+		count++
+		return []byte{'t', count}, nil
+	}
+
+	s := newVault(t)
+
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// There are no app passwords by default.
+	require.Empty(t, user.AppPasswords())
+
+	phone, phoneToken, err := user.AddAppPassword("phone", vault.AppPasswordScopeAll)
+	require.NoError(t, err)
+
+	archiver, archiverToken, err := user.AddAppPassword("archiver", vault.AppPasswordScopeIMAPReadOnly)
+	require.NoError(t, err)
+
+	require.NotEqual(t, phone.ID, archiver.ID)
+	require.NotEqual(t, phoneToken, archiverToken)
+	require.Len(t, user.AppPasswords(), 2)
+
+	// The names are unique, case-insensitively.
+	_, _, err = user.AddAppPassword("Phone", vault.AppPasswordScopeSMTP)
+	require.ErrorIs(t, err, vault.ErrAppPasswordNameTaken)
+	require.Len(t, user.AppPasswords(), 2)
+
+	// The token is not stored.
+	for _, appPassword := range user.AppPasswords() {
+		require.NotEqual(t, phoneToken, appPassword.Hash)
+		require.NotEqual(t, archiverToken, appPassword.Hash)
+	}
+
+	// The tokens match their app password and the use is recorded.
+	used, ok, err := user.UseAppPassword(archiverToken)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, archiver.ID, used.ID)
+	require.False(t, user.AppPasswords()[1].LastUsedAt.IsZero())
+	require.True(t, user.AppPasswords()[0].LastUsedAt.IsZero())
+
+	_, ok, err = user.UseAppPassword([]byte("unknown"))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Revoked app passwords no longer match.
+	removed, err := user.RemoveAppPassword(phone.ID)
+	require.NoError(t, err)
+	require.True(t, removed)
+
+	removed, err = user.RemoveAppPassword(phone.ID)
+	require.NoError(t, err)
+	require.False(t, removed)
+
+	_, ok, err = user.UseAppPassword(phoneToken)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.Len(t, user.AppPasswords(), 1)
+}
+
+func TestUser_ReadOnly(t *testing.T) {
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// New users are not read-only.
+	require.False(t, user.ReadOnly())
+
+	// Make the user read-only.
+	require.NoError(t, user.SetReadOnly(true))
+	require.True(t, user.ReadOnly())
+
+	// The value is kept for the next time the user is loaded.
+	require.NoError(t, user.Close())
+
+	require.NoError(t, s.GetUser("userID", func(user *vault.User) {
+		require.True(t, user.ReadOnly())
+	}))
+}
+
+func TestUser_StrictSplitMode(t *testing.T) {
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// New users are not in strict split mode.
+	require.False(t, user.StrictSplitMode())
+
+	// Enable the strict split mode.
+	require.NoError(t, user.SetStrictSplitMode(true))
+	require.True(t, user.StrictSplitMode())
+
+	// The value is kept for the next time the user is loaded.
+	require.NoError(t, user.Close())
+
+	require.NoError(t, s.GetUser("userID", func(user *vault.User) {
+		require.True(t, user.StrictSplitMode())
+	}))
+}
+
+func TestUser_AddressGroups(t *testing.T) {
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// New users have no address group.
+	require.Empty(t, user.AddressGroups())
+
+	// Group some addresses.
+	groups := []vault.AddressGroup{
+		{Name: "personal", AddrIDs: []string{"addrID1", "addrID2"}},
+		{Name: "work", AddrIDs: []string{"addrID3", "addrID4"}},
+	}
+
+	require.NoError(t, user.SetAddressGroups(groups))
+	require.Equal(t, groups, user.AddressGroups())
+
+	// The groups are kept for the next time the user is loaded.
+	require.NoError(t, user.Close())
+
+	require.NoError(t, s.GetUser("userID", func(user *vault.User) {
+		require.Equal(t, groups, user.AddressGroups())
+	}))
+}
+
+func TestUser_ExportSchedule(t *testing.T) {
+	// Create a new test vault.
+	s := newVault(t)
+
+	// Create a new user.
+	user, err := s.AddUser("userID", "username", "username@pm.me", "authUID", "authRef", []byte("keyPass"))
+	require.NoError(t, err)
+
+	// New users have no export schedule.
+	require.False(t, user.ExportSchedule().IsEnabled())
+
+	// Schedule an export.
+	schedule := vault.ExportSchedule{
+		Format:   "maildir",
+		Dest:     "/backup",
+		Interval: 24 * time.Hour,
+		LastRun:  time.Unix(1700000000, 0),
+	}
+
+	require.NoError(t, user.SetExportSchedule(schedule))
+	require.True(t, user.ExportSchedule().IsEnabled())
+
+	// The schedule is kept for the next time the user is loaded.
+	require.NoError(t, user.Close())
+
+	require.NoError(t, s.GetUser("userID", func(user *vault.User) {
+		require.Equal(t, schedule, user.ExportSchedule())
+	}))
+}
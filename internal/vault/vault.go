@@ -49,8 +49,11 @@ type Vault struct {
 	panicHandler async.PanicHandler
 }
 
+const vaultFileName = "vault.enc"
+
 var ErrDecryptFailed = errors.New("failed to decrypt vault")
 var ErrUnmarshal = errors.New("vault contents are corrupt")
+var ErrAppPasswordNameTaken = errors.New("an app password with this name already exists")
 
 // New constructs a new encrypted data vault at the given filepath using the given encryption key.
 // The first error is a corruption error for an existing vault, the second errors refrain to all other errors.
@@ -59,19 +62,12 @@ func New(vaultDir, gluonCacheDir string, key []byte, panicHandler async.PanicHan
 		return nil, nil, err
 	}
 
-	hash256 := sha256.Sum256(key)
-
-	aes, err := aes.NewCipher(hash256[:])
+	gcm, err := newCipher(key)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	gcm, err := cipher.NewGCM(aes)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	vault, corrupt, err := newVault(filepath.Join(vaultDir, "vault.enc"), gluonCacheDir, gcm)
+	vault, corrupt, err := newVault(filepath.Join(vaultDir, vaultFileName), gluonCacheDir, gcm)
 	if err != nil {
 		return nil, corrupt, err
 	}
@@ -277,6 +273,29 @@ func (vault *Vault) DeleteUser(userID string) error {
 	})
 }
 
+// DeleteUserAt removes the user at the given position in the vault, e.g. one without ID.
+func (vault *Vault) DeleteUserAt(idx int) error {
+	vault.lock.Lock()
+	defer vault.lock.Unlock()
+
+	logrus.WithField("index", idx).Info("Deleting vault user")
+
+	users := vault.getUnsafe().Users
+
+	if idx < 0 || idx >= len(users) {
+		return fmt.Errorf("no user at index %v", idx)
+	}
+
+	if _, ok := vault.ref[users[idx].UserID]; ok {
+		return fmt.Errorf("user %s is currently in use", users[idx].UserID)
+	}
+
+	return vault.modUnsafe(func(data *Data) {
+		data.Settings.PasswordArchive.set(data.Users[idx].PrimaryEmail, data.Users[idx].BridgePass)
+		data.Users = append(data.Users[:idx], data.Users[idx+1:]...)
+	})
+}
+
 func (vault *Vault) Migrated() bool {
 	vault.lock.RLock()
 	defer vault.lock.RUnlock()
@@ -424,13 +443,18 @@ func (vault *Vault) modUnsafe(fn func(data *Data)) error {
 
 	vault.enc = enc
 
-	tmpFile := vault.path + ".tmp"
+	return writeFile(vault.path, vault.enc)
+}
+
+// writeFile replaces the vault file atomically.
+func writeFile(path string, enc []byte) error {
+	tmpFile := path + ".tmp"
 
-	if err := os.WriteFile(tmpFile, vault.enc, 0o600); err != nil {
+	if err := os.WriteFile(tmpFile, enc, 0o600); err != nil {
 		return fmt.Errorf("failed write new vault to disk: %w", err)
 	}
 
-	if err := os.Rename(tmpFile, vault.path); err != nil {
+	if err := os.Rename(tmpFile, path); err != nil {
 		return fmt.Errorf("failed to overwrite old vault data: %w", err)
 	}
 
@@ -487,6 +511,18 @@ func (vault *Vault) modUserUnsafe(userID string, fn func(userData *UserData)) er
 	})
 }
 
+// newCipher returns the cipher encrypting the vault with the given key.
+func newCipher(key []byte) (cipher.AEAD, error) {
+	hash256 := sha256.Sum256(key)
+
+	aes, err := aes.NewCipher(hash256[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(aes)
+}
+
 func initVault(path, gluonDir string, gcm cipher.AEAD) ([]byte, error) {
 	enc, err := marshalFile(gcm, newDefaultData(gluonDir))
 	if err != nil {
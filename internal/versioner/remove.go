@@ -28,7 +28,9 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// RemoveOldVersions removes all but the latest app version.
+// RemoveOldVersions removes all but the two latest allowed app versions, the previous one being kept to roll back to.
+// The versions which are not allowed, because they are bad or newer than the pinned version, are removed too,
+// unless running.
 func (v *Versioner) RemoveOldVersions() error {
 	versions, err := v.ListVersions()
 	if err != nil {
@@ -40,7 +42,24 @@ func (v *Versioner) RemoveOldVersions() error {
 		return nil
 	}
 
-	for _, version := range versions[1:] {
+	var kept int
+
+	for _, version := range versions {
+		// Without the state, the versions to keep are unknown and none is removed.
+		allowed, err := v.IsAllowed(version.version)
+		if err != nil {
+			return err
+		}
+
+		if allowed && kept < 2 {
+			kept++
+			continue
+		}
+
+		if version.Equal(semver.MustParse(constants.Version)) {
+			continue
+		}
+
 		if err := os.RemoveAll(version.path); err != nil {
 			logrus.WithError(err).Error("Failed to remove old app version")
 		}
@@ -22,7 +22,7 @@ package versioner
 
 import "github.com/Masterminds/semver/v3"
 
-// RemoveOldVersions removes all but the latest app version.
+// RemoveOldVersions removes all but the two latest allowed app versions, the previous one being kept to roll back to.
 func (v *Versioner) RemoveOldVersions() error {
 	// darwin does not use the versioner; removal is a noop.
 	return nil
@@ -0,0 +1,183 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package versioner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"golang.org/x/exp/slices"
+)
+
+// stateFile holds the versions pinned and marked bad. It is kept in plain text next to the versions,
+// so that the launcher can read it without unlocking the vault.
+const stateFile = "versions.json"
+
+// DefaultRollbackCrashes is the number of consecutive startup crashes after which the app rolls back, unless set.
+const DefaultRollbackCrashes = 3
+
+type state struct {
+	Pinned string   `json:"pinned,omitempty"`
+	Bad    []string `json:"bad,omitempty"`
+
+	// RollbackCrashes is the number of consecutive startup crashes after which the app rolls back, nil meaning
+	// DefaultRollbackCrashes. It is kept here, as the vault may not be unlocked yet when the app crashes.
+	RollbackCrashes *int `json:"rollbackCrashes,omitempty"`
+}
+
+// GetPinnedVersion returns the version the app is pinned to, or nil if it isn't.
+func (v *Versioner) GetPinnedVersion() (*semver.Version, error) {
+	st, err := v.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	if st.Pinned == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	return semver.StrictNewVersion(st.Pinned)
+}
+
+// SetPinnedVersion pins the app to the given version: no newer version is started or installed.
+// A nil version removes the pin.
+func (v *Versioner) SetPinnedVersion(version *semver.Version) error {
+	return v.modState(func(st *state) {
+		if version == nil {
+			st.Pinned = ""
+		} else {
+			st.Pinned = version.String()
+		}
+	})
+}
+
+// MarkBad marks the given version as bad: it is neither started nor installed again.
+func (v *Versioner) MarkBad(version *semver.Version) error {
+	return v.modState(func(st *state) {
+		if !slices.Contains(st.Bad, version.String()) {
+			st.Bad = append(st.Bad, version.String())
+		}
+	})
+}
+
+// GetRollbackCrashes returns the number of consecutive startup crashes after which the app rolls back to the previous
+// version, 0 meaning never.
+func (v *Versioner) GetRollbackCrashes() (int, error) {
+	st, err := v.loadState()
+	if err != nil {
+		return 0, err
+	}
+
+	if st.RollbackCrashes == nil {
+		return DefaultRollbackCrashes, nil
+	}
+
+	return *st.RollbackCrashes, nil
+}
+
+// SetRollbackCrashes sets the number of consecutive startup crashes after which the app rolls back to the previous
+// version, 0 meaning never.
+func (v *Versioner) SetRollbackCrashes(crashes int) error {
+	return v.modState(func(st *state) {
+		st.RollbackCrashes = &crashes
+	})
+}
+
+// IsAllowed returns whether the given version may be started or installed, i.e. it is not bad nor newer than the
+// pinned version. It fails if the state can't be read, no version being known to be allowed then.
+func (v *Versioner) IsAllowed(version *semver.Version) (bool, error) {
+	st, err := v.loadState()
+	if err != nil {
+		return false, fmt.Errorf("failed to load versions state: %w", err)
+	}
+
+	if slices.Contains(st.Bad, version.String()) {
+		return false, nil
+	}
+
+	if pinned, err := semver.StrictNewVersion(st.Pinned); err == nil && version.GreaterThan(pinned) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// Contains returns whether the given path is part of one of the versions, as opposed to the base installation.
+func (v *Versioner) Contains(path string) bool {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+
+	absRoot, err := filepath.Abs(v.root)
+	if err != nil {
+		return false
+	}
+
+	return strings.HasPrefix(absPath, absRoot+string(filepath.Separator))
+}
+
+func (v *Versioner) loadState() (state, error) {
+	var st state
+
+	b, err := os.ReadFile(filepath.Join(v.root, stateFile)) //nolint:gosec
+	if errors.Is(err, fs.ErrNotExist) {
+		return st, nil
+	} else if err != nil {
+		return st, err
+	}
+
+	if err := json.Unmarshal(b, &st); err != nil {
+		return st, err
+	}
+
+	return st, nil
+}
+
+func (v *Versioner) modState(fn func(*state)) error {
+	st, err := v.loadState()
+	if err != nil {
+		return err
+	}
+
+	fn(&st)
+
+	b, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(v.root, 0o700); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so that the launcher never reads a partial state.
+	tmp := filepath.Join(v.root, stateFile+".tmp")
+
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, filepath.Join(v.root, stateFile))
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge. If not, see <https://www.gnu.org/licenses/>.
+
+package versioner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersioner_State(t *testing.T) {
+	// The state is saved even if there is no version yet.
+	v := New(filepath.Join(t.TempDir(), "updates"))
+
+	pinned, err := v.GetPinnedVersion()
+	require.NoError(t, err)
+	require.Nil(t, pinned)
+	require.True(t, isAllowed(t, v, "3.0.0"))
+
+	// Newer versions than the pinned one are not allowed.
+	require.NoError(t, v.SetPinnedVersion(semver.MustParse("3.1.0")))
+
+	pinned, err = v.GetPinnedVersion()
+	require.NoError(t, err)
+	require.Equal(t, semver.MustParse("3.1.0"), pinned)
+
+	require.True(t, isAllowed(t, v, "3.1.0"))
+	require.False(t, isAllowed(t, v, "3.1.1"))
+
+	// Nor are the bad versions.
+	require.NoError(t, v.MarkBad(semver.MustParse("3.0.0")))
+	require.NoError(t, v.MarkBad(semver.MustParse("3.0.0")))
+	require.False(t, isAllowed(t, v, "3.0.0"))
+
+	// The state is read again by other versioners, like the launcher's.
+	other := New(v.root)
+	require.False(t, isAllowed(t, other, "3.0.0"))
+
+	require.NoError(t, other.SetPinnedVersion(nil))
+	require.True(t, isAllowed(t, v, "3.1.1"))
+	require.False(t, isAllowed(t, v, "3.0.0"))
+}
+
+func TestVersioner_RollbackCrashes(t *testing.T) {
+	v := New(filepath.Join(t.TempDir(), "updates"))
+
+	crashes, err := v.GetRollbackCrashes()
+	require.NoError(t, err)
+	require.Equal(t, DefaultRollbackCrashes, crashes)
+
+	// Rolling back can be disabled.
+	require.NoError(t, v.SetRollbackCrashes(0))
+
+	crashes, err = New(v.root).GetRollbackCrashes()
+	require.NoError(t, err)
+	require.Zero(t, crashes)
+}
+
+func TestVersioner_State_Unreadable(t *testing.T) {
+	v := New(filepath.Join(t.TempDir(), "updates"))
+
+	require.NoError(t, os.MkdirAll(v.root, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(v.root, stateFile), []byte("{"), 0o600))
+
+	// No version is known to be allowed if the state can't be read.
+	_, err := v.IsAllowed(semver.MustParse("3.0.0"))
+	require.Error(t, err)
+
+	// Nor is any version removed.
+	require.NoError(t, os.MkdirAll(filepath.Join(v.root, "3.0.0"), 0o700))
+	require.Error(t, v.RemoveOldVersions())
+	require.DirExists(t, filepath.Join(v.root, "3.0.0"))
+}
+
+func TestVersioner_Contains(t *testing.T) {
+	v := New(filepath.Join(t.TempDir(), "updates"))
+
+	require.True(t, v.Contains(filepath.Join(v.root, "3.0.0", "bridge")))
+	require.False(t, v.Contains(filepath.Join(filepath.Dir(v.root), "bridge")))
+	require.False(t, v.Contains(v.root+"-other"))
+}
+
+func isAllowed(t *testing.T, v *Versioner, version string) bool {
+	allowed, err := v.IsAllowed(semver.MustParse(version))
+	require.NoError(t, err)
+
+	return allowed
+}
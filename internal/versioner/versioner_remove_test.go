@@ -42,10 +42,30 @@ func TestRemoveOldVersions(t *testing.T) {
 
 	assert.NoError(t, v.RemoveOldVersions())
 
+	// The previous version is kept to roll back to.
 	cleanedVersions, err := v.ListVersions()
 	assert.NoError(t, err)
-	assert.Len(t, cleanedVersions, 1)
+	assert.Len(t, cleanedVersions, 2)
 
 	assert.Equal(t, semver.MustParse("2.4.0"), cleanedVersions[0].version)
 	assert.Equal(t, filepath.Join(tempDir, "2.4.0"), cleanedVersions[0].path)
+	assert.Equal(t, semver.MustParse("2.3.5"), cleanedVersions[1].version)
+}
+
+func TestRemoveOldVersions_NotAllowed(t *testing.T) {
+	tempDir := t.TempDir()
+
+	v := newTestVersioner(t, "myCoolApp", tempDir, "2.3.4", "2.3.5", "2.4.0", "2.5.0")
+
+	// The bad versions and those newer than the pinned one are removed, the two latest others are kept.
+	require.NoError(t, v.MarkBad(semver.MustParse("2.3.5")))
+	require.NoError(t, v.SetPinnedVersion(semver.MustParse("2.4.0")))
+
+	require.NoError(t, v.RemoveOldVersions())
+
+	cleanedVersions, err := v.ListVersions()
+	require.NoError(t, err)
+	require.Len(t, cleanedVersions, 2)
+	require.Equal(t, semver.MustParse("2.4.0"), cleanedVersions[0].version)
+	require.Equal(t, semver.MustParse("2.3.4"), cleanedVersions[1].version)
 }
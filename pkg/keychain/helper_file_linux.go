@@ -0,0 +1,385 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package keychain
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/ProtonMail/proton-bridge/v3/internal/constants"
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain/passphrasefd"
+	"github.com/docker/docker-credential-helpers/credentials"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// PassphraseFileEnv is the environment variable holding the passphrase of the passphrase file keychain.
+	PassphraseFileEnv = "BRIDGE_KEYCHAIN_PASSPHRASE"
+
+	// PassphraseFileFDEnv is the environment variable holding the number of an open file descriptor
+	// from which the passphrase of the passphrase file keychain is read, see passphrasefd.
+	PassphraseFileFDEnv = passphrasefd.Env
+
+	// PassphraseFileCredential is the name of the systemd credential holding the passphrase of the
+	// passphrase file keychain, read from $CREDENTIALS_DIRECTORY (see systemd.exec(5) LoadCredential=).
+	PassphraseFileCredential = "bridge-keychain-passphrase"
+
+	// PassphraseFilePathEnv is the environment variable overriding the location of the passphrase file keychain.
+	PassphraseFilePathEnv = "BRIDGE_KEYCHAIN_FILE"
+)
+
+const (
+	fileKeychainVersion = 1
+	fileKeychainName    = "keychain.enc"
+
+	// The scrypt parameters recommended for interactive logins as of 2017.
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = chacha20poly1305.KeySize
+	scryptSalt   = 32
+)
+
+var (
+	ErrNoPassphrase    = errors.New("no keychain passphrase was provided")
+	ErrWrongPassphrase = errors.New("the keychain file could not be decrypted, the passphrase is probably wrong")
+)
+
+// FileHelper stores the credentials in a file encrypted with a key derived from a passphrase with scrypt.
+// It is meant for headless systems where neither the secret service nor pass are available.
+type FileHelper struct {
+	path       string
+	passphrase []byte
+
+	// salt and key cache the last key derived from the passphrase, as scrypt is deliberately slow.
+	salt []byte
+	key  []byte
+
+	lock sync.Mutex
+}
+
+// fileKeychain is the content of the keychain file.
+type fileKeychain struct {
+	Version int
+	Salt    []byte
+	Nonce   []byte
+	Data    []byte
+}
+
+// fileCredentials are the credentials stored in the keychain file.
+type fileCredentials struct {
+	Username string
+	Secret   string
+}
+
+func NewFileHelper(path string, passphrase []byte) *FileHelper {
+	return &FileHelper{
+		path:       path,
+		passphrase: passphrase,
+	}
+}
+
+func newFileHelper(string) (credentials.Helper, error) {
+	passphrase, err := readFilePassphrase()
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := getFilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFileHelper(path, passphrase), nil
+}
+
+// hasFilePassphrase returns whether a passphrase was provided for the passphrase file keychain.
+func hasFilePassphrase() bool {
+	if os.Getenv(PassphraseFileEnv) != "" || os.Getenv(PassphraseFileFDEnv) != "" {
+		return true
+	}
+
+	if dir := os.Getenv("CREDENTIALS_DIRECTORY"); dir != "" {
+		if _, err := os.Stat(filepath.Join(dir, PassphraseFileCredential)); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// readFilePassphrase reads the passphrase from the environment, a file descriptor or a systemd credential, in that order.
+func readFilePassphrase() ([]byte, error) {
+	if passphrase := os.Getenv(PassphraseFileEnv); passphrase != "" {
+		return []byte(passphrase), nil
+	}
+
+	passphrase, err := passphrasefd.Read()
+	if err != nil {
+		return nil, err
+	} else if passphrase != nil {
+		return trimPassphrase(passphrase)
+	}
+
+	if dir := os.Getenv("CREDENTIALS_DIRECTORY"); dir != "" {
+		passphrase, err := os.ReadFile(filepath.Join(dir, PassphraseFileCredential)) //nolint:gosec
+		if err == nil {
+			return trimPassphrase(passphrase)
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("could not read systemd credential %v: %w", PassphraseFileCredential, err)
+		}
+	}
+
+	return nil, ErrNoPassphrase
+}
+
+// trimPassphrase removes the trailing newline most tools add when writing the passphrase to a file.
+func trimPassphrase(passphrase []byte) ([]byte, error) {
+	passphrase = bytes.TrimRight(passphrase, "\r\n")
+
+	if len(passphrase) == 0 {
+		return nil, ErrNoPassphrase
+	}
+
+	return passphrase, nil
+}
+
+func getFilePath() (string, error) {
+	if path := os.Getenv(PassphraseFilePathEnv); path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, constants.VendorName, constants.KeyChainName, fileKeychainName), nil
+}
+
+// Add appends credentials to the store.
+func (h *FileHelper) Add(creds *credentials.Credentials) error {
+	if creds.ServerURL == "" {
+		return credentials.NewErrCredentialsMissingServerURL()
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	items, err := h.load()
+	if err != nil {
+		return err
+	}
+
+	items[creds.ServerURL] = fileCredentials{
+		Username: creds.Username,
+		Secret:   creds.Secret,
+	}
+
+	return h.save(items)
+}
+
+// Delete removes credentials from the store.
+func (h *FileHelper) Delete(serverURL string) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	items, err := h.load()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := items[serverURL]; !ok {
+		return nil
+	}
+
+	delete(items, serverURL)
+
+	return h.save(items)
+}
+
+// Get retrieves credentials from the store.
+// It returns username and secret as strings.
+func (h *FileHelper) Get(serverURL string) (string, string, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	items, err := h.load()
+	if err != nil {
+		return "", "", err
+	}
+
+	item, ok := items[serverURL]
+	if !ok {
+		return "", "", credentials.NewErrCredentialsNotFound()
+	}
+
+	return item.Username, item.Secret, nil
+}
+
+// List returns the stored serverURLs and their associated usernames.
+func (h *FileHelper) List() (map[string]string, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	items, err := h.load()
+	if err != nil {
+		return nil, err
+	}
+
+	list := make(map[string]string, len(items))
+
+	for url, item := range items {
+		list[url] = item.Username
+	}
+
+	return list, nil
+}
+
+// load decrypts the keychain file, which is considered empty if it doesn't exist yet.
+func (h *FileHelper) load() (map[string]fileCredentials, error) {
+	enc, err := os.ReadFile(h.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return make(map[string]fileCredentials), nil
+		}
+
+		return nil, fmt.Errorf("could not read keychain file: %w", err)
+	}
+
+	var file fileKeychain
+
+	if err := json.Unmarshal(enc, &file); err != nil {
+		return nil, fmt.Errorf("could not parse keychain file: %w", err)
+	}
+
+	if file.Version != fileKeychainVersion {
+		return nil, fmt.Errorf("unsupported keychain file version %v", file.Version)
+	}
+
+	aead, err := h.getCipher(file.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(file.Nonce) != aead.NonceSize() {
+		return nil, errors.New("invalid keychain file nonce")
+	}
+
+	dec, err := aead.Open(nil, file.Nonce, file.Data, fileKeychainAD())
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+
+	items := make(map[string]fileCredentials)
+
+	if err := json.Unmarshal(dec, &items); err != nil {
+		return nil, fmt.Errorf("could not parse keychain file content: %w", err)
+	}
+
+	return items, nil
+}
+
+// save encrypts the credentials into the keychain file, replacing it atomically.
+func (h *FileHelper) save(items map[string]fileCredentials) error {
+	dec, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+
+	if h.salt == nil {
+		salt := make([]byte, scryptSalt)
+
+		if _, err := rand.Read(salt); err != nil {
+			return err
+		}
+
+		h.salt = salt
+		h.key = nil
+	}
+
+	aead, err := h.getCipher(h.salt)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	enc, err := json.Marshal(fileKeychain{
+		Version: fileKeychainVersion,
+		Salt:    h.salt,
+		Nonce:   nonce,
+		Data:    aead.Seal(nil, nonce, dec, fileKeychainAD()),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(h.path), 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(h.path), fileKeychainName+"-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(enc); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), h.path)
+}
+
+// getCipher returns the cipher for the key derived from the passphrase with the given salt.
+func (h *FileHelper) getCipher(salt []byte) (cipher.AEAD, error) {
+	if h.key == nil || !bytes.Equal(h.salt, salt) {
+		key, err := scrypt.Key(h.passphrase, salt, scryptN, scryptR, scryptP, scryptKeyLen)
+		if err != nil {
+			return nil, fmt.Errorf("could not derive keychain key: %w", err)
+		}
+
+		h.salt, h.key = salt, key
+	}
+
+	return chacha20poly1305.NewX(h.key)
+}
+
+// fileKeychainAD binds the encrypted data to the format version.
+func fileKeychainAD() []byte {
+	return []byte("proton-bridge-keychain-v" + strconv.Itoa(fileKeychainVersion))
+}
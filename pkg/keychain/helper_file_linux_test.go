@@ -0,0 +1,101 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+package keychain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileHelper(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keychain", fileKeychainName)
+
+	kc := newKeychain(NewFileHelper(path, []byte("passphrase")), hostURL("bridge"))
+
+	// The keychain is empty until the first item is added.
+	_, _, err := kc.Get("user1")
+	require.True(t, IsErrKeychainNoItem(err))
+
+	require.NoError(t, kc.Put("user1", "secret1"))
+	require.NoError(t, kc.Put("user2", "secret2"))
+
+	// The secrets are not stored in clear.
+	enc, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(enc), "secret1")
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// Another helper with the same passphrase can read the secrets.
+	kc = newKeychain(NewFileHelper(path, []byte("passphrase")), hostURL("bridge"))
+
+	list, err := kc.List()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"user1", "user2"}, list)
+
+	_, secret, err := kc.Get("user1")
+	require.NoError(t, err)
+	require.Equal(t, "secret1", secret)
+
+	require.NoError(t, kc.Delete("user1"))
+
+	list, err = kc.List()
+	require.NoError(t, err)
+	require.Equal(t, []string{"user2"}, list)
+
+	// A helper with another passphrase can't.
+	kc = newKeychain(NewFileHelper(path, []byte("wrong")), hostURL("bridge"))
+
+	_, _, err = kc.Get("user2")
+	require.ErrorIs(t, err, ErrWrongPassphrase)
+	require.ErrorIs(t, kc.Put("user3", "secret3"), ErrWrongPassphrase)
+}
+
+func TestReadFilePassphrase(t *testing.T) {
+	t.Setenv(PassphraseFileEnv, "")
+	t.Setenv(PassphraseFileFDEnv, "")
+	t.Setenv("CREDENTIALS_DIRECTORY", "")
+
+	require.False(t, hasFilePassphrase())
+
+	_, err := readFilePassphrase()
+	require.ErrorIs(t, err, ErrNoPassphrase)
+
+	// From a systemd credential.
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, PassphraseFileCredential), []byte("credential\n"), 0o600))
+	t.Setenv("CREDENTIALS_DIRECTORY", dir)
+
+	require.True(t, hasFilePassphrase())
+
+	passphrase, err := readFilePassphrase()
+	require.NoError(t, err)
+	require.Equal(t, "credential", string(passphrase))
+
+	// From the environment.
+	t.Setenv(PassphraseFileEnv, "environment")
+
+	passphrase, err = readFilePassphrase()
+	require.NoError(t, err)
+	require.Equal(t, "environment", string(passphrase))
+}
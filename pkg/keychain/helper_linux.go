@@ -29,6 +29,7 @@ const (
 	Pass              = "pass-app"
 	SecretService     = "secret-service"
 	SecretServiceDBus = "secret-service-dbus"
+	PassphraseFile    = "passphrase-file"
 )
 
 func listHelpers() (Helpers, string) {
@@ -55,6 +56,13 @@ func listHelpers() (Helpers, string) {
 		logrus.WithField("keychain", "Pass").Debug("Keychain is not available.")
 	}
 
+	if hasFilePassphrase() && isUsable(newFileHelper("")) {
+		helpers[PassphraseFile] = newFileHelper
+		logrus.WithField("keychain", "PassphraseFile").Info("Keychain is usable.")
+	} else {
+		logrus.WithField("keychain", "PassphraseFile").Debug("Keychain is not available.")
+	}
+
 	defaultHelper := SecretServiceDBus
 
 	// If Pass is available, use it by default.
@@ -64,6 +72,14 @@ func listHelpers() (Helpers, string) {
 	} else if _, ok := helpers[SecretService]; ok {
 		defaultHelper = SecretService
 	}
+
+	// On headless systems, where no other keychain is usable, use the passphrase file if it was set up.
+	if _, ok := helpers[defaultHelper]; !ok {
+		if _, ok := helpers[PassphraseFile]; ok {
+			defaultHelper = PassphraseFile
+		}
+	}
+
 	return helpers, defaultHelper
 }
 
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build linux
+// +build linux
+
+package passphrasefd
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// pipeCapacity is the size of the smallest pipe buffer, which the passphrase must fit in as it is written before the
+// command is started.
+const pipeCapacity = 4096
+
+// Forward makes the command read the passphrase given by file descriptor, if any, from a pipe of the process, passed as
+// an extra file. It must be called once the environment of the command was set.
+func Forward(cmd *exec.Cmd) error {
+	passphrase, err := Read()
+	if err != nil || passphrase == nil {
+		return err
+	}
+
+	if len(passphrase) > pipeCapacity {
+		return errors.New("the keychain passphrase is too long to be passed on")
+	}
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(passphrase)
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = reader.Close()
+		return err
+	}
+
+	// The extra files follow the standard input, output and error.
+	cmd.ExtraFiles = append(cmd.ExtraFiles, reader)
+
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+
+	cmd.Env = append(removeEnv(cmd.Env, Env), Env+"="+strconv.Itoa(2+len(cmd.ExtraFiles)))
+
+	return nil
+}
+
+func removeEnv(env []string, key string) []string {
+	res := make([]string, 0, len(env))
+
+	for _, entry := range env {
+		if !strings.HasPrefix(entry, key+"=") {
+			res = append(res, entry)
+		}
+	}
+
+	return res
+}
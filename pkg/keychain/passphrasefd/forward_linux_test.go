@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build linux
+// +build linux
+
+package passphrasefd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForward(t *testing.T) {
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+
+	_, err = w.WriteString("descriptor\n")
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	// The descriptor is closed once read, so it is handed over as a duplicate.
+	fd, err := syscall.Dup(int(r.Fd()))
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+
+	t.Setenv(Env, fmt.Sprint(fd))
+
+	passphrase, err := Read()
+	require.NoError(t, err)
+	require.Equal(t, "descriptor\n", string(passphrase))
+
+	// The passphrase is kept once the descriptor was read, and passed on to the commands through a pipe of their own.
+	for i := 0; i < 2; i++ {
+		cmd := exec.Command("sh", "-c", `cat <&"$`+Env+`"`)
+		require.NoError(t, Forward(cmd))
+		require.Contains(t, cmd.Env, Env+"=3")
+
+		out, err := cmd.Output()
+		require.NoError(t, err)
+		require.Equal(t, "descriptor\n", string(out))
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Proton AG
+//
+// This file is part of Proton Mail Bridge.
+//
+// Proton Mail Bridge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Proton Mail Bridge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Proton Mail Bridge.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package passphrasefd reads the passphrase of the passphrase file keychain from the file descriptor given in the
+// environment, and hands it on to the processes started by bridge and its launcher. A descriptor can only be read
+// once and is not inherited by the processes they start, so each of them passes the passphrase on through a pipe of
+// its own: the launcher to bridge, and bridge to the launcher restarting it.
+package passphrasefd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// Env is the environment variable holding the number of an open file descriptor from which the passphrase of the
+// passphrase file keychain is read.
+const Env = "BRIDGE_KEYCHAIN_PASSPHRASE_FD"
+
+var (
+	passphrase     []byte    //nolint:gochecknoglobals
+	passphraseErr  error     //nolint:gochecknoglobals
+	passphraseOnce sync.Once //nolint:gochecknoglobals
+)
+
+// Read returns the passphrase read from the file descriptor given in the environment, nil if none is given.
+// The descriptor is read when first needed and closed, the passphrase being kept.
+func Read() ([]byte, error) {
+	passphraseOnce.Do(func() {
+		passphrase, passphraseErr = read()
+	})
+
+	return passphrase, passphraseErr
+}
+
+func read() ([]byte, error) {
+	fdEnv := os.Getenv(Env)
+	if fdEnv == "" {
+		return nil, nil
+	}
+
+	fd, err := strconv.Atoi(fdEnv)
+	if err != nil || fd < 0 {
+		return nil, fmt.Errorf("invalid file descriptor %q in %v", fdEnv, Env)
+	}
+
+	file := os.NewFile(uintptr(fd), "keychain-passphrase")
+	if file == nil {
+		return nil, fmt.Errorf("invalid file descriptor %v in %v", fd, Env)
+	}
+	defer func() { _ = file.Close() }()
+
+	passphrase, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("could not read passphrase from file descriptor %v: %w", fd, err)
+	}
+
+	return passphrase, nil
+}
@@ -48,7 +48,10 @@ func (h TestHelper) Delete(url string) error {
 }
 
 func (h TestHelper) Get(url string) (string, string, error) {
-	creds := h[url]
+	creds, ok := h[url]
+	if !ok {
+		return "", "", credentials.NewErrCredentialsNotFound()
+	}
 
 	return creds.Username, creds.Secret, nil
 }
@@ -423,6 +423,14 @@ func getMessageHeader(msg proton.Message, opts JobOptions) message.Header {
 		}
 	}
 
+	// Set the conversation ID if known, so that clients can thread the messages like the web app.
+	// A header of the same name sent with the message is removed so that it can't join another conversation.
+	if opts.ConversationID != "" {
+		setHeaderIfNeeded(&hdr, "X-Pm-Conversation-Id", opts.ConversationID)
+	} else {
+		hdr.Del("X-Pm-Conversation-Id")
+	}
+
 	// Set our server date if requested.
 	// Can be useful to see how long it took for a message to arrive.
 	if opts.AddMessageDate {
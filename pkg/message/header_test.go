@@ -207,3 +207,21 @@ lorem`)
 	require.Equal(t, `Return-Path: <dummy@proton.me>`, lines[18])
 	require.Equal(t, `Delivered-To: test@proton.me`, lines[19])
 }
+
+func TestHeaderConversationID(t *testing.T) {
+	message := newTestMessageFromRFC822(t, []byte("From: Sender <sender@proton.me>\r\n"+
+		"Subject: test\r\n"+
+		"Date: Tue, 15 Oct 2024 07:54:39 +0000\r\n"+
+		"Content-Type: text/plain\r\n"+
+		"X-Pm-Conversation-Id: forged\r\n"+
+		"\r\n"+
+		"lorem"))
+
+	// The conversation ID is set when known.
+	hdr := getMessageHeader(message, JobOptions{ConversationID: "conversationID"})
+	require.Equal(t, []string{"conversationID"}, hdr.Values("X-Pm-Conversation-Id"))
+
+	// A header sent with the message is removed otherwise.
+	hdr = getMessageHeader(message, JobOptions{})
+	require.False(t, hdr.Has("X-Pm-Conversation-Id"))
+}
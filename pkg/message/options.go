@@ -25,4 +25,6 @@ type JobOptions struct {
 	AddMessageDate         bool // Whether to include message time as X-Pm-Date.
 	AddMessageIDReference  bool // Whether to include the MessageID in References.
 	SanitizeMBOXHeaderLine bool // Whether to ignore header line representing MBOX delimiter
+
+	ConversationID string // The conversation ID to include as X-Pm-Conversation-Id, if known.
 }
@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/ProtonMail/proton-bridge/v3/pkg/keychain/passphrasefd"
 	"github.com/bradenaw/juniper/xslices"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sys/execabs"
@@ -96,6 +97,11 @@ func (restarter *Restarter) Restart() {
 
 	cmd.Env = getEnvList(env)
 
+	// The launcher can't read the descriptor of the keychain passphrase again.
+	if err := passphrasefd.Forward(cmd); err != nil {
+		l.WithError(err).Error("Failed to pass on the keychain passphrase")
+	}
+
 	if err := run(cmd); err != nil {
 		l.WithError(err).Error("Failed to restart")
 	}